@@ -6,6 +6,7 @@ import (
 	"github.com/logan2k02/ims/gateway/inventory_handlers"
 	"github.com/logan2k02/ims/gateway/orders_handlers"
 	"github.com/logan2k02/ims/gateway/products_handlers"
+	"github.com/logan2k02/ims/gateway/suppliers_handlers"
 	pb "github.com/logan2k02/ims/shared/protobuf"
 
 	_ "github.com/joho/godotenv/autoload"
@@ -13,19 +14,86 @@ import (
 
 var validate = validator.New(validator.WithRequiredStructEnabled())
 
-func registerHandlers(app *fiber.App, productsClient pb.ProductsServiceClient, inventoryClient pb.InventoryServiceClient, ordersClient pb.OrdersServiceClient) {
+func registerHandlers(app *fiber.App, productsClient pb.ProductsServiceClient, inventoryClient pb.InventoryServiceClient, ordersClient pb.OrdersServiceClient, suppliersClient pb.SuppliersServiceClient) {
 	app.Post("/products/create", products_handlers.CreateProductHandler(productsClient, validate))
 	app.Get("/products/:id", products_handlers.GetProduct(productsClient))
 	app.Get("/products", products_handlers.ListProducts(productsClient))
 	app.Delete("/products/:id", products_handlers.DeleteProduct(productsClient))
 	app.Put("/products/:id", products_handlers.UpdateProduct(productsClient, validate))
+	app.Get("/products/:id/components", products_handlers.GetKit(productsClient))
+	app.Put("/products/:id/components", products_handlers.SetKitComponents(productsClient, validate))
+	app.Get("/products/:id/units", products_handlers.ListProductUnits(productsClient))
+	app.Put("/products/:id/units", products_handlers.SetProductUnits(productsClient, validate))
 
-	app.Post("/inventory/supply/:id", inventory_handlers.Supply(inventoryClient, validate))
-	app.Post("/inventory/correct/:id", inventory_handlers.Correct(inventoryClient, validate))
+	app.Post("/inventory/supply/:id", inventory_handlers.Supply(inventoryClient, productsClient, validate))
+	app.Post("/inventory/correct/:id", inventory_handlers.Correct(inventoryClient, productsClient, validate))
+	app.Get("/inventory/corrections", inventory_handlers.ListCorrections(inventoryClient, validate))
+	app.Post("/inventory/corrections/:id/approve", inventory_handlers.ReviewCorrection(inventoryClient.ApproveStockCorrection, validate, "failed to approve correction"))
+	app.Post("/inventory/corrections/:id/reject", inventory_handlers.ReviewCorrection(inventoryClient.RejectStockCorrection, validate, "failed to reject correction"))
+	app.Get("/inventory/correction-reasons", inventory_handlers.ListCorrectionReasons(inventoryClient))
+	app.Put("/inventory/correction-reasons/:code", inventory_handlers.SaveCorrectionReason(inventoryClient, validate))
+	app.Get("/inventory/movements", inventory_handlers.ListMovements(inventoryClient, validate))
+	app.Post("/inventory/movements/:id/reverse", inventory_handlers.ReverseMovement(inventoryClient))
+	app.Get("/inventory/stock-as-of", inventory_handlers.GetStockAsOf(inventoryClient))
+	app.Post("/inventory/reconcile", inventory_handlers.Reconcile(inventoryClient, validate))
+	app.Get("/inventory/valuation", inventory_handlers.GetValuation(inventoryClient))
+	app.Post("/inventory/transfer/:id", inventory_handlers.Transfer(inventoryClient, validate))
+	app.Get("/inventory/states/:id", inventory_handlers.GetStockStates(inventoryClient))
+	app.Post("/inventory/states/:id", inventory_handlers.MoveState(inventoryClient, validate))
+	app.Post("/inventory/assemble/:id", inventory_handlers.Assemble(inventoryClient, validate))
+	app.Get("/inventory/locations", inventory_handlers.ListLocations(inventoryClient))
+	app.Post("/inventory/locations", inventory_handlers.CreateLocation(inventoryClient, validate))
+	app.Get("/inventory/locations/:id", inventory_handlers.GetLocationStock(inventoryClient))
+	app.Get("/inventory/batches/expiring", inventory_handlers.ListExpiringBatches(inventoryClient))
+	app.Get("/inventory/batches/:id", inventory_handlers.ListBatches(inventoryClient))
+	app.Get("/inventory/serials", inventory_handlers.ListSerialUnits(inventoryClient, validate))
+	app.Get("/inventory/serials/:serial", inventory_handlers.GetSerialHistory(inventoryClient))
+	app.Get("/inventory/alerts", inventory_handlers.ListAlerts(inventoryClient))
+	app.Get("/inventory/alerts/stream", inventory_handlers.StreamAlerts(inventoryClient))
+	app.Get("/inventory/counts", inventory_handlers.ListCycleCounts(inventoryClient))
+	app.Post("/inventory/counts", inventory_handlers.OpenCycleCount(inventoryClient, validate))
+	app.Get("/inventory/counts/:id", inventory_handlers.CycleCountAction(inventoryClient.GetCycleCount, "failed to get cycle count"))
+	app.Post("/inventory/counts/:id/entries", inventory_handlers.RecordCycleCount(inventoryClient, validate))
+	app.Post("/inventory/counts/:id/submit", inventory_handlers.CycleCountAction(inventoryClient.SubmitCycleCount, "failed to submit cycle count"))
+	app.Post("/inventory/counts/:id/approve", inventory_handlers.ApproveCycleCount(inventoryClient, validate))
+	app.Post("/inventory/counts/:id/post", inventory_handlers.CycleCountAction(inventoryClient.PostCycleCount, "failed to post cycle count"))
+	app.Post("/inventory/counts/:id/cancel", inventory_handlers.CycleCountAction(inventoryClient.CancelCycleCount, "failed to cancel cycle count"))
 
-	app.Post("/orders/create", orders_handlers.CreateOrderHandler(ordersClient, validate))
+	app.Post("/orders/create", orders_handlers.CreateOrderHandler(ordersClient, productsClient, validate))
 	app.Get("/orders", orders_handlers.ListOrdersHandler(ordersClient))
 	app.Get("/orders/:id", orders_handlers.GetOrderHandler(ordersClient))
+	app.Get("/orders/:id/history", orders_handlers.OrderStatusHistoryHandler(ordersClient))
 	app.Post("/orders/change-status/:id", orders_handlers.ChangeOrderStatusHandler(ordersClient, validate))
 	app.Delete("/orders/:id", orders_handlers.DeleteOrderHandler(ordersClient))
+	app.Post("/orders/:id/returns", orders_handlers.CreateReturnHandler(ordersClient, validate))
+	app.Get("/returns", orders_handlers.ListReturnsHandler(ordersClient, validate))
+	app.Get("/returns/:id", orders_handlers.ReturnActionHandler(ordersClient.GetReturn, "failed to get return"))
+	app.Post("/returns/:id/receive", orders_handlers.ReturnActionHandler(ordersClient.ReceiveReturn, "failed to receive return"))
+	app.Post("/returns/:id/disposition", orders_handlers.DispositionReturnHandler(ordersClient, validate))
+	app.Post("/returns/:id/cancel", orders_handlers.ReturnActionHandler(ordersClient.CancelReturn, "failed to cancel return"))
+	app.Post("/orders/:id/shipments", orders_handlers.CreateShipmentHandler(ordersClient, validate))
+	app.Get("/orders/:id/shipments", orders_handlers.ListShipmentsHandler(ordersClient))
+	app.Get("/shipments/:id", orders_handlers.GetShipmentHandler(ordersClient))
+	app.Post("/promotions", orders_handlers.CreatePromotionHandler(ordersClient, validate))
+	app.Get("/promotions", orders_handlers.ListPromotionsHandler(ordersClient))
+	app.Get("/promotions/:id", orders_handlers.GetPromotionHandler(ordersClient))
+	app.Put("/promotions/:id", orders_handlers.UpdatePromotionHandler(ordersClient, validate))
+	app.Delete("/promotions/:id", orders_handlers.DeletePromotionHandler(ordersClient))
+
+	app.Post("/suppliers", suppliers_handlers.CreateSupplier(suppliersClient, validate))
+	app.Get("/suppliers", suppliers_handlers.ListSuppliers(suppliersClient))
+	app.Get("/suppliers/:id", suppliers_handlers.GetSupplier(suppliersClient))
+	app.Get("/suppliers/:id/products", suppliers_handlers.ListSupplierProducts(suppliersClient))
+	app.Put("/suppliers/:id/products/:productId", suppliers_handlers.LinkSupplierProduct(suppliersClient, validate))
+
+	app.Post("/replenishment/proposals", suppliers_handlers.CreateReplenishmentProposal(suppliersClient, validate))
+	app.Get("/replenishment/proposals/:id", suppliers_handlers.GetReplenishmentProposal(suppliersClient))
+	app.Post("/replenishment/proposals/:id/approve", suppliers_handlers.ApproveReplenishmentProposal(suppliersClient, validate))
+
+	app.Post("/purchase-orders", suppliers_handlers.CreatePurchaseOrder(suppliersClient, validate))
+	app.Get("/purchase-orders", suppliers_handlers.ListPurchaseOrders(suppliersClient, validate))
+	app.Get("/purchase-orders/:id", suppliers_handlers.GetPurchaseOrder(suppliersClient))
+	app.Post("/purchase-orders/:id/send", suppliers_handlers.PurchaseOrderAction(suppliersClient.SendPurchaseOrder, "failed to send purchase order"))
+	app.Post("/purchase-orders/:id/receive", suppliers_handlers.ReceivePurchaseOrder(suppliersClient, validate))
+	app.Post("/purchase-orders/:id/close", suppliers_handlers.PurchaseOrderAction(suppliersClient.ClosePurchaseOrder, "failed to close purchase order"))
 }
@@ -1,15 +1,23 @@
 package inventory_handlers
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"github.com/logan2k02/ims/gateway/products_handlers"
 	pb "github.com/logan2k02/ims/shared/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-func Supply(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+func Supply(inventoryCLient pb.InventoryServiceClient, productsClient pb.ProductsServiceClient, validate *validator.Validate) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		idParam := c.Params("id", "1")
 		id, err := strconv.ParseInt(idParam, 10, 64)
@@ -20,7 +28,7 @@ func Supply(inventoryCLient pb.InventoryServiceClient, validate *validator.Valid
 			})
 		}
 
-		var payload manageDto
+		var payload supplyDto
 		if err := c.BodyParser(&payload); err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
 		}
@@ -32,20 +40,39 @@ func Supply(inventoryCLient pb.InventoryServiceClient, validate *validator.Valid
 			})
 		}
 
+		if ok, err := products_handlers.UnitExists(c.Context(), productsClient, id, payload.Unit); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to look up unit", "details": status.Convert(err).Message()})
+		} else if !ok {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid supply", "details": fmt.Sprintf("product %d has no unit %s", id, payload.Unit)})
+		}
+
 		record, err := inventoryCLient.SupplyInventoryProduct(c.Context(), &pb.ManageInventoryRequest{
-			ProductId: id,
-			Quantity:  payload.Quantity,
-			Note:      payload.Note,
+			ProductId:     id,
+			Quantity:      payload.Quantity,
+			Note:          payload.Note,
+			LocationId:    payload.LocationId,
+			LotNumber:     payload.LotNumber,
+			ExpiresAt:     payload.ExpiresAt,
+			SerialNumbers: payload.SerialNumbers,
+			UnitCost:      payload.UnitCost,
+			Unit:          payload.Unit,
 		})
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to supply inventory", "details": status.Convert(err).Message()})
+			st := status.Convert(err)
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid supply", "details": st.Message()})
+			case codes.FailedPrecondition:
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "serial number already in stock", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to supply inventory", "details": st.Message()})
 		}
 
 		return c.Status(fiber.StatusCreated).JSON(record)
 	}
 }
 
-func Correct(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+func Correct(inventoryCLient pb.InventoryServiceClient, productsClient pb.ProductsServiceClient, validate *validator.Validate) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		idParam := c.Params("id", "1")
 		id, err := strconv.ParseInt(idParam, 10, 64)
@@ -68,15 +95,929 @@ func Correct(inventoryCLient pb.InventoryServiceClient, validate *validator.Vali
 			})
 		}
 
-		record, err := inventoryCLient.CorrectInventoryStock(c.Context(), &pb.ManageInventoryRequest{
+		if ok, err := products_handlers.UnitExists(c.Context(), productsClient, id, payload.Unit); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to look up unit", "details": status.Convert(err).Message()})
+		} else if !ok {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid correction", "details": fmt.Sprintf("product %d has no unit %s", id, payload.Unit)})
+		}
+
+		correction, err := inventoryCLient.CorrectInventoryStock(c.Context(), &pb.ManageInventoryRequest{
+			ProductId:     id,
+			Quantity:      payload.Quantity,
+			Note:          payload.Note,
+			LocationId:    payload.LocationId,
+			Unit:          payload.Unit,
+			ReasonCode:    payload.ReasonCode,
+			RequestedBy:   payload.RequestedBy,
+			SerialNumbers: payload.SerialNumbers,
+		})
+		if err != nil {
+			st := status.Convert(err)
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid correction", "details": st.Message()})
+			case codes.FailedPrecondition:
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "serial unit unavailable", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to correct stock inventory", "details": st.Message()})
+		}
+
+		// corrections over the approval thresholds are accepted but not posted yet
+		if correction.Status == "pending" {
+			return c.Status(fiber.StatusAccepted).JSON(correction)
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(correction)
+	}
+}
+
+func ListCorrections(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var query listCorrectionsQuery
+		if err := c.QueryParser(&query); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid query parameters"})
+		}
+
+		if err := validate.Struct(query); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		res, err := inventoryCLient.ListStockCorrections(c.Context(), &pb.ListStockCorrectionsRequest{
+			Status:    query.Status,
+			ProductId: query.ProductId,
+		})
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to list corrections", "details": status.Convert(err).Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Corrections)
+	}
+}
+
+// approve and reject both take the reviewing user
+func ReviewCorrection(call func(context.Context, *pb.ReviewStockCorrectionRequest, ...grpc.CallOption) (*pb.StockCorrection, error), validate *validator.Validate, message string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		var payload reviewCorrectionDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		correction, err := call(c.Context(), &pb.ReviewStockCorrectionRequest{
+			Id:         id,
+			ReviewedBy: payload.ReviewedBy,
+		})
+		if err != nil {
+			st := status.Convert(err)
+			switch st.Code() {
+			case codes.NotFound:
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "correction not found", "details": st.Message()})
+			case codes.FailedPrecondition:
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": message, "details": st.Message()})
+			case codes.PermissionDenied:
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": message, "details": st.Message()})
+			case codes.InvalidArgument:
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": message, "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": message, "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(correction)
+	}
+}
+
+func ListCorrectionReasons(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		res, err := inventoryCLient.ListCorrectionReasons(c.Context(), &pb.ListCorrectionReasonsRequest{
+			IncludeInactive: c.QueryBool("all", false),
+		})
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to list correction reasons", "details": status.Convert(err).Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Reasons)
+	}
+}
+
+func SaveCorrectionReason(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		code := c.Params("code")
+		if err := validate.Var(code, "required,max=30"); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid reason code given",
+				"details": err.Error(),
+			})
+		}
+
+		var payload correctionReasonDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		active := payload.Active == nil || *payload.Active
+
+		reason, err := inventoryCLient.SaveCorrectionReason(c.Context(), &pb.CorrectionReason{
+			Code:   code,
+			Name:   payload.Name,
+			Active: active,
+		})
+		if err != nil {
+			st := status.Convert(err)
+			if st.Code() == codes.InvalidArgument {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid correction reason", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to save correction reason", "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(reason)
+	}
+}
+
+func Transfer(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		var payload transferDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		transfer, err := inventoryCLient.TransferStock(c.Context(), &pb.TransferStockRequest{
+			ProductId:      id,
+			FromLocationId: payload.FromLocationId,
+			ToLocationId:   payload.ToLocationId,
+			Quantity:       payload.Quantity,
+			Note:           payload.Note,
+			SerialNumbers:  payload.SerialNumbers,
+		})
+		if err != nil {
+			st := status.Convert(err)
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid transfer", "details": st.Message()})
+			case codes.NotFound:
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "location not found", "details": st.Message()})
+			case codes.FailedPrecondition:
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "insufficient stock", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to transfer stock", "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(transfer)
+	}
+}
+
+func MoveState(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		var payload moveStateDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		change, err := inventoryCLient.MoveStockState(c.Context(), &pb.MoveStockStateRequest{
+			ProductId:     id,
+			LocationId:    payload.LocationId,
+			Quantity:      payload.Quantity,
+			FromState:     payload.FromState,
+			ToState:       payload.ToState,
+			Note:          payload.Note,
+			SerialNumbers: payload.SerialNumbers,
+		})
+		if err != nil {
+			st := status.Convert(err)
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid state change", "details": st.Message()})
+			case codes.FailedPrecondition:
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "insufficient stock", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to move stock state", "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(change)
+	}
+}
+
+func GetStockStates(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		res, err := inventoryCLient.GetProductStockStates(c.Context(), &pb.ProductStockStatesRequest{
 			ProductId: id,
-			Quantity:  payload.Quantity,
-			Note:      payload.Note,
 		})
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to correct stock inventory", "details": status.Convert(err).Message()})
+			st := status.Convert(err)
+			if st.Code() == codes.NotFound {
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "product not found", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get stock states", "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func Assemble(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		var payload assembleDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		assembly, err := inventoryCLient.AssembleKit(c.Context(), &pb.AssembleKitRequest{
+			KitId:      id,
+			LocationId: payload.LocationId,
+			Quantity:   payload.Quantity,
+			Note:       payload.Note,
+		})
+		if err != nil {
+			st := status.Convert(err)
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid assembly", "details": st.Message()})
+			case codes.FailedPrecondition:
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "cannot assemble kit", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to assemble kit", "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(assembly)
+	}
+}
+
+func CreateLocation(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var payload createLocationDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		location, err := inventoryCLient.CreateLocation(c.Context(), &pb.CreateLocationRequest{
+			Code: payload.Code,
+			Name: payload.Name,
+		})
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to create location", "details": status.Convert(err).Message()})
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(location)
+	}
+}
+
+func ListLocations(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		res, err := inventoryCLient.ListLocations(c.Context(), &pb.ListLocationsRequest{})
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to list locations", "details": status.Convert(err).Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Locations)
+	}
+}
+
+func GetLocationStock(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		res, err := inventoryCLient.GetLocationStock(c.Context(), &pb.LocationIdRequest{
+			Id: id,
+		})
+		if err != nil {
+			st := status.Convert(err)
+			if st.Code() == codes.NotFound {
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "location not found", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get location stock", "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func ListBatches(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		locationId, err := strconv.ParseInt(c.Query("location_id", "0"), 10, 64)
+		if err != nil || locationId < 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid location id",
+				"details": "location_id must be a positive integer",
+			})
+		}
+
+		res, err := inventoryCLient.ListStockBatches(c.Context(), &pb.ListStockBatchesRequest{
+			ProductId:    id,
+			LocationId:   locationId,
+			IncludeEmpty: c.QueryBool("include_empty", false),
+		})
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to list batches", "details": status.Convert(err).Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Batches)
+	}
+}
+
+func ListExpiringBatches(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		days, err := strconv.ParseInt(c.Query("days", "30"), 10, 64)
+		if err != nil || days < 1 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid days",
+				"details": "days must be a positive integer",
+			})
+		}
+
+		locationId, err := strconv.ParseInt(c.Query("location_id", "0"), 10, 64)
+		if err != nil || locationId < 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid location id",
+				"details": "location_id must be a positive integer",
+			})
+		}
+
+		res, err := inventoryCLient.ListExpiringBatches(c.Context(), &pb.ListExpiringBatchesRequest{
+			Days:       days,
+			LocationId: locationId,
+		})
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to list expiring batches", "details": status.Convert(err).Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Batches)
+	}
+}
+
+func ListSerialUnits(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var query listSerialUnitsQuery
+		if err := c.QueryParser(&query); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid query parameters"})
+		}
+
+		if err := validate.Struct(query); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		res, err := inventoryCLient.ListSerialUnits(c.Context(), &pb.ListSerialUnitsRequest{
+			ProductId:  query.ProductId,
+			LocationId: query.LocationId,
+			Status:     query.Status,
+		})
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to list serial units", "details": status.Convert(err).Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Units)
+	}
+}
+
+func GetSerialHistory(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		productId, err := strconv.ParseInt(c.Query("product_id", "0"), 10, 64)
+		if err != nil || productId < 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid product id",
+				"details": "product_id must be a positive integer",
+			})
+		}
+
+		res, err := inventoryCLient.GetSerialHistory(c.Context(), &pb.SerialHistoryRequest{
+			SerialNumber: c.Params("serial"),
+			ProductId:    productId,
+		})
+		if err != nil {
+			st := status.Convert(err)
+			if st.Code() == codes.NotFound {
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "serial number not found", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get serial history", "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Units)
+	}
+}
+
+func ListMovements(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var query listMovementsQuery
+		if err := c.QueryParser(&query); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid query parameters"})
+		}
+
+		if err := validate.Struct(query); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		res, err := inventoryCLient.ListStockMovements(c.Context(), &pb.ListStockMovementsRequest{
+			ProductId:  query.ProductId,
+			LocationId: query.LocationId,
+			Type:       query.Type,
+			Reference:  query.Reference,
+			From:       query.From,
+			To:         query.To,
+			Cursor:     query.Cursor,
+			Limit:      query.Limit,
+			SortOrder:  query.Sort,
+		})
+		if err != nil {
+			st := status.Convert(err)
+			if st.Code() == codes.InvalidArgument {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid query parameters", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to list stock movements", "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{
+			"records":     res.Records,
+			"next_cursor": res.NextCursor,
+			"total_count": res.TotalCount,
+		})
+	}
+}
+
+func parseProductIds(ids string) ([]int64, error) {
+	var productIds []int64
+	if ids == "" {
+		return productIds, nil
+	}
+
+	for _, idParam := range strings.Split(ids, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(idParam), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if id < 1 {
+			return nil, fmt.Errorf("product id %d is not positive", id)
+		}
+		productIds = append(productIds, id)
+	}
+
+	return productIds, nil
+}
+
+func GetStockAsOf(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		at := c.Query("at")
+		if at == "" {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "missing at",
+				"details": "at must be an RFC 3339 timestamp or YYYY-MM-DD",
+			})
+		}
+
+		productIds, err := parseProductIds(c.Query("product_ids"))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid product ids",
+				"details": "product_ids must be a comma separated list of positive integers",
+			})
+		}
+
+		locationId, err := strconv.ParseInt(c.Query("location_id", "0"), 10, 64)
+		if err != nil || locationId < 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid location id",
+				"details": "location_id must be a positive integer",
+			})
+		}
+
+		res, err := inventoryCLient.GetStockAsOf(c.Context(), &pb.StockAsOfRequest{
+			ProductIds: productIds,
+			At:         at,
+			LocationId: locationId,
+		})
+		if err != nil {
+			st := status.Convert(err)
+			if st.Code() == codes.InvalidArgument {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid at", "details": st.Message()})
+			}
+			if st.Code() == codes.FailedPrecondition {
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "stock history unavailable", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get stock", "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func GetValuation(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		productIds, err := parseProductIds(c.Query("product_ids"))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid product ids",
+				"details": "product_ids must be a comma separated list of positive integers",
+			})
+		}
+
+		res, err := inventoryCLient.GetInventoryValuation(c.Context(), &pb.InventoryValuationRequest{
+			ProductIds: productIds,
+			From:       c.Query("from"),
+			To:         c.Query("to"),
+		})
+		if err != nil {
+			st := status.Convert(err)
+			if st.Code() == codes.InvalidArgument {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid period", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get valuation", "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func Reconcile(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var payload reconcileDto
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&payload); err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+			}
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		res, err := inventoryCLient.ReconcileStock(c.Context(), &pb.ReconcileStockRequest{
+			ProductIds: payload.ProductIds,
+			Repair:     payload.Repair,
+		})
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to reconcile stock", "details": status.Convert(err).Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res)
+	}
+}
+
+func ReverseMovement(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		var payload reverseDto
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&payload); err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+			}
+		}
+
+		record, err := inventoryCLient.ReverseStockMovement(c.Context(), &pb.ReverseStockMovementRequest{
+			Id:   id,
+			Note: payload.Note,
+		})
+		if err != nil {
+			st := status.Convert(err)
+			switch st.Code() {
+			case codes.NotFound:
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "movement not found", "details": st.Message()})
+			case codes.FailedPrecondition:
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "movement cannot be reversed", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to reverse movement", "details": st.Message()})
 		}
 
 		return c.Status(fiber.StatusCreated).JSON(record)
 	}
 }
+
+func cycleCountError(c *fiber.Ctx, err error, message string) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.NotFound:
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "cycle count not found", "details": st.Message()})
+	case codes.FailedPrecondition:
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": message, "details": st.Message()})
+	case codes.InvalidArgument:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": message, "details": st.Message()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": message, "details": st.Message()})
+}
+
+func OpenCycleCount(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var payload openCountDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		count, err := inventoryCLient.OpenCycleCount(c.Context(), &pb.OpenCycleCountRequest{
+			ProductIds:  payload.ProductIds,
+			LocationIds: payload.LocationIds,
+			Note:        payload.Note,
+		})
+		if err != nil {
+			return cycleCountError(c, err, "failed to open cycle count")
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(count)
+	}
+}
+
+func ListCycleCounts(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		res, err := inventoryCLient.ListCycleCounts(c.Context(), &pb.ListCycleCountsRequest{
+			Status: c.Query("status"),
+		})
+		if err != nil {
+			return cycleCountError(c, err, "failed to list cycle counts")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Counts)
+	}
+}
+
+// get, submit, post and cancel only take the count id
+func CycleCountAction(call func(context.Context, *pb.CycleCountIdRequest, ...grpc.CallOption) (*pb.CycleCount, error), message string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		count, err := call(c.Context(), &pb.CycleCountIdRequest{Id: id})
+		if err != nil {
+			return cycleCountError(c, err, message)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(count)
+	}
+}
+
+func RecordCycleCount(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		var payload recordCountDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		entries := make([]*pb.CountedQuantity, len(payload.Entries))
+		for i, entry := range payload.Entries {
+			entries[i] = &pb.CountedQuantity{
+				ProductId:  entry.ProductId,
+				LocationId: entry.LocationId,
+				Quantity:   entry.Quantity,
+			}
+		}
+
+		count, err := inventoryCLient.RecordCycleCount(c.Context(), &pb.RecordCycleCountRequest{
+			CountId: id,
+			Counter: payload.Counter,
+			Entries: entries,
+		})
+		if err != nil {
+			return cycleCountError(c, err, "failed to record counted quantities")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(count)
+	}
+}
+
+func ApproveCycleCount(inventoryCLient pb.InventoryServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		var payload approveCountDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		count, err := inventoryCLient.ApproveCycleCount(c.Context(), &pb.ApproveCycleCountRequest{
+			CountId: id,
+			LineIds: payload.LineIds,
+			All:     payload.All,
+		})
+		if err != nil {
+			return cycleCountError(c, err, "failed to approve cycle count")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(count)
+	}
+}
+
+func ListAlerts(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		productId, err := strconv.ParseInt(c.Query("product_id", "0"), 10, 64)
+		if err != nil || productId < 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid product id",
+				"details": "product_id must be a positive integer",
+			})
+		}
+
+		res, err := inventoryCLient.ListStockAlerts(c.Context(), &pb.ListStockAlertsRequest{
+			ProductId: productId,
+			Status:    c.Query("status"),
+		})
+		if err != nil {
+			st := status.Convert(err)
+			if st.Code() == codes.InvalidArgument {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid status", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to list alerts", "details": st.Message()})
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Alerts)
+	}
+}
+
+// pushes alerts to the client as server-sent events for as long as the
+// connection stays open
+func StreamAlerts(inventoryCLient pb.InventoryServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		stream, err := inventoryCLient.SubscribeStockAlerts(ctx, &pb.SubscribeStockAlertsRequest{})
+		if err != nil {
+			cancel()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to subscribe to alerts", "details": status.Convert(err).Message()})
+		}
+
+		c.Set("Content-Type", "text/event-stream")
+		c.Set("Cache-Control", "no-cache")
+		c.Set("Connection", "keep-alive")
+
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			defer cancel()
+
+			for {
+				alert, err := stream.Recv()
+				if err != nil {
+					return
+				}
+
+				data, err := json.Marshal(alert)
+				if err != nil {
+					continue
+				}
+
+				fmt.Fprintf(w, "event: %s\ndata: %s\n\n", alert.Type, data)
+				if err := w.Flush(); err != nil {
+					return
+				}
+			}
+		})
+
+		return nil
+	}
+}
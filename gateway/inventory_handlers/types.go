@@ -1,6 +1,113 @@
 package inventory_handlers
 
 type manageDto struct {
-	Quantity int64  `json:"quantity" validate:"required,gt=0"`
-	Note     string `json:"note"`
+	Quantity      int64    `json:"quantity" validate:"required,gt=0"`
+	Unit          string   `json:"unit" validate:"omitempty,alphanum,lowercase,max=20"`
+	Note          string   `json:"note"`
+	LocationId    int64    `json:"location_id" validate:"gte=0"`
+	ReasonCode    string   `json:"reason_code" validate:"required,max=30"`
+	RequestedBy   string   `json:"requested_by" validate:"required,max=100"`
+	SerialNumbers []string `json:"serial_numbers" validate:"omitempty,dive,required,max=100"`
+}
+
+type supplyDto struct {
+	Quantity      int64    `json:"quantity" validate:"required,gt=0"`
+	Unit          string   `json:"unit" validate:"omitempty,alphanum,lowercase,max=20"`
+	Note          string   `json:"note"`
+	LocationId    int64    `json:"location_id" validate:"gte=0"`
+	LotNumber     string   `json:"lot_number" validate:"max=100"`
+	ExpiresAt     string   `json:"expires_at" validate:"omitempty,datetime=2006-01-02"`
+	SerialNumbers []string `json:"serial_numbers" validate:"omitempty,dive,required,max=100"`
+	UnitCost      float64  `json:"unit_cost" validate:"gte=0"`
+}
+
+type createLocationDto struct {
+	Code string `json:"code" validate:"required,max=50"`
+	Name string `json:"name" validate:"required"`
+}
+
+type transferDto struct {
+	FromLocationId int64    `json:"from_location_id" validate:"required,gt=0"`
+	ToLocationId   int64    `json:"to_location_id" validate:"required,gt=0,nefield=FromLocationId"`
+	Quantity       int64    `json:"quantity" validate:"required,gt=0"`
+	Note           string   `json:"note"`
+	SerialNumbers  []string `json:"serial_numbers" validate:"omitempty,dive,required,max=100"`
+}
+
+type moveStateDto struct {
+	FromState     string   `json:"from_state" validate:"required,oneof=available quarantined damaged in_transit"`
+	ToState       string   `json:"to_state" validate:"required,oneof=available quarantined damaged in_transit,nefield=FromState"`
+	Quantity      int64    `json:"quantity" validate:"required,gt=0"`
+	LocationId    int64    `json:"location_id" validate:"gte=0"`
+	Note          string   `json:"note"`
+	SerialNumbers []string `json:"serial_numbers" validate:"omitempty,dive,required,max=100"`
+}
+
+type assembleDto struct {
+	LocationId int64  `json:"location_id" validate:"gte=0"`
+	Quantity   int64  `json:"quantity" validate:"required,gt=0"`
+	Note       string `json:"note"`
+}
+
+type listSerialUnitsQuery struct {
+	ProductId  int64  `query:"product_id" validate:"required,gt=0"`
+	LocationId int64  `query:"location_id" validate:"gte=0"`
+	Status     string `query:"status" validate:"omitempty,oneof=in_stock sold returned quarantined damaged in_transit scrapped"`
+}
+
+type listMovementsQuery struct {
+	ProductId  int64  `query:"product_id" validate:"gte=0"`
+	LocationId int64  `query:"location_id" validate:"gte=0"`
+	Type       string `query:"type" validate:"omitempty,oneof=purchase supply correction restock transfer_out transfer_in reversal assembly_out assembly_in return state_out state_in"`
+	Reference  string `query:"reference" validate:"max=100"`
+	From       string `query:"from"`
+	To         string `query:"to"`
+	Cursor     string `query:"cursor"`
+	Limit      int64  `query:"limit" validate:"gte=0,lte=500"`
+	Sort       string `query:"sort" validate:"omitempty,oneof=asc desc"`
+}
+
+type reconcileDto struct {
+	ProductIds []int64 `json:"product_ids" validate:"omitempty,dive,gt=0"`
+	Repair     bool    `json:"repair"`
+}
+
+type reverseDto struct {
+	Note string `json:"note"`
+}
+
+type openCountDto struct {
+	ProductIds  []int64 `json:"product_ids" validate:"required_without=LocationIds,omitempty,dive,gt=0"`
+	LocationIds []int64 `json:"location_ids" validate:"required_without=ProductIds,omitempty,dive,gt=0"`
+	Note        string  `json:"note"`
+}
+
+type countedQuantityDto struct {
+	ProductId  int64 `json:"product_id" validate:"required,gt=0"`
+	LocationId int64 `json:"location_id" validate:"gte=0"`
+	Quantity   int64 `json:"quantity" validate:"gte=0"`
+}
+
+type recordCountDto struct {
+	Counter string               `json:"counter" validate:"required,max=100"`
+	Entries []countedQuantityDto `json:"entries" validate:"required,min=1,dive"`
+}
+
+type approveCountDto struct {
+	LineIds []int64 `json:"line_ids" validate:"required_without=All,omitempty,dive,gt=0"`
+	All     bool    `json:"all"`
+}
+
+type reviewCorrectionDto struct {
+	ReviewedBy string `json:"reviewed_by" validate:"required,max=100"`
+}
+
+type listCorrectionsQuery struct {
+	Status    string `query:"status" validate:"omitempty,oneof=pending posted rejected"`
+	ProductId int64  `query:"product_id" validate:"gte=0"`
+}
+
+type correctionReasonDto struct {
+	Name   string `json:"name" validate:"required,max=100"`
+	Active *bool  `json:"active"`
 }
@@ -52,7 +52,15 @@ func main() {
 
 	ordersClient := protobuf.NewOrdersServiceClient(ordersClientConn)
 
-	registerHandlers(app, productsClient, inventoryClient, ordersClient)
+	suppliersClientConn, err := grpcservice.GetGRPCConnection(consulClient, "suppliers-grpc-service")
+	if err != nil {
+		Logger.FatalLog("get suppliers client connection", "failed to get gRPC connection: %v", err)
+	}
+	defer suppliersClientConn.Close()
+
+	suppliersClient := protobuf.NewSuppliersServiceClient(suppliersClientConn)
+
+	registerHandlers(app, productsClient, inventoryClient, ordersClient, suppliersClient)
 
 	if err := app.Listen(":" + port); err != nil {
 		Logger.FatalLog("http server init", "failed to start HTTP server: %v", err)
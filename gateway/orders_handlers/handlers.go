@@ -1,15 +1,20 @@
 package orders_handlers
 
 import (
+	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"github.com/logan2k02/ims/gateway/products_handlers"
 	pb "github.com/logan2k02/ims/shared/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-func CreateOrderHandler(ordersClient pb.OrdersServiceClient, validate *validator.Validate) fiber.Handler {
+func CreateOrderHandler(ordersClient pb.OrdersServiceClient, productsClient pb.ProductsServiceClient, validate *validator.Validate) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		var payload createOrderDto
 		if err := c.BodyParser(&payload); err != nil {
@@ -23,11 +28,21 @@ func CreateOrderHandler(ordersClient pb.OrdersServiceClient, validate *validator
 			})
 		}
 
+		for i, item := range payload.Items {
+			if ok, err := products_handlers.UnitExists(c.Context(), productsClient, item.ProductId, item.Unit); err != nil {
+				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to look up unit", "details": status.Convert(err).Message()})
+			} else if !ok {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid order item", "details": fmt.Sprintf("line %d: product %d has no unit %s", i+1, item.ProductId, item.Unit)})
+			}
+		}
+
 		var items []*pb.OrderItem
 		for _, item := range payload.Items {
 			items = append(items, &pb.OrderItem{
 				ProductId: item.ProductId,
 				Quantity:  item.Quantity,
+				Unit:      item.Unit,
+				Discount:  item.Discount,
 			})
 		}
 
@@ -36,16 +51,35 @@ func CreateOrderHandler(ordersClient pb.OrdersServiceClient, validate *validator
 			PaymentReference: payload.PaymentReference,
 			CustomerName:     payload.CustomerName,
 			CustomerContact:  payload.CustomerContact,
+			CouponCodes:      payload.CouponCodes,
 		})
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to create order", "details": status.Convert(err).Message()})
+			st := status.Convert(err)
+			switch st.Code() {
+			case codes.FailedPrecondition:
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "order cannot be placed", "details": st.Message()})
+			case codes.InvalidArgument:
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid order item", "details": st.Message()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to create order", "details": st.Message()})
 		}
 
 		var orderResItems []orderItem
 		for _, item := range orderRes.Items {
 			orderResItems = append(orderResItems, orderItem{
-				ProductId: item.ProductId,
-				Quantity:  item.Quantity,
+				Id:               item.Id,
+				ProductId:        item.ProductId,
+				Quantity:         item.Quantity,
+				Unit:             item.Unit,
+				BaseQuantity:     item.BaseQuantity,
+				ReturnedQuantity: item.ReturnedQuantity,
+				ShippedQuantity:  item.ShippedQuantity,
+				UnitPrice:        item.UnitPrice,
+				Subtotal:         item.Subtotal,
+				Discount:         item.Discount,
+				TaxRate:          item.TaxRate,
+				Tax:              item.Tax,
+				Total:            item.Total,
 			})
 		}
 
@@ -57,10 +91,29 @@ func CreateOrderHandler(ordersClient pb.OrdersServiceClient, validate *validator
 			CustomerContact:  orderRes.CustomerContact,
 			Status:           orderRes.Status,
 			CreatedAt:        orderRes.CreatedAt,
+			Subtotal:         orderRes.Subtotal,
+			DiscountTotal:    orderRes.DiscountTotal,
+			TaxTotal:         orderRes.TaxTotal,
+			GrandTotal:       orderRes.GrandTotal,
+			Discounts:        orderDiscounts(orderRes.Discounts),
 		})
 	}
 }
 
+func orderDiscounts(discounts []*pb.OrderDiscount) []orderDiscount {
+	res := make([]orderDiscount, len(discounts))
+	for i, d := range discounts {
+		res[i] = orderDiscount{
+			PromotionId: d.PromotionId,
+			Code:        d.Code,
+			Name:        d.Name,
+			OrderItemId: d.OrderItemId,
+			Amount:      d.Amount,
+		}
+	}
+	return res
+}
+
 func GetOrderHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		id := c.Params("id", "")
@@ -89,8 +142,19 @@ func GetOrderHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
 		var items []orderItem
 		for _, item := range orderRes.Items {
 			items = append(items, orderItem{
-				ProductId: item.ProductId,
-				Quantity:  item.Quantity,
+				Id:               item.Id,
+				ProductId:        item.ProductId,
+				Quantity:         item.Quantity,
+				Unit:             item.Unit,
+				BaseQuantity:     item.BaseQuantity,
+				ReturnedQuantity: item.ReturnedQuantity,
+				ShippedQuantity:  item.ShippedQuantity,
+				UnitPrice:        item.UnitPrice,
+				Subtotal:         item.Subtotal,
+				Discount:         item.Discount,
+				TaxRate:          item.TaxRate,
+				Tax:              item.Tax,
+				Total:            item.Total,
 			})
 		}
 
@@ -102,6 +166,11 @@ func GetOrderHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
 			CustomerContact:  orderRes.CustomerContact,
 			Status:           orderRes.Status,
 			CreatedAt:        orderRes.CreatedAt,
+			Subtotal:         orderRes.Subtotal,
+			DiscountTotal:    orderRes.DiscountTotal,
+			TaxTotal:         orderRes.TaxTotal,
+			GrandTotal:       orderRes.GrandTotal,
+			Discounts:        orderDiscounts(orderRes.Discounts),
 		})
 	}
 }
@@ -140,8 +209,19 @@ func ListOrdersHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
 			var items []orderItem
 			for _, item := range o.Items {
 				items = append(items, orderItem{
-					ProductId: item.ProductId,
-					Quantity:  item.Quantity,
+					Id:               item.Id,
+					ProductId:        item.ProductId,
+					Quantity:         item.Quantity,
+					Unit:             item.Unit,
+					BaseQuantity:     item.BaseQuantity,
+					ReturnedQuantity: item.ReturnedQuantity,
+					ShippedQuantity:  item.ShippedQuantity,
+					UnitPrice:        item.UnitPrice,
+					Subtotal:         item.Subtotal,
+					Discount:         item.Discount,
+					TaxRate:          item.TaxRate,
+					Tax:              item.Tax,
+					Total:            item.Total,
 				})
 			}
 			orders = append(orders, order{
@@ -152,6 +232,11 @@ func ListOrdersHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
 				CustomerContact:  o.CustomerContact,
 				Status:           o.Status,
 				CreatedAt:        o.CreatedAt,
+				Subtotal:         o.Subtotal,
+				DiscountTotal:    o.DiscountTotal,
+				TaxTotal:         o.TaxTotal,
+				GrandTotal:       o.GrandTotal,
+				Discounts:        orderDiscounts(o.Discounts),
 			})
 		}
 
@@ -190,18 +275,31 @@ func ChangeOrderStatusHandler(ordersClient pb.OrdersServiceClient, validate *val
 		}
 
 		orderRes, err := ordersClient.ChangeOrderStatus(c.Context(), &pb.ChangeOrderStatusRequest{
-			Id:     orderId,
-			Status: payload.Status,
+			Id:        orderId,
+			Status:    payload.Status,
+			ChangedBy: payload.ChangedBy,
+			Note:      payload.Note,
 		})
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to change order status", "details": status.Convert(err).Message()})
+			return ordersError(c, err, "failed to change order status")
 		}
 
 		var items []orderItem
 		for _, item := range orderRes.Items {
 			items = append(items, orderItem{
-				ProductId: item.ProductId,
-				Quantity:  item.Quantity,
+				Id:               item.Id,
+				ProductId:        item.ProductId,
+				Quantity:         item.Quantity,
+				Unit:             item.Unit,
+				BaseQuantity:     item.BaseQuantity,
+				ReturnedQuantity: item.ReturnedQuantity,
+				ShippedQuantity:  item.ShippedQuantity,
+				UnitPrice:        item.UnitPrice,
+				Subtotal:         item.Subtotal,
+				Discount:         item.Discount,
+				TaxRate:          item.TaxRate,
+				Tax:              item.Tax,
+				Total:            item.Total,
 			})
 		}
 
@@ -213,7 +311,44 @@ func ChangeOrderStatusHandler(ordersClient pb.OrdersServiceClient, validate *val
 			CustomerContact:  orderRes.CustomerContact,
 			Status:           orderRes.Status,
 			CreatedAt:        orderRes.CreatedAt,
+			Subtotal:         orderRes.Subtotal,
+			DiscountTotal:    orderRes.DiscountTotal,
+			TaxTotal:         orderRes.TaxTotal,
+			GrandTotal:       orderRes.GrandTotal,
+			Discounts:        orderDiscounts(orderRes.Discounts),
+		})
+	}
+}
+
+func OrderStatusHistoryHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orderId, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid order ID",
+				"details": "order ID must be an integer",
+			})
+		}
+
+		historyRes, err := ordersClient.GetOrderStatusHistory(c.Context(), &pb.OrderIdRequest{
+			Id: orderId,
 		})
+		if err != nil {
+			return ordersError(c, err, "failed to get order status history")
+		}
+
+		changes := make([]orderStatusChange, len(historyRes.Changes))
+		for i, change := range historyRes.Changes {
+			changes[i] = orderStatusChange{
+				FromStatus: change.FromStatus,
+				ToStatus:   change.ToStatus,
+				ChangedBy:  change.ChangedBy,
+				Note:       change.Note,
+				ChangedAt:  change.ChangedAt,
+			}
+		}
+
+		return c.Status(fiber.StatusOK).JSON(changes)
 	}
 }
 
@@ -244,3 +379,362 @@ func DeleteOrderHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
 		return c.SendStatus(fiber.StatusNoContent)
 	}
 }
+
+func ordersError(c *fiber.Ctx, err error, message string) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.NotFound:
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": message, "details": st.Message()})
+	case codes.FailedPrecondition:
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": message, "details": st.Message()})
+	case codes.InvalidArgument:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": message, "details": st.Message()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": message, "details": st.Message()})
+}
+
+func CreateReturnHandler(ordersClient pb.OrdersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orderId, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid order ID",
+				"details": "order ID must be an integer",
+			})
+		}
+
+		var payload createReturnDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		lines := make([]*pb.ReturnLineRequest, len(payload.Lines))
+		for i, line := range payload.Lines {
+			lines[i] = &pb.ReturnLineRequest{
+				OrderItemId: line.OrderItemId,
+				Quantity:    line.Quantity,
+			}
+		}
+
+		ret, err := ordersClient.CreateReturn(c.Context(), &pb.CreateReturnRequest{
+			OrderId: orderId,
+			Lines:   lines,
+			Reason:  payload.Reason,
+			Note:    payload.Note,
+		})
+		if err != nil {
+			return ordersError(c, err, "failed to create return")
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(ret)
+	}
+}
+
+func ListReturnsHandler(ordersClient pb.OrdersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var query listReturnsQuery
+		if err := c.QueryParser(&query); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid query parameters"})
+		}
+
+		if err := validate.Struct(&query); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		res, err := ordersClient.ListReturns(c.Context(), &pb.ListReturnsRequest{
+			OrderId: query.OrderId,
+			Status:  query.Status,
+		})
+		if err != nil {
+			return ordersError(c, err, "failed to list returns")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Returns)
+	}
+}
+
+// get, receive and cancel only take the return id
+func ReturnActionHandler(call func(context.Context, *pb.ReturnIdRequest, ...grpc.CallOption) (*pb.Return, error), message string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid return ID",
+				"details": "return ID must be an integer",
+			})
+		}
+
+		ret, err := call(c.Context(), &pb.ReturnIdRequest{Id: id})
+		if err != nil {
+			return ordersError(c, err, message)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(ret)
+	}
+}
+
+func DispositionReturnHandler(ordersClient pb.OrdersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid return ID",
+				"details": "return ID must be an integer",
+			})
+		}
+
+		var payload dispositionReturnDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		lines := make([]*pb.LineDisposition, len(payload.Lines))
+		for i, line := range payload.Lines {
+			lines[i] = &pb.LineDisposition{
+				LineId:        line.LineId,
+				Disposition:   line.Disposition,
+				LocationId:    line.LocationId,
+				SerialNumbers: line.SerialNumbers,
+			}
+		}
+
+		ret, err := ordersClient.DispositionReturn(c.Context(), &pb.DispositionReturnRequest{
+			Id:    id,
+			Lines: lines,
+		})
+		if err != nil {
+			return ordersError(c, err, "failed to disposition return")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(ret)
+	}
+}
+
+func CreateShipmentHandler(ordersClient pb.OrdersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orderId, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid order ID",
+				"details": "order ID must be an integer",
+			})
+		}
+
+		var payload createShipmentDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		lines := make([]*pb.ShipmentLineRequest, len(payload.Lines))
+		for i, line := range payload.Lines {
+			lines[i] = &pb.ShipmentLineRequest{
+				OrderItemId: line.OrderItemId,
+				Quantity:    line.Quantity,
+			}
+		}
+
+		shipment, err := ordersClient.CreateShipment(c.Context(), &pb.CreateShipmentRequest{
+			OrderId:        orderId,
+			Carrier:        payload.Carrier,
+			TrackingNumber: payload.TrackingNumber,
+			ShippedBy:      payload.ShippedBy,
+			Lines:          lines,
+		})
+		if err != nil {
+			return ordersError(c, err, "failed to create shipment")
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(shipment)
+	}
+}
+
+func ListShipmentsHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orderId, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid order ID",
+				"details": "order ID must be an integer",
+			})
+		}
+
+		shipmentsRes, err := ordersClient.ListShipments(c.Context(), &pb.OrderIdRequest{Id: orderId})
+		if err != nil {
+			return ordersError(c, err, "failed to list shipments")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(shipmentsRes.Shipments)
+	}
+}
+
+func GetShipmentHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid shipment ID",
+				"details": "shipment ID must be an integer",
+			})
+		}
+
+		shipment, err := ordersClient.GetShipment(c.Context(), &pb.ShipmentIdRequest{Id: id})
+		if err != nil {
+			return ordersError(c, err, "failed to get shipment")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(shipment)
+	}
+}
+
+func (p *promotionDto) toProto(id int64) *pb.Promotion {
+	active := true
+	if p.Active != nil {
+		active = *p.Active
+	}
+
+	return &pb.Promotion{
+		Id:            id,
+		Code:          p.Code,
+		Name:          p.Name,
+		Type:          p.Type,
+		Percentage:    p.Percentage,
+		Amount:        p.Amount,
+		ProductId:     p.ProductId,
+		BuyQuantity:   p.BuyQuantity,
+		GetQuantity:   p.GetQuantity,
+		MinQuantity:   p.MinQuantity,
+		MinOrderTotal: p.MinOrderTotal,
+		UsageLimit:    p.UsageLimit,
+		StartsAt:      p.StartsAt,
+		EndsAt:        p.EndsAt,
+		Active:        active,
+	}
+}
+
+func CreatePromotionHandler(ordersClient pb.OrdersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var payload promotionDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		promotion, err := ordersClient.CreatePromotion(c.Context(), payload.toProto(0))
+		if err != nil {
+			return ordersError(c, err, "failed to create promotion")
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(promotion)
+	}
+}
+
+func UpdatePromotionHandler(ordersClient pb.OrdersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid promotion ID",
+				"details": "promotion ID must be an integer",
+			})
+		}
+
+		var payload promotionDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		promotion, err := ordersClient.UpdatePromotion(c.Context(), payload.toProto(id))
+		if err != nil {
+			return ordersError(c, err, "failed to update promotion")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(promotion)
+	}
+}
+
+func GetPromotionHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid promotion ID",
+				"details": "promotion ID must be an integer",
+			})
+		}
+
+		promotion, err := ordersClient.GetPromotion(c.Context(), &pb.PromotionIdRequest{Id: id})
+		if err != nil {
+			return ordersError(c, err, "failed to get promotion")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(promotion)
+	}
+}
+
+func ListPromotionsHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		res, err := ordersClient.ListPromotions(c.Context(), &pb.ListPromotionsRequest{
+			ActiveOnly: c.QueryBool("active", false),
+		})
+		if err != nil {
+			return ordersError(c, err, "failed to list promotions")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Promotions)
+	}
+}
+
+func DeletePromotionHandler(ordersClient pb.OrdersServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseInt(c.Params("id", ""), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid promotion ID",
+				"details": "promotion ID must be an integer",
+			})
+		}
+
+		if _, err := ordersClient.DeletePromotion(c.Context(), &pb.PromotionIdRequest{Id: id}); err != nil {
+			return ordersError(c, err, "failed to delete promotion")
+		}
+
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
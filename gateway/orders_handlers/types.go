@@ -1,8 +1,19 @@
 package orders_handlers
 
 type orderItem struct {
-	ProductId int64 `json:"product_id" validate:"required"`
-	Quantity  int64 `json:"quantity" validate:"required,gt=0"`
+	Id               int64  `json:"id"`
+	ProductId        int64  `json:"product_id" validate:"required"`
+	Quantity         int64  `json:"quantity" validate:"required,gt=0"`
+	Unit             string `json:"unit" validate:"omitempty,alphanum,lowercase,max=20"`
+	BaseQuantity     int64  `json:"base_quantity"`
+	ReturnedQuantity int64  `json:"returned_quantity"`
+	ShippedQuantity  int64  `json:"shipped_quantity"`
+	UnitPrice        string `json:"unit_price"`
+	Subtotal         string `json:"subtotal"`
+	Discount         string `json:"discount" validate:"omitempty,numeric"`
+	TaxRate          string `json:"tax_rate"`
+	Tax              string `json:"tax"`
+	Total            string `json:"total"`
 }
 
 type createOrderDto struct {
@@ -10,18 +21,98 @@ type createOrderDto struct {
 	CustomerContact  string      `json:"customer_contact" validate:"required"`
 	Items            []orderItem `json:"items" validate:"required,dive"`
 	PaymentReference string      `json:"payment_reference" validate:"required"`
+	CouponCodes      []string    `json:"coupon_codes" validate:"omitempty,dive,required,max=50"`
 }
 
 type changeOrderStatusDto struct {
-	Status string `json:"status" validate:"required,oneof=pending completed cancelled"`
+	Status    string `json:"status" validate:"required,oneof=confirmed picking shipped delivered cancelled"`
+	ChangedBy string `json:"changed_by" validate:"required,max=100"`
+	Note      string `json:"note"`
+}
+
+type orderStatusChange struct {
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	ChangedBy  string `json:"changed_by"`
+	Note       string `json:"note"`
+	ChangedAt  string `json:"changed_at"`
 }
 
 type order struct {
-	Id               int64       `json:"id"`
-	PaymentReference string      `json:"payment_reference"`
-	CustomerName     string      `json:"customer_name"`
-	CustomerContact  string      `json:"customer_contact"`
-	Status           string      `json:"status"`
-	CreatedAt        string      `json:"created_at"`
-	Items            []orderItem `json:"items"`
+	Id               int64           `json:"id"`
+	PaymentReference string          `json:"payment_reference"`
+	CustomerName     string          `json:"customer_name"`
+	CustomerContact  string          `json:"customer_contact"`
+	Status           string          `json:"status"`
+	CreatedAt        string          `json:"created_at"`
+	Items            []orderItem     `json:"items"`
+	Subtotal         string          `json:"subtotal"`
+	DiscountTotal    string          `json:"discount_total"`
+	TaxTotal         string          `json:"tax_total"`
+	GrandTotal       string          `json:"grand_total"`
+	Discounts        []orderDiscount `json:"discounts"`
+}
+
+type orderDiscount struct {
+	PromotionId int64  `json:"promotion_id"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	OrderItemId int64  `json:"order_item_id"`
+	Amount      string `json:"amount"`
+}
+
+type returnLineDto struct {
+	OrderItemId int64 `json:"order_item_id" validate:"required,gt=0"`
+	Quantity    int64 `json:"quantity" validate:"required,gt=0"`
+}
+
+type createReturnDto struct {
+	Reason string          `json:"reason" validate:"required,max=255"`
+	Note   string          `json:"note"`
+	Lines  []returnLineDto `json:"lines" validate:"required,min=1,unique=OrderItemId,dive"`
+}
+
+type listReturnsQuery struct {
+	OrderId int64  `query:"order_id" validate:"gte=0"`
+	Status  string `query:"status" validate:"omitempty,oneof=requested received closed cancelled"`
+}
+
+type lineDispositionDto struct {
+	LineId        int64    `json:"line_id" validate:"required,gt=0"`
+	Disposition   string   `json:"disposition" validate:"required,oneof=restock scrap"`
+	LocationId    int64    `json:"location_id" validate:"gte=0"`
+	SerialNumbers []string `json:"serial_numbers" validate:"omitempty,dive,required,max=100"`
+}
+
+type dispositionReturnDto struct {
+	Lines []lineDispositionDto `json:"lines" validate:"required,min=1,unique=LineId,dive"`
+}
+
+type shipmentLineDto struct {
+	OrderItemId int64 `json:"order_item_id" validate:"required,gt=0"`
+	Quantity    int64 `json:"quantity" validate:"required,gt=0"`
+}
+
+type createShipmentDto struct {
+	Carrier        string            `json:"carrier" validate:"required,max=100"`
+	TrackingNumber string            `json:"tracking_number" validate:"required,max=100"`
+	ShippedBy      string            `json:"shipped_by" validate:"required,max=100"`
+	Lines          []shipmentLineDto `json:"lines" validate:"required,min=1,unique=OrderItemId,dive"`
+}
+
+type promotionDto struct {
+	Code          string `json:"code" validate:"omitempty,max=50"`
+	Name          string `json:"name" validate:"required,max=255"`
+	Type          string `json:"type" validate:"required,oneof=percentage fixed buy_x_get_y"`
+	Percentage    string `json:"percentage" validate:"required_if=Type percentage,omitempty,numeric"`
+	Amount        string `json:"amount" validate:"required_if=Type fixed,omitempty,numeric"`
+	ProductId     int64  `json:"product_id" validate:"required_if=Type buy_x_get_y,gte=0"`
+	BuyQuantity   int64  `json:"buy_quantity" validate:"required_if=Type buy_x_get_y,gte=0"`
+	GetQuantity   int64  `json:"get_quantity" validate:"required_if=Type buy_x_get_y,gte=0"`
+	MinQuantity   int64  `json:"min_quantity" validate:"gte=0"`
+	MinOrderTotal string `json:"min_order_total" validate:"omitempty,numeric"`
+	UsageLimit    int64  `json:"usage_limit" validate:"gte=0"`
+	StartsAt      string `json:"starts_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	EndsAt        string `json:"ends_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Active        *bool  `json:"active"`
 }
@@ -1,12 +1,14 @@
 package products_handlers
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	pb "github.com/logan2k02/ims/shared/protobuf"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
@@ -32,21 +34,32 @@ func CreateProductHandler(productsClient pb.ProductsServiceClient, validate *val
 			ReorderLevel:    payload.ReorderLevel,
 			ReorderQuantity: payload.ReorderQuantity,
 			InitialQuantity: payload.InitialQuantity,
+			AllowBackorder:  payload.AllowBackorder,
+			Serialized:      payload.Serialized,
+			CostingMethod:   payload.CostingMethod,
 		})
 		if err != nil {
 			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to create product", "details": status.Convert(err).Message()})
 		}
 
 		product := &product{
-			Id:              productRes.Id,
-			Name:            productRes.Name,
-			Sku:             productRes.Sku,
-			Description:     productRes.Description,
-			Price:           productRes.Price,
-			CreatedAt:       productRes.CreatedAt,
-			ReorderLevel:    productRes.ReorderLevel,
-			ReorderQuantity: payload.ReorderQuantity,
-			StockQuantity:   productRes.StockQuantity,
+			Id:                productRes.Id,
+			Name:              productRes.Name,
+			Sku:               productRes.Sku,
+			Description:       productRes.Description,
+			Price:             productRes.Price,
+			CreatedAt:         productRes.CreatedAt,
+			ReorderLevel:      productRes.ReorderLevel,
+			ReorderQuantity:   payload.ReorderQuantity,
+			StockQuantity:     productRes.StockQuantity,
+			ReservedQuantity:  productRes.ReservedQuantity,
+			HeldQuantity:      productRes.HeldQuantity,
+			AvailableQuantity: productRes.AvailableQuantity,
+			AllowBackorder:    productRes.AllowBackorder,
+			Serialized:        productRes.Serialized,
+			CostingMethod:     productRes.CostingMethod,
+			IsKit:             productRes.IsKit,
+			BuildableQuantity: productRes.BuildableQuantity,
 		}
 
 		return c.Status(fiber.StatusCreated).JSON(product)
@@ -72,15 +85,23 @@ func GetProduct(productsClient pb.ProductsServiceClient) fiber.Handler {
 		}
 
 		product := &product{
-			Id:              productRes.Id,
-			Name:            productRes.Name,
-			Sku:             productRes.Sku,
-			Description:     productRes.Description,
-			Price:           productRes.Price,
-			CreatedAt:       productRes.CreatedAt,
-			ReorderLevel:    productRes.ReorderLevel,
-			ReorderQuantity: productRes.ReorderQuantity,
-			StockQuantity:   productRes.StockQuantity,
+			Id:                productRes.Id,
+			Name:              productRes.Name,
+			Sku:               productRes.Sku,
+			Description:       productRes.Description,
+			Price:             productRes.Price,
+			CreatedAt:         productRes.CreatedAt,
+			ReorderLevel:      productRes.ReorderLevel,
+			ReorderQuantity:   productRes.ReorderQuantity,
+			StockQuantity:     productRes.StockQuantity,
+			ReservedQuantity:  productRes.ReservedQuantity,
+			HeldQuantity:      productRes.HeldQuantity,
+			AvailableQuantity: productRes.AvailableQuantity,
+			AllowBackorder:    productRes.AllowBackorder,
+			Serialized:        productRes.Serialized,
+			CostingMethod:     productRes.CostingMethod,
+			IsKit:             productRes.IsKit,
+			BuildableQuantity: productRes.BuildableQuantity,
 		}
 
 		return c.Status(fiber.StatusCreated).JSON(product)
@@ -115,15 +136,23 @@ func ListProducts(productsClient pb.ProductsServiceClient) fiber.Handler {
 		var products []*product
 		for _, p := range productRes.Products {
 			product := &product{
-				Id:              p.Id,
-				Name:            p.Name,
-				Sku:             p.Sku,
-				Description:     p.Description,
-				Price:           p.Price,
-				CreatedAt:       p.CreatedAt,
-				ReorderLevel:    p.ReorderLevel,
-				ReorderQuantity: p.ReorderQuantity,
-				StockQuantity:   p.StockQuantity,
+				Id:                p.Id,
+				Name:              p.Name,
+				Sku:               p.Sku,
+				Description:       p.Description,
+				Price:             p.Price,
+				CreatedAt:         p.CreatedAt,
+				ReorderLevel:      p.ReorderLevel,
+				ReorderQuantity:   p.ReorderQuantity,
+				StockQuantity:     p.StockQuantity,
+				ReservedQuantity:  p.ReservedQuantity,
+				HeldQuantity:      p.HeldQuantity,
+				AvailableQuantity: p.AvailableQuantity,
+				AllowBackorder:    p.AllowBackorder,
+				Serialized:        p.Serialized,
+				CostingMethod:     p.CostingMethod,
+				IsKit:             p.IsKit,
+				BuildableQuantity: p.BuildableQuantity,
 			}
 			products = append(products, product)
 		}
@@ -184,23 +213,199 @@ func UpdateProduct(productsClient pb.ProductsServiceClient, validate *validator.
 			Price:           payload.Price,
 			ReorderLevel:    payload.ReorderLevel,
 			ReorderQuantity: payload.ReorderQuantity,
+			AllowBackorder:  payload.AllowBackorder,
+			Serialized:      payload.Serialized,
+			CostingMethod:   payload.CostingMethod,
 		})
 		if err != nil {
 			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to update product", "details": status.Convert(err).Message()})
 		}
 
 		product := &product{
-			Id:              productRes.Id,
-			Name:            productRes.Name,
-			Sku:             payload.Sku,
-			Description:     productRes.Description,
-			Price:           productRes.Price,
-			CreatedAt:       productRes.CreatedAt,
-			ReorderLevel:    productRes.ReorderLevel,
-			ReorderQuantity: productRes.ReorderQuantity,
-			StockQuantity:   productRes.StockQuantity,
+			Id:                productRes.Id,
+			Name:              productRes.Name,
+			Sku:               payload.Sku,
+			Description:       productRes.Description,
+			Price:             productRes.Price,
+			CreatedAt:         productRes.CreatedAt,
+			ReorderLevel:      productRes.ReorderLevel,
+			ReorderQuantity:   productRes.ReorderQuantity,
+			StockQuantity:     productRes.StockQuantity,
+			ReservedQuantity:  productRes.ReservedQuantity,
+			HeldQuantity:      productRes.HeldQuantity,
+			AvailableQuantity: productRes.AvailableQuantity,
+			AllowBackorder:    productRes.AllowBackorder,
+			Serialized:        productRes.Serialized,
+			CostingMethod:     productRes.CostingMethod,
+			IsKit:             productRes.IsKit,
+			BuildableQuantity: productRes.BuildableQuantity,
 		}
 
 		return c.Status(fiber.StatusOK).JSON(product)
 	}
 }
+
+func productsError(c *fiber.Ctx, err error, message string) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.NotFound:
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found", "details": st.Message()})
+	case codes.InvalidArgument:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request", "details": st.Message()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": message, "details": st.Message()})
+}
+
+func GetKit(productsClient pb.ProductsServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		kit, err := productsClient.GetKit(c.Context(), &pb.ProductIdRequest{
+			Id: id,
+		})
+		if err != nil {
+			return productsError(c, err, "failed to get kit")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(kit)
+	}
+}
+
+func SetKitComponents(productsClient pb.ProductsServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		var payload setKitComponentsDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		components := make([]*pb.KitComponent, len(payload.Components))
+		for i, component := range payload.Components {
+			components[i] = &pb.KitComponent{
+				ProductId: component.ProductId,
+				Quantity:  component.Quantity,
+			}
+		}
+
+		kit, err := productsClient.SetKitComponents(c.Context(), &pb.SetKitComponentsRequest{
+			KitId:      id,
+			Components: components,
+		})
+		if err != nil {
+			return productsError(c, err, "failed to set kit components")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(kit)
+	}
+}
+
+func ListProductUnits(productsClient pb.ProductsServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		units, err := productsClient.ListProductUnits(c.Context(), &pb.ProductIdRequest{
+			Id: id,
+		})
+		if err != nil {
+			return productsError(c, err, "failed to list product units")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(units)
+	}
+}
+
+// UnitExists reports whether the product has the unit, so requests giving a
+// unit the product is not sold in are refused with a 400 before they reach the
+// other services. No unit and the base unit each always exist, as does any unit
+// of a product that is not found, which the service handling the request reports.
+func UnitExists(ctx context.Context, productsClient pb.ProductsServiceClient, productId int64, unit string) (bool, error) {
+	if unit == "" || unit == "each" {
+		return true, nil
+	}
+
+	units, err := productsClient.ListProductUnits(ctx, &pb.ProductIdRequest{
+		Id: productId,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return true, nil
+		}
+		return false, err
+	}
+
+	for _, productUnit := range units.Units {
+		if productUnit.Code == unit {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func SetProductUnits(productsClient pb.ProductsServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		var payload setProductUnitsDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		units := make([]*pb.ProductUnit, len(payload.Units))
+		for i, unit := range payload.Units {
+			units[i] = &pb.ProductUnit{
+				Code:   unit.Code,
+				Name:   unit.Name,
+				Factor: unit.Factor,
+			}
+		}
+
+		res, err := productsClient.SetProductUnits(c.Context(), &pb.SetProductUnitsRequest{
+			ProductId: id,
+			Units:     units,
+		})
+		if err != nil {
+			return productsError(c, err, "failed to set product units")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res)
+	}
+}
@@ -8,6 +8,9 @@ type createProductDto struct {
 	ReorderLevel    int64   `json:"reorder_level" validate:"required,gt=0"`
 	ReorderQuantity int64   `json:"reorder_quantity" validate:"required,gt=0"`
 	InitialQuantity int64   `json:"initial_quantity" validate:"required,gt=0"`
+	AllowBackorder  bool    `json:"allow_backorder"`
+	Serialized      bool    `json:"serialized"`
+	CostingMethod   string  `json:"costing_method" validate:"omitempty,oneof=fifo average"`
 }
 
 type updateProductDto struct {
@@ -17,16 +20,46 @@ type updateProductDto struct {
 	Price           float64 `json:"price" validate:"required,gt=0"`
 	ReorderLevel    int64   `json:"reorder_level" validate:"required,gt=0"`
 	ReorderQuantity int64   `json:"reorder_quantity" validate:"required,gt=0"`
+	AllowBackorder  bool    `json:"allow_backorder"`
+	Serialized      bool    `json:"serialized"`
+	CostingMethod   string  `json:"costing_method" validate:"omitempty,oneof=fifo average"`
 }
 
 type product struct {
-	Id              int64   `json:"id"`
-	Name            string  `json:"name"`
-	Sku             string  `json:"sku"`
-	Description     string  `json:"description"`
-	Price           float64 `json:"price"`
-	CreatedAt       string  `json:"created_at"`
-	ReorderLevel    int64   `json:"reorder_level"`
-	ReorderQuantity int64   `json:"reorder_quantity"`
-	StockQuantity   int64   `json:"stock_quantity"`
+	Id                int64   `json:"id"`
+	Name              string  `json:"name"`
+	Sku               string  `json:"sku"`
+	Description       string  `json:"description"`
+	Price             float64 `json:"price"`
+	CreatedAt         string  `json:"created_at"`
+	ReorderLevel      int64   `json:"reorder_level"`
+	ReorderQuantity   int64   `json:"reorder_quantity"`
+	StockQuantity     int64   `json:"stock_quantity"`
+	ReservedQuantity  int64   `json:"reserved_quantity"`
+	HeldQuantity      int64   `json:"held_quantity"`
+	AvailableQuantity int64   `json:"available_quantity"`
+	AllowBackorder    bool    `json:"allow_backorder"`
+	Serialized        bool    `json:"serialized"`
+	CostingMethod     string  `json:"costing_method"`
+	IsKit             bool    `json:"is_kit"`
+	BuildableQuantity int64   `json:"buildable_quantity"`
+}
+
+type kitComponentDto struct {
+	ProductId int64 `json:"product_id" validate:"required,gt=0"`
+	Quantity  int64 `json:"quantity" validate:"required,gt=0"`
+}
+
+type setKitComponentsDto struct {
+	Components []kitComponentDto `json:"components" validate:"dive"`
+}
+
+type productUnitDto struct {
+	Code   string `json:"code" validate:"required,alphanum,lowercase,max=20,ne=each"`
+	Name   string `json:"name" validate:"required,max=100"`
+	Factor int64  `json:"factor" validate:"required,gt=1"`
+}
+
+type setProductUnitsDto struct {
+	Units []productUnitDto `json:"units" validate:"unique=Code,dive"`
 }
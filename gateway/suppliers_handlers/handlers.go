@@ -0,0 +1,386 @@
+package suppliers_handlers
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	pb "github.com/logan2k02/ims/shared/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func suppliersError(c *fiber.Ctx, err error, message string) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.NotFound:
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": message, "details": st.Message()})
+	case codes.FailedPrecondition:
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": message, "details": st.Message()})
+	case codes.InvalidArgument:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": message, "details": st.Message()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": message, "details": st.Message()})
+}
+
+func CreateSupplier(suppliersClient pb.SuppliersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var payload createSupplierDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		supplier, err := suppliersClient.CreateSupplier(c.Context(), &pb.CreateSupplierRequest{
+			Name:  payload.Name,
+			Email: payload.Email,
+			Phone: payload.Phone,
+		})
+		if err != nil {
+			return suppliersError(c, err, "failed to create supplier")
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(supplier)
+	}
+}
+
+func ListSuppliers(suppliersClient pb.SuppliersServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		res, err := suppliersClient.ListSuppliers(c.Context(), &pb.ListSuppliersRequest{})
+		if err != nil {
+			return suppliersError(c, err, "failed to list suppliers")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Suppliers)
+	}
+}
+
+func GetSupplier(suppliersClient pb.SuppliersServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		supplier, err := suppliersClient.GetSupplier(c.Context(), &pb.SupplierIdRequest{Id: id})
+		if err != nil {
+			return suppliersError(c, err, "failed to get supplier")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(supplier)
+	}
+}
+
+func ListSupplierProducts(suppliersClient pb.SuppliersServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		res, err := suppliersClient.ListSupplierProducts(c.Context(), &pb.ListSupplierProductsRequest{SupplierId: id})
+		if err != nil {
+			return suppliersError(c, err, "failed to list supplier products")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.Products)
+	}
+}
+
+func LinkSupplierProduct(suppliersClient pb.SuppliersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		productId, err := strconv.ParseInt(c.Params("productId"), 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid product id given",
+				"details": "product id must be an integer",
+			})
+		}
+
+		var payload linkProductDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		product, err := suppliersClient.LinkSupplierProduct(c.Context(), &pb.LinkSupplierProductRequest{
+			SupplierId:   id,
+			ProductId:    productId,
+			SupplierSku:  payload.SupplierSku,
+			UnitCost:     payload.UnitCost,
+			PackSize:     payload.PackSize,
+			LeadTimeDays: payload.LeadTimeDays,
+			Preferred:    payload.Preferred,
+		})
+		if err != nil {
+			return suppliersError(c, err, "failed to link supplier product")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(product)
+	}
+}
+
+func CreateReplenishmentProposal(suppliersClient pb.SuppliersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var payload createProposalDto
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&payload); err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+			}
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		proposal, err := suppliersClient.CreateReplenishmentProposal(c.Context(), &pb.CreateReplenishmentProposalRequest{
+			ProductIds: payload.ProductIds,
+		})
+		if err != nil {
+			return suppliersError(c, err, "failed to create replenishment proposal")
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(proposal)
+	}
+}
+
+func GetReplenishmentProposal(suppliersClient pb.SuppliersServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		proposal, err := suppliersClient.GetReplenishmentProposal(c.Context(), &pb.ReplenishmentProposalIdRequest{Id: id})
+		if err != nil {
+			return suppliersError(c, err, "failed to get replenishment proposal")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(proposal)
+	}
+}
+
+func ApproveReplenishmentProposal(suppliersClient pb.SuppliersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		var payload approveProposalDto
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&payload); err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+			}
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		res, err := suppliersClient.ApproveReplenishmentProposal(c.Context(), &pb.ApproveReplenishmentRequest{
+			Id:          id,
+			SupplierIds: payload.SupplierIds,
+		})
+		if err != nil {
+			return suppliersError(c, err, "failed to approve replenishment proposal")
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(res)
+	}
+}
+
+func ListPurchaseOrders(suppliersClient pb.SuppliersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var query listPurchaseOrdersQuery
+		if err := c.QueryParser(&query); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid query parameters"})
+		}
+
+		if err := validate.Struct(query); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		res, err := suppliersClient.ListPurchaseOrders(c.Context(), &pb.ListPurchaseOrdersRequest{
+			SupplierId: query.SupplierId,
+			Status:     query.Status,
+		})
+		if err != nil {
+			return suppliersError(c, err, "failed to list purchase orders")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(res.PurchaseOrders)
+	}
+}
+
+func GetPurchaseOrder(suppliersClient pb.SuppliersServiceClient) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		order, err := suppliersClient.GetPurchaseOrder(c.Context(), &pb.PurchaseOrderIdRequest{Id: id})
+		if err != nil {
+			return suppliersError(c, err, "failed to get purchase order")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(order)
+	}
+}
+
+func CreatePurchaseOrder(suppliersClient pb.SuppliersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var payload createPurchaseOrderDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		lines := make([]*pb.PurchaseOrderLineRequest, len(payload.Lines))
+		for i, line := range payload.Lines {
+			lines[i] = &pb.PurchaseOrderLineRequest{
+				ProductId: line.ProductId,
+				Quantity:  line.Quantity,
+				UnitCost:  line.UnitCost,
+			}
+		}
+
+		order, err := suppliersClient.CreatePurchaseOrder(c.Context(), &pb.CreatePurchaseOrderRequest{
+			SupplierId: payload.SupplierId,
+			Note:       payload.Note,
+			Lines:      lines,
+		})
+		if err != nil {
+			return suppliersError(c, err, "failed to create purchase order")
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(order)
+	}
+}
+
+// send and close only take the purchase order id
+func PurchaseOrderAction(call func(context.Context, *pb.PurchaseOrderIdRequest, ...grpc.CallOption) (*pb.PurchaseOrder, error), message string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		order, err := call(c.Context(), &pb.PurchaseOrderIdRequest{Id: id})
+		if err != nil {
+			return suppliersError(c, err, message)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(order)
+	}
+}
+
+func ReceivePurchaseOrder(suppliersClient pb.SuppliersServiceClient, validate *validator.Validate) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		idParam := c.Params("id", "1")
+		id, err := strconv.ParseInt(idParam, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid id given",
+				"details": "id must be an integer",
+			})
+		}
+
+		var payload receivePurchaseOrderDto
+		if err := c.BodyParser(&payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+		}
+
+		if err := validate.Struct(payload); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "validation failed",
+				"details": err.Error(),
+			})
+		}
+
+		lines := make([]*pb.ReceiptLine, len(payload.Lines))
+		for i, line := range payload.Lines {
+			lines[i] = &pb.ReceiptLine{
+				LineId:        line.LineId,
+				Quantity:      line.Quantity,
+				LotNumber:     line.LotNumber,
+				ExpiresAt:     line.ExpiresAt,
+				SerialNumbers: line.SerialNumbers,
+			}
+		}
+
+		order, err := suppliersClient.ReceivePurchaseOrder(c.Context(), &pb.ReceivePurchaseOrderRequest{
+			Id:         id,
+			LocationId: payload.LocationId,
+			Note:       payload.Note,
+			Lines:      lines,
+		})
+		if err != nil {
+			return suppliersError(c, err, "failed to receive purchase order")
+		}
+
+		return c.Status(fiber.StatusOK).JSON(order)
+	}
+}
@@ -0,0 +1,54 @@
+package suppliers_handlers
+
+type createSupplierDto struct {
+	Name  string `json:"name" validate:"required,max=255"`
+	Email string `json:"email" validate:"omitempty,email"`
+	Phone string `json:"phone" validate:"max=50"`
+}
+
+type linkProductDto struct {
+	SupplierSku  string  `json:"supplier_sku" validate:"max=255"`
+	UnitCost     float64 `json:"unit_cost" validate:"gte=0"`
+	PackSize     int64   `json:"pack_size" validate:"gte=0"`
+	LeadTimeDays int64   `json:"lead_time_days" validate:"gte=0"`
+	Preferred    bool    `json:"preferred"`
+}
+
+type createProposalDto struct {
+	ProductIds []int64 `json:"product_ids" validate:"omitempty,dive,gt=0"`
+}
+
+type approveProposalDto struct {
+	SupplierIds []int64 `json:"supplier_ids" validate:"omitempty,dive,gt=0"`
+}
+
+type listPurchaseOrdersQuery struct {
+	SupplierId int64  `query:"supplier_id" validate:"gte=0"`
+	Status     string `query:"status" validate:"omitempty,oneof=draft sent partially_received received closed"`
+}
+
+type purchaseOrderLineDto struct {
+	ProductId int64   `json:"product_id" validate:"required,gt=0"`
+	Quantity  int64   `json:"quantity" validate:"required,gt=0"`
+	UnitCost  float64 `json:"unit_cost" validate:"gte=0"`
+}
+
+type createPurchaseOrderDto struct {
+	SupplierId int64                  `json:"supplier_id" validate:"required,gt=0"`
+	Note       string                 `json:"note"`
+	Lines      []purchaseOrderLineDto `json:"lines" validate:"required,min=1,dive"`
+}
+
+type receiptLineDto struct {
+	LineId        int64    `json:"line_id" validate:"required,gt=0"`
+	Quantity      int64    `json:"quantity" validate:"required,gt=0"`
+	LotNumber     string   `json:"lot_number" validate:"max=100"`
+	ExpiresAt     string   `json:"expires_at" validate:"omitempty,datetime=2006-01-02"`
+	SerialNumbers []string `json:"serial_numbers" validate:"omitempty,dive,required,max=100"`
+}
+
+type receivePurchaseOrderDto struct {
+	LocationId int64            `json:"location_id" validate:"gte=0"`
+	Note       string           `json:"note"`
+	Lines      []receiptLineDto `json:"lines" validate:"required,min=1,dive"`
+}
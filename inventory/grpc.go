@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"time"
 
 	pb "github.com/logan2k02/ims/shared/protobuf"
 	"google.golang.org/grpc/codes"
@@ -19,44 +22,678 @@ func NewInventoryGRPCHandler(service *inventoryService) *inventoryGRPCHandler {
 	}
 }
 
+// maps the store's stock errors to grpc status codes
+func stockStatusError(err error) error {
+	var stockErr *insufficientStockError
+	switch {
+	case errors.As(err, &stockErr), errors.Is(err, errReservationNotActive), errors.Is(err, errSerialUnavailable),
+		errors.Is(err, errMovementNotReversible), errors.Is(err, errMovementAlreadyReversed), errors.Is(err, errNotAKit), errors.Is(err, errInsufficientState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errMovementNotFound), errors.Is(err, errLocationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errInvalidSerialNumbers), errors.Is(err, errUnknownUnit), errors.Is(err, errUnitQuantityLimit):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
 func (h *inventoryGRPCHandler) PurchaseInventoryProduct(ctx context.Context, payload *pb.PurchaseInventoryRequest) (*pb.StockMovement, error) {
+	if payload.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+	}
+
 	record, err := h.service.Purchase(ctx, payload)
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, stockStatusError(err)
+	}
+
+	return record, nil
+}
+
+func (h *inventoryGRPCHandler) RestockInventoryProduct(ctx context.Context, payload *pb.PurchaseInventoryRequest) (*pb.StockMovement, error) {
+	if payload.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+	}
+
+	record, err := h.service.Restock(ctx, payload)
+
+	if err != nil {
+		return nil, stockStatusError(err)
+	}
+
+	return record, nil
+}
+
+func (h *inventoryGRPCHandler) ReturnInventoryProduct(ctx context.Context, payload *pb.PurchaseInventoryRequest) (*pb.StockMovement, error) {
+	if payload.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+	}
+
+	record, err := h.service.Return(ctx, payload)
+
+	if err != nil {
+		return nil, stockStatusError(err)
 	}
 
 	return record, nil
 }
 
 func (h *inventoryGRPCHandler) SupplyInventoryProduct(ctx context.Context, payload *pb.ManageInventoryRequest) (*pb.StockMovement, error) {
+	if payload.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+	}
+
+	if payload.UnitCost < 0 {
+		return nil, status.Error(codes.InvalidArgument, "unit cost cannot be negative")
+	}
+
+	if payload.ExpiresAt != "" {
+		if _, err := time.Parse(time.DateOnly, payload.ExpiresAt); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "expiry date must be formatted as YYYY-MM-DD")
+		}
+	}
+
 	record, err := h.service.Supply(ctx, payload)
 
+	if err != nil {
+		return nil, stockStatusError(err)
+	}
+
+	return record, nil
+}
+
+func correctionStatusError(err error) error {
+	switch {
+	case errors.Is(err, errCorrectionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errCorrectionNotPending):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errCorrectionSelfApproval):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, errInvalidReasonCode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return stockStatusError(err)
+}
+
+func (h *inventoryGRPCHandler) CorrectInventoryStock(ctx context.Context, payload *pb.ManageInventoryRequest) (*pb.StockCorrection, error) {
+	if payload.Quantity < 0 {
+		return nil, status.Error(codes.InvalidArgument, "corrected quantity cannot be negative")
+	}
+
+	if payload.ReasonCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason code is required")
+	}
+
+	if payload.RequestedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "requested by is required")
+	}
+
+	correction, err := h.service.CorrectStockQuantity(ctx, payload)
+
+	if err != nil {
+		return nil, correctionStatusError(err)
+	}
+
+	return correction, nil
+}
+
+func (h *inventoryGRPCHandler) ApproveStockCorrection(ctx context.Context, payload *pb.ReviewStockCorrectionRequest) (*pb.StockCorrection, error) {
+	if payload.ReviewedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "reviewed by is required")
+	}
+
+	correction, err := h.service.ApproveStockCorrection(ctx, payload)
+
+	if err != nil {
+		return nil, correctionStatusError(err)
+	}
+
+	return correction, nil
+}
+
+func (h *inventoryGRPCHandler) RejectStockCorrection(ctx context.Context, payload *pb.ReviewStockCorrectionRequest) (*pb.StockCorrection, error) {
+	if payload.ReviewedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "reviewed by is required")
+	}
+
+	correction, err := h.service.RejectStockCorrection(ctx, payload)
+
+	if err != nil {
+		return nil, correctionStatusError(err)
+	}
+
+	return correction, nil
+}
+
+func (h *inventoryGRPCHandler) ListStockCorrections(ctx context.Context, payload *pb.ListStockCorrectionsRequest) (*pb.ListStockCorrectionsResponse, error) {
+	switch payload.Status {
+	case "", "pending", "posted", "rejected":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status must be one of pending, posted or rejected")
+	}
+
+	corrections, err := h.service.ListStockCorrections(ctx, payload)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ListStockCorrectionsResponse{
+		Corrections: corrections,
+	}, nil
+}
+
+func (h *inventoryGRPCHandler) ListCorrectionReasons(ctx context.Context, payload *pb.ListCorrectionReasonsRequest) (*pb.ListCorrectionReasonsResponse, error) {
+	reasons, err := h.service.ListCorrectionReasons(ctx, payload.IncludeInactive)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ListCorrectionReasonsResponse{
+		Reasons: reasons,
+	}, nil
+}
+
+func (h *inventoryGRPCHandler) SaveCorrectionReason(ctx context.Context, payload *pb.CorrectionReason) (*pb.CorrectionReason, error) {
+	if payload.Code == "" || payload.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason code and name are required")
+	}
+
+	reason, err := h.service.SaveCorrectionReason(ctx, payload)
+
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	return reason, nil
+}
+
+func (h *inventoryGRPCHandler) ListStockMovements(ctx context.Context, payload *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	res, err := h.service.ListStockMovements(ctx, payload)
+
+	if err != nil {
+		if errors.Is(err, errInvalidMovementQuery) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (h *inventoryGRPCHandler) ReverseStockMovement(ctx context.Context, payload *pb.ReverseStockMovementRequest) (*pb.StockMovement, error) {
+	record, err := h.service.ReverseStockMovement(ctx, payload)
+
+	if err != nil {
+		return nil, stockStatusError(err)
+	}
+
 	return record, nil
 }
 
-func (h *inventoryGRPCHandler) CorrectInventoryStock(ctx context.Context, payload *pb.ManageInventoryRequest) (*pb.StockMovement, error) {
-	record, err := h.service.CorrectStockQuantity(ctx, payload)
+func (h *inventoryGRPCHandler) GetStockAsOf(ctx context.Context, payload *pb.StockAsOfRequest) (*pb.StockAsOfResponse, error) {
+	at, err := time.Parse(time.RFC3339, payload.At)
+	if err != nil {
+		day, dayErr := time.Parse(time.DateOnly, payload.At)
+		if dayErr != nil {
+			return nil, status.Error(codes.InvalidArgument, "at must be an RFC 3339 timestamp or YYYY-MM-DD")
+		}
+		at = day.AddDate(0, 0, 1).Add(-time.Second)
+	}
+
+	res, err := h.service.GetStockAsOf(ctx, payload.ProductIds, payload.LocationId, at)
 
 	if err != nil {
+		if errors.Is(err, errStockHistoryUnavailable) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	return res, nil
+}
+
+func (h *inventoryGRPCHandler) GetInventoryValuation(ctx context.Context, payload *pb.InventoryValuationRequest) (*pb.InventoryValuationResponse, error) {
+	res, err := h.service.GetInventoryValuation(ctx, payload)
+
+	if err != nil {
+		if errors.Is(err, errInvalidValuationQuery) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (h *inventoryGRPCHandler) ReconcileStock(ctx context.Context, payload *pb.ReconcileStockRequest) (*pb.ReconcileStockResponse, error) {
+	res, err := h.service.ReconcileStock(ctx, payload)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (h *inventoryGRPCHandler) ReserveStock(ctx context.Context, payload *pb.ReserveStockRequest) (*pb.StockReservation, error) {
+	if payload.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+	}
+
+	reservation, err := h.service.ReserveStock(ctx, payload)
+
+	if err != nil {
+		return nil, stockStatusError(err)
+	}
+
+	return reservation, nil
+}
+
+func (h *inventoryGRPCHandler) CommitReservation(ctx context.Context, payload *pb.CommitReservationRequest) (*pb.StockMovement, error) {
+	// zero commits everything still reserved
+	if payload.Quantity < 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity cannot be negative")
+	}
+
+	record, err := h.service.CommitReservation(ctx, payload)
+
+	if err != nil {
+		return nil, stockStatusError(err)
+	}
+
 	return record, nil
 }
 
-func (h *inventoryGRPCHandler) ListStockMovements(ctx context.Context, payload *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
-	records, err := h.service.ListStockMovements(ctx, payload.ProductId)
+func (h *inventoryGRPCHandler) ReleaseReservation(ctx context.Context, payload *pb.ReservationIdRequest) (*pb.StockReservation, error) {
+	reservation, err := h.service.ReleaseReservation(ctx, payload.Id)
+
+	if err != nil {
+		return nil, stockStatusError(err)
+	}
+
+	return reservation, nil
+}
+
+func (h *inventoryGRPCHandler) CreateLocation(ctx context.Context, payload *pb.CreateLocationRequest) (*pb.Location, error) {
+	if payload.Code == "" || payload.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "location code and name are required")
+	}
+
+	location, err := h.service.CreateLocation(ctx, payload)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return location, nil
+}
+
+func (h *inventoryGRPCHandler) ListLocations(ctx context.Context, payload *pb.ListLocationsRequest) (*pb.ListLocationsResponse, error) {
+	locations, err := h.service.ListLocations(ctx)
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.ListStockMovementsResponse{
-		Records: records,
+	return &pb.ListLocationsResponse{
+		Locations: locations,
 	}, nil
 }
+
+func (h *inventoryGRPCHandler) GetLocationStock(ctx context.Context, payload *pb.LocationIdRequest) (*pb.LocationStockResponse, error) {
+	stock, err := h.service.GetLocationStock(ctx, payload.Id)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if stock == nil {
+		return nil, status.Error(codes.NotFound, "location not found")
+	}
+
+	return stock, nil
+}
+
+func (h *inventoryGRPCHandler) TransferStock(ctx context.Context, payload *pb.TransferStockRequest) (*pb.TransferStockResponse, error) {
+	if payload.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+	}
+
+	if resolveLocationId(payload.FromLocationId) == resolveLocationId(payload.ToLocationId) {
+		return nil, status.Error(codes.InvalidArgument, "source and destination locations must differ")
+	}
+
+	transfer, err := h.service.TransferStock(ctx, payload)
+
+	if err != nil {
+		return nil, stockStatusError(err)
+	}
+
+	return transfer, nil
+}
+
+var stockStates = []string{"available", "quarantined", "damaged", "in_transit"}
+
+func (h *inventoryGRPCHandler) MoveStockState(ctx context.Context, payload *pb.MoveStockStateRequest) (*pb.MoveStockStateResponse, error) {
+	if payload.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+	}
+
+	if !slices.Contains(stockStates, payload.FromState) || !slices.Contains(stockStates, payload.ToState) {
+		return nil, status.Error(codes.InvalidArgument, "states must be one of available, quarantined, damaged or in_transit")
+	}
+
+	if payload.FromState == payload.ToState {
+		return nil, status.Error(codes.InvalidArgument, "source and destination states must differ")
+	}
+
+	change, err := h.service.MoveStockState(ctx, payload)
+
+	if err != nil {
+		return nil, stockStatusError(err)
+	}
+
+	return change, nil
+}
+
+func (h *inventoryGRPCHandler) GetProductStockStates(ctx context.Context, payload *pb.ProductStockStatesRequest) (*pb.ProductStockStatesResponse, error) {
+	states, err := h.service.GetProductStockStates(ctx, payload.ProductId)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if states == nil {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+
+	return states, nil
+}
+
+func (h *inventoryGRPCHandler) AssembleKit(ctx context.Context, payload *pb.AssembleKitRequest) (*pb.KitAssembly, error) {
+	if payload.KitId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "kit id is required")
+	}
+
+	if payload.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+	}
+
+	assembly, err := h.service.AssembleKit(ctx, payload)
+
+	if err != nil {
+		return nil, stockStatusError(err)
+	}
+
+	return assembly, nil
+}
+
+func (h *inventoryGRPCHandler) ListStockBatches(ctx context.Context, payload *pb.ListStockBatchesRequest) (*pb.ListStockBatchesResponse, error) {
+	if payload.ProductId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+
+	batches, err := h.service.ListStockBatches(ctx, payload)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ListStockBatchesResponse{
+		Batches: batches,
+	}, nil
+}
+
+func (h *inventoryGRPCHandler) ListExpiringBatches(ctx context.Context, payload *pb.ListExpiringBatchesRequest) (*pb.ListStockBatchesResponse, error) {
+	if payload.Days <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "days must be greater than zero")
+	}
+
+	batches, err := h.service.ListExpiringBatches(ctx, payload)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ListStockBatchesResponse{
+		Batches: batches,
+	}, nil
+}
+
+func (h *inventoryGRPCHandler) ListSerialUnits(ctx context.Context, payload *pb.ListSerialUnitsRequest) (*pb.ListSerialUnitsResponse, error) {
+	if payload.ProductId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+
+	switch payload.Status {
+	case "", "in_stock", "sold", "returned", "quarantined", "damaged", "in_transit", "scrapped":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status must be one of in_stock, sold, returned, quarantined, damaged, in_transit or scrapped")
+	}
+
+	units, err := h.service.ListSerialUnits(ctx, payload)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ListSerialUnitsResponse{
+		Units: units,
+	}, nil
+}
+
+func (h *inventoryGRPCHandler) GetSerialHistory(ctx context.Context, payload *pb.SerialHistoryRequest) (*pb.ListSerialUnitsResponse, error) {
+	if payload.SerialNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "serial number is required")
+	}
+
+	units, err := h.service.GetSerialHistory(ctx, payload)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if len(units) == 0 {
+		return nil, status.Error(codes.NotFound, "serial number not found")
+	}
+
+	return &pb.ListSerialUnitsResponse{
+		Units: units,
+	}, nil
+}
+
+func (h *inventoryGRPCHandler) ScrapSerialUnits(ctx context.Context, payload *pb.ScrapSerialUnitsRequest) (*pb.ListSerialUnitsResponse, error) {
+	return h.scrapSerialUnits(ctx, payload, false)
+}
+
+func (h *inventoryGRPCHandler) RestoreSerialUnits(ctx context.Context, payload *pb.ScrapSerialUnitsRequest) (*pb.ListSerialUnitsResponse, error) {
+	return h.scrapSerialUnits(ctx, payload, true)
+}
+
+func (h *inventoryGRPCHandler) scrapSerialUnits(ctx context.Context, payload *pb.ScrapSerialUnitsRequest, restore bool) (*pb.ListSerialUnitsResponse, error) {
+	if payload.ProductId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+
+	if payload.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than zero")
+	}
+
+	if payload.Reference == "" {
+		return nil, status.Error(codes.InvalidArgument, "reference is required")
+	}
+
+	units, err := h.service.ScrapSerialUnits(ctx, payload, restore)
+
+	if err != nil {
+		return nil, stockStatusError(err)
+	}
+
+	return &pb.ListSerialUnitsResponse{
+		Units: units,
+	}, nil
+}
+
+func cycleCountStatusError(err error) error {
+	switch {
+	case errors.Is(err, errCycleCountNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errCycleCountState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errInvalidCycleCount):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return stockStatusError(err)
+}
+
+func (h *inventoryGRPCHandler) OpenCycleCount(ctx context.Context, payload *pb.OpenCycleCountRequest) (*pb.CycleCount, error) {
+	if len(payload.ProductIds) == 0 && len(payload.LocationIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "products or locations to count are required")
+	}
+
+	count, err := h.service.OpenCycleCount(ctx, payload)
+
+	if err != nil {
+		return nil, cycleCountStatusError(err)
+	}
+
+	return count, nil
+}
+
+func (h *inventoryGRPCHandler) ListCycleCounts(ctx context.Context, payload *pb.ListCycleCountsRequest) (*pb.ListCycleCountsResponse, error) {
+	switch payload.Status {
+	case "", "open", "review", "posted", "cancelled":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status must be one of open, review, posted or cancelled")
+	}
+
+	counts, err := h.service.ListCycleCounts(ctx, payload.Status)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ListCycleCountsResponse{
+		Counts: counts,
+	}, nil
+}
+
+func (h *inventoryGRPCHandler) GetCycleCount(ctx context.Context, payload *pb.CycleCountIdRequest) (*pb.CycleCount, error) {
+	count, err := h.service.GetCycleCount(ctx, payload.Id)
+
+	if err != nil {
+		return nil, cycleCountStatusError(err)
+	}
+
+	return count, nil
+}
+
+func (h *inventoryGRPCHandler) RecordCycleCount(ctx context.Context, payload *pb.RecordCycleCountRequest) (*pb.CycleCount, error) {
+	if payload.Counter == "" {
+		return nil, status.Error(codes.InvalidArgument, "counter is required")
+	}
+
+	if len(payload.Entries) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one counted quantity is required")
+	}
+
+	for _, entry := range payload.Entries {
+		if entry.Quantity < 0 {
+			return nil, status.Error(codes.InvalidArgument, "counted quantities cannot be negative")
+		}
+	}
+
+	count, err := h.service.RecordCycleCount(ctx, payload)
+
+	if err != nil {
+		return nil, cycleCountStatusError(err)
+	}
+
+	return count, nil
+}
+
+func (h *inventoryGRPCHandler) SubmitCycleCount(ctx context.Context, payload *pb.CycleCountIdRequest) (*pb.CycleCount, error) {
+	count, err := h.service.SubmitCycleCount(ctx, payload.Id)
+
+	if err != nil {
+		return nil, cycleCountStatusError(err)
+	}
+
+	return count, nil
+}
+
+func (h *inventoryGRPCHandler) ApproveCycleCount(ctx context.Context, payload *pb.ApproveCycleCountRequest) (*pb.CycleCount, error) {
+	if !payload.All && len(payload.LineIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "line ids are required unless approving all lines")
+	}
+
+	count, err := h.service.ApproveCycleCount(ctx, payload)
+
+	if err != nil {
+		return nil, cycleCountStatusError(err)
+	}
+
+	return count, nil
+}
+
+func (h *inventoryGRPCHandler) PostCycleCount(ctx context.Context, payload *pb.CycleCountIdRequest) (*pb.CycleCount, error) {
+	count, err := h.service.PostCycleCount(ctx, payload.Id)
+
+	if err != nil {
+		return nil, cycleCountStatusError(err)
+	}
+
+	return count, nil
+}
+
+func (h *inventoryGRPCHandler) CancelCycleCount(ctx context.Context, payload *pb.CycleCountIdRequest) (*pb.CycleCount, error) {
+	count, err := h.service.CancelCycleCount(ctx, payload.Id)
+
+	if err != nil {
+		return nil, cycleCountStatusError(err)
+	}
+
+	return count, nil
+}
+
+func (h *inventoryGRPCHandler) ListStockAlerts(ctx context.Context, payload *pb.ListStockAlertsRequest) (*pb.ListStockAlertsResponse, error) {
+	switch payload.Status {
+	case "", "open", "resolved":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status must be open or resolved")
+	}
+
+	alerts, err := h.service.ListStockAlerts(ctx, payload)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ListStockAlertsResponse{
+		Alerts: alerts,
+	}, nil
+}
+
+func (h *inventoryGRPCHandler) SubscribeStockAlerts(payload *pb.SubscribeStockAlertsRequest, stream pb.InventoryService_SubscribeStockAlertsServer) error {
+	alerts, unsubscribe := h.service.SubscribeStockAlerts()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case alert := <-alerts:
+			if len(payload.ProductIds) > 0 && !slices.Contains(payload.ProductIds, alert.ProductId) {
+				continue
+			}
+
+			if err := stream.Send(alert); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"strconv"
+	"time"
 
 	"github.com/logan2k02/ims/shared/consul"
 	"github.com/logan2k02/ims/shared/grpcservice"
@@ -18,6 +20,14 @@ var (
 	gRPCHost   = utils.GetEnv("GRPC_HOST", "localhost")
 	consulAddr = utils.GetEnv("CONSUL_ADDR", "localhost:8500")
 
+	reservationTTL           = utils.GetEnv("RESERVATION_TTL", "30m")
+	reservationSweepInterval = utils.GetEnv("RESERVATION_SWEEP_INTERVAL", "1m")
+	stockSnapshotInterval    = utils.GetEnv("STOCK_SNAPSHOT_INTERVAL", "1h")
+	alertPollInterval        = utils.GetEnv("ALERT_POLL_INTERVAL", "5s")
+
+	correctionApprovalQuantity = utils.GetEnv("CORRECTION_APPROVAL_QUANTITY", "50")
+	correctionApprovalValue    = utils.GetEnv("CORRECTION_APPROVAL_VALUE", "1000")
+
 	Logger = logger.NewLogger("inventory-service")
 )
 
@@ -40,7 +50,44 @@ func main() {
 
 	Logger.Log("store init", "initialized successfully")
 
-	service := NewInventoryService(store)
+	_reservationTTL, err := time.ParseDuration(reservationTTL)
+	if err != nil {
+		Logger.FatalLog("service init", "invalid reservation ttl %q: %v", reservationTTL, err)
+	}
+
+	_reservationSweepInterval, err := time.ParseDuration(reservationSweepInterval)
+	if err != nil {
+		Logger.FatalLog("service init", "invalid reservation sweep interval %q: %v", reservationSweepInterval, err)
+	}
+
+	_stockSnapshotInterval, err := time.ParseDuration(stockSnapshotInterval)
+	if err != nil {
+		Logger.FatalLog("service init", "invalid stock snapshot interval %q: %v", stockSnapshotInterval, err)
+	}
+
+	_alertPollInterval, err := time.ParseDuration(alertPollInterval)
+	if err != nil {
+		Logger.FatalLog("service init", "invalid alert poll interval %q: %v", alertPollInterval, err)
+	}
+
+	_correctionApprovalQuantity, err := strconv.ParseInt(correctionApprovalQuantity, 10, 64)
+	if err != nil {
+		Logger.FatalLog("service init", "invalid correction approval quantity %q: %v", correctionApprovalQuantity, err)
+	}
+
+	_correctionApprovalValue, err := strconv.ParseFloat(correctionApprovalValue, 64)
+	if err != nil {
+		Logger.FatalLog("service init", "invalid correction approval value %q: %v", correctionApprovalValue, err)
+	}
+
+	service := NewInventoryService(store, _reservationTTL, CorrectionThresholds{
+		Quantity: _correctionApprovalQuantity,
+		Value:    _correctionApprovalValue,
+	})
+
+	go service.RunReservationSweeper(context.Background(), _reservationSweepInterval)
+	go service.RunSnapshotScheduler(context.Background(), _stockSnapshotInterval)
+	go service.RunAlertDispatcher(context.Background(), _alertPollInterval)
 
 	consulCient, err := consul.NewClient(consulAddr)
 	if err != nil {
@@ -2,45 +2,577 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
 
 	pb "github.com/logan2k02/ims/shared/protobuf"
 )
 
 type inventoryService struct {
-	store *inventoryStore
+	store          *inventoryStore
+	reservationTTL time.Duration
+	alerts         *alertBroker
+	corrections    CorrectionThresholds
 }
 
-func NewInventoryService(store *inventoryStore) *inventoryService {
-	return &inventoryService{store}
+func NewInventoryService(store *inventoryStore, reservationTTL time.Duration, corrections CorrectionThresholds) *inventoryService {
+	return &inventoryService{store, reservationTTL, newAlertBroker(), corrections}
 }
 
 func (s *inventoryService) Purchase(ctx context.Context, payload *pb.PurchaseInventoryRequest) (*pb.StockMovement, error) {
 	return s.store.UpdateStockQuantity(ctx, &UpdateStockDto{
-		ProductId: payload.ProductId,
-		Change:    -payload.Quantity,
-		Reference: payload.Reference,
-		Type:      "purchase",
+		ProductId:     payload.ProductId,
+		LocationId:    payload.LocationId,
+		Change:        -payload.Quantity,
+		Reference:     payload.Reference,
+		Type:          "purchase",
+		SerialNumbers: payload.SerialNumbers,
+		Unit:          payload.Unit,
 	})
 }
 
-func (s *inventoryService) Supply(ctx context.Context, payload *pb.ManageInventoryRequest) (*pb.StockMovement, error) {
+func (s *inventoryService) Restock(ctx context.Context, payload *pb.PurchaseInventoryRequest) (*pb.StockMovement, error) {
 	return s.store.UpdateStockQuantity(ctx, &UpdateStockDto{
-		ProductId: payload.ProductId,
-		Change:    payload.Quantity,
-		Note:      payload.Note,
-		Type:      "supply",
+		ProductId:     payload.ProductId,
+		LocationId:    payload.LocationId,
+		Change:        payload.Quantity,
+		Reference:     payload.Reference,
+		Type:          "restock",
+		SerialNumbers: payload.SerialNumbers,
+		Unit:          payload.Unit,
+	})
+}
+
+func (s *inventoryService) Return(ctx context.Context, payload *pb.PurchaseInventoryRequest) (*pb.StockMovement, error) {
+	return s.store.UpdateStockQuantity(ctx, &UpdateStockDto{
+		ProductId:     payload.ProductId,
+		LocationId:    payload.LocationId,
+		Change:        payload.Quantity,
+		Reference:     payload.Reference,
+		Type:          "return",
+		SerialNumbers: payload.SerialNumbers,
+		Unit:          payload.Unit,
 	})
 }
 
-func (s *inventoryService) CorrectStockQuantity(ctx context.Context, payload *pb.ManageInventoryRequest) (*pb.StockMovement, error) {
+func (s *inventoryService) Supply(ctx context.Context, payload *pb.ManageInventoryRequest) (*pb.StockMovement, error) {
 	return s.store.UpdateStockQuantity(ctx, &UpdateStockDto{
+		ProductId:     payload.ProductId,
+		LocationId:    payload.LocationId,
+		Change:        payload.Quantity,
+		Note:          payload.Note,
+		Type:          "supply",
+		Reference:     payload.Reference,
+		LotNumber:     payload.LotNumber,
+		ExpiresAt:     payload.ExpiresAt,
+		SerialNumbers: payload.SerialNumbers,
+		UnitCost:      payload.UnitCost,
+		Unit:          payload.Unit,
+	})
+}
+
+func (s *inventoryService) CorrectStockQuantity(ctx context.Context, payload *pb.ManageInventoryRequest) (*pb.StockCorrection, error) {
+	correction, err := s.store.CorrectStock(ctx, &CorrectStockDto{
+		ProductId:     payload.ProductId,
+		LocationId:    payload.LocationId,
+		Quantity:      payload.Quantity,
+		Unit:          payload.Unit,
+		ReasonCode:    payload.ReasonCode,
+		Note:          payload.Note,
+		RequestedBy:   payload.RequestedBy,
+		SerialNumbers: payload.SerialNumbers,
+	}, s.corrections)
+	if err != nil {
+		return nil, err
+	}
+
+	if correction.Status == "pending" {
+		Logger.Log("stock correction", "correction %d of product %d by %s is pending approval (change %+d, value %.2f)", correction.Id, correction.ProductId, correction.RequestedBy, correction.Change, correction.Value)
+	}
+
+	return correction, nil
+}
+
+func (s *inventoryService) ApproveStockCorrection(ctx context.Context, payload *pb.ReviewStockCorrectionRequest) (*pb.StockCorrection, error) {
+	return s.store.ReviewStockCorrection(ctx, payload.Id, payload.ReviewedBy, true)
+}
+
+func (s *inventoryService) RejectStockCorrection(ctx context.Context, payload *pb.ReviewStockCorrectionRequest) (*pb.StockCorrection, error) {
+	return s.store.ReviewStockCorrection(ctx, payload.Id, payload.ReviewedBy, false)
+}
+
+func (s *inventoryService) ListStockCorrections(ctx context.Context, payload *pb.ListStockCorrectionsRequest) ([]*pb.StockCorrection, error) {
+	return s.store.ListStockCorrections(ctx, payload.Status, payload.ProductId)
+}
+
+func (s *inventoryService) ListCorrectionReasons(ctx context.Context, includeInactive bool) ([]*pb.CorrectionReason, error) {
+	return s.store.ListCorrectionReasons(ctx, includeInactive)
+}
+
+func (s *inventoryService) SaveCorrectionReason(ctx context.Context, reason *pb.CorrectionReason) (*pb.CorrectionReason, error) {
+	return s.store.SaveCorrectionReason(ctx, reason)
+}
+
+const (
+	defaultMovementsPageSize = 50
+	maxMovementsPageSize     = 500
+)
+
+var errInvalidMovementQuery = errors.New("invalid movement query")
+
+func parseMovementTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
+
+func encodeMovementCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodeMovementCursor(cursor string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(raw), 10, 64)
+}
+
+func (s *inventoryService) ListStockMovements(ctx context.Context, payload *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	limit := payload.Limit
+	if limit <= 0 {
+		limit = defaultMovementsPageSize
+	}
+	limit = min(limit, maxMovementsPageSize)
+
+	query := &ListMovementsDto{
+		ProductId:  payload.ProductId,
+		LocationId: payload.LocationId,
+		Type:       payload.Type,
+		Reference:  payload.Reference,
+		// one extra row tells whether there is a next page
+		Limit: limit + 1,
+	}
+
+	switch payload.SortOrder {
+	case "", "desc":
+	case "asc":
+		query.Ascending = true
+	default:
+		return nil, fmt.Errorf("sort order must be asc or desc: %w", errInvalidMovementQuery)
+	}
+
+	var err error
+	if payload.From != "" {
+		if query.From, err = parseMovementTime(payload.From); err != nil {
+			return nil, fmt.Errorf("from must be an RFC 3339 timestamp or YYYY-MM-DD: %w", errInvalidMovementQuery)
+		}
+	}
+
+	if payload.To != "" {
+		if query.To, err = parseMovementTime(payload.To); err != nil {
+			return nil, fmt.Errorf("to must be an RFC 3339 timestamp or YYYY-MM-DD: %w", errInvalidMovementQuery)
+		}
+	}
+
+	if payload.Cursor != "" {
+		if query.AfterId, err = decodeMovementCursor(payload.Cursor); err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", errInvalidMovementQuery)
+		}
+	}
+
+	records, total, err := s.store.ListStockMovements(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if int64(len(records)) > limit {
+		records = records[:limit]
+		nextCursor = encodeMovementCursor(records[limit-1].Id)
+	}
+
+	return &pb.ListStockMovementsResponse{
+		Records:    records,
+		NextCursor: nextCursor,
+		TotalCount: total,
+	}, nil
+}
+
+func (s *inventoryService) ReverseStockMovement(ctx context.Context, payload *pb.ReverseStockMovementRequest) (*pb.StockMovement, error) {
+	return s.store.ReverseStockMovement(ctx, &ReverseMovementDto{
+		Id:   payload.Id,
+		Note: payload.Note,
+	})
+}
+
+func (s *inventoryService) GetStockAsOf(ctx context.Context, productIds []int64, locationId int64, at time.Time) (*pb.StockAsOfResponse, error) {
+	return s.store.GetStockAsOf(ctx, &StockAsOfDto{
+		ProductIds: productIds,
+		LocationId: locationId,
+		At:         at,
+	})
+}
+
+var errInvalidValuationQuery = errors.New("invalid valuation query")
+
+func (s *inventoryService) GetInventoryValuation(ctx context.Context, payload *pb.InventoryValuationRequest) (*pb.InventoryValuationResponse, error) {
+	query := &InventoryValuationDto{
+		ProductIds: payload.ProductIds,
+		To:         time.Now(),
+	}
+
+	var err error
+	if payload.From != "" {
+		if query.From, err = parseMovementTime(payload.From); err != nil {
+			return nil, fmt.Errorf("from must be an RFC 3339 timestamp or YYYY-MM-DD: %w", errInvalidValuationQuery)
+		}
+	}
+
+	if payload.To != "" {
+		if query.To, err = parseMovementTime(payload.To); err != nil {
+			return nil, fmt.Errorf("to must be an RFC 3339 timestamp or YYYY-MM-DD: %w", errInvalidValuationQuery)
+		}
+	}
+
+	res, err := s.store.GetInventoryValuation(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if !query.From.IsZero() {
+		res.From = query.From.Format(time.RFC3339)
+	}
+	res.To = query.To.Format(time.RFC3339)
+
+	return res, nil
+}
+
+func (s *inventoryService) ReconcileStock(ctx context.Context, payload *pb.ReconcileStockRequest) (*pb.ReconcileStockResponse, error) {
+	res, err := s.store.ReconcileStock(ctx, &ReconcileStockDto{
+		ProductIds: payload.ProductIds,
+		Repair:     payload.Repair,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Repaired && len(res.Discrepancies) > 0 {
+		Logger.Log("reconcile stock", "repaired %d discrepancies", len(res.Discrepancies))
+	}
+
+	return res, nil
+}
+
+// checks regularly so the snapshot for a day is taken soon after it starts,
+// the store skips days that already have one
+func (s *inventoryService) RunSnapshotScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		taken, err := s.store.TakeDailySnapshot(ctx)
+		if err != nil {
+			Logger.LogError("stock snapshot", "failed to take snapshot: %v", err)
+		} else if taken {
+			Logger.Log("stock snapshot", "took daily stock snapshot")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *inventoryService) ReserveStock(ctx context.Context, payload *pb.ReserveStockRequest) (*pb.StockReservation, error) {
+	// order reservations hold their stock until the order ships or is
+	// cancelled, other holds are abandoned once their ttl passes
+	ttlSeconds := payload.TtlSeconds
+	if payload.NoExpiry {
+		ttlSeconds = 0
+	} else if ttlSeconds <= 0 {
+		ttlSeconds = int64(s.reservationTTL / time.Second)
+	}
+
+	return s.store.ReserveStock(ctx, &ReserveStockDto{
+		ProductId:  payload.ProductId,
+		LocationId: payload.LocationId,
+		Quantity:   payload.Quantity,
+		Reference:  payload.Reference,
+		TtlSeconds: ttlSeconds,
+		Unit:       payload.Unit,
+	})
+}
+
+func (s *inventoryService) CommitReservation(ctx context.Context, payload *pb.CommitReservationRequest) (*pb.StockMovement, error) {
+	return s.store.CommitReservation(ctx, &CommitReservationDto{
+		Id:            payload.Id,
+		SerialNumbers: payload.SerialNumbers,
+		Quantity:      payload.Quantity,
+		Reference:     payload.Reference,
+	})
+}
+
+func (s *inventoryService) ReleaseReservation(ctx context.Context, id int64) (*pb.StockReservation, error) {
+	return s.store.ReleaseReservation(ctx, id)
+}
+
+func (s *inventoryService) RunReservationSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := s.store.ExpireReservations(ctx)
+			if err != nil {
+				Logger.LogError("reservation sweeper", "failed to expire reservations: %v", err)
+				continue
+			}
+
+			if count > 0 {
+				Logger.Log("reservation sweeper", "expired %d reservations", count)
+			}
+		}
+	}
+}
+
+func (s *inventoryService) CreateLocation(ctx context.Context, payload *pb.CreateLocationRequest) (*pb.Location, error) {
+	return s.store.CreateLocation(ctx, &CreateLocationDto{
+		Code: payload.Code,
+		Name: payload.Name,
+	})
+}
+
+func (s *inventoryService) ListLocations(ctx context.Context) ([]*pb.Location, error) {
+	return s.store.ListLocations(ctx)
+}
+
+func (s *inventoryService) GetLocationStock(ctx context.Context, locationId int64) (*pb.LocationStockResponse, error) {
+	return s.store.GetLocationStock(ctx, locationId)
+}
+
+func (s *inventoryService) TransferStock(ctx context.Context, payload *pb.TransferStockRequest) (*pb.TransferStockResponse, error) {
+	return s.store.TransferStock(ctx, &TransferStockDto{
+		ProductId:      payload.ProductId,
+		FromLocationId: payload.FromLocationId,
+		ToLocationId:   payload.ToLocationId,
+		Quantity:       payload.Quantity,
+		Note:           payload.Note,
+		SerialNumbers:  payload.SerialNumbers,
+	})
+}
+
+func (s *inventoryService) MoveStockState(ctx context.Context, payload *pb.MoveStockStateRequest) (*pb.MoveStockStateResponse, error) {
+	return s.store.MoveStockState(ctx, &MoveStockStateDto{
+		ProductId:     payload.ProductId,
+		LocationId:    payload.LocationId,
+		Quantity:      payload.Quantity,
+		FromState:     payload.FromState,
+		ToState:       payload.ToState,
+		Note:          payload.Note,
+		SerialNumbers: payload.SerialNumbers,
+	})
+}
+
+func (s *inventoryService) GetProductStockStates(ctx context.Context, productId int64) (*pb.ProductStockStatesResponse, error) {
+	return s.store.GetProductStockStates(ctx, productId)
+}
+
+func (s *inventoryService) AssembleKit(ctx context.Context, payload *pb.AssembleKitRequest) (*pb.KitAssembly, error) {
+	assembly, err := s.store.AssembleKit(ctx, &AssembleKitDto{
+		KitId:      payload.KitId,
+		LocationId: payload.LocationId,
+		Quantity:   payload.Quantity,
+		Note:       payload.Note,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	Logger.Log("assemble kit", "%s assembled %d of kit %d from %d components", assembly.Reference, assembly.Quantity, assembly.KitId, len(assembly.Components))
+
+	return assembly, nil
+}
+
+func (s *inventoryService) ListStockBatches(ctx context.Context, payload *pb.ListStockBatchesRequest) ([]*pb.StockBatch, error) {
+	return s.store.ListBatches(ctx, &ListBatchesDto{
+		ProductId:    payload.ProductId,
+		LocationId:   payload.LocationId,
+		IncludeEmpty: payload.IncludeEmpty,
+	})
+}
+
+func (s *inventoryService) ListExpiringBatches(ctx context.Context, payload *pb.ListExpiringBatchesRequest) ([]*pb.StockBatch, error) {
+	return s.store.ListBatches(ctx, &ListBatchesDto{
+		LocationId:   payload.LocationId,
+		ExpiringDays: payload.Days,
+	})
+}
+
+func (s *inventoryService) ListSerialUnits(ctx context.Context, payload *pb.ListSerialUnitsRequest) ([]*pb.SerialUnit, error) {
+	return s.store.ListSerialUnits(ctx, &ListSerialUnitsDto{
+		ProductId:  payload.ProductId,
+		LocationId: payload.LocationId,
+		Status:     payload.Status,
+	})
+}
+
+func (s *inventoryService) GetSerialHistory(ctx context.Context, payload *pb.SerialHistoryRequest) ([]*pb.SerialUnit, error) {
+	return s.store.GetSerialHistory(ctx, payload.SerialNumber, payload.ProductId)
+}
+
+func (s *inventoryService) ScrapSerialUnits(ctx context.Context, payload *pb.ScrapSerialUnitsRequest, restore bool) ([]*pb.SerialUnit, error) {
+	return s.store.ScrapSerialUnits(ctx, &ScrapSerialUnitsDto{
+		ProductId:     payload.ProductId,
+		Quantity:      payload.Quantity,
+		SerialNumbers: payload.SerialNumbers,
+		Reference:     payload.Reference,
+	}, restore)
+}
+
+func (s *inventoryService) OpenCycleCount(ctx context.Context, payload *pb.OpenCycleCountRequest) (*pb.CycleCount, error) {
+	return s.store.OpenCycleCount(ctx, &OpenCycleCountDto{
+		ProductIds:  payload.ProductIds,
+		LocationIds: payload.LocationIds,
+		Note:        payload.Note,
+	})
+}
+
+func (s *inventoryService) ListCycleCounts(ctx context.Context, countStatus string) ([]*pb.CycleCount, error) {
+	return s.store.ListCycleCounts(ctx, countStatus)
+}
+
+func (s *inventoryService) GetCycleCount(ctx context.Context, id int64) (*pb.CycleCount, error) {
+	return s.store.GetCycleCount(ctx, id)
+}
+
+func (s *inventoryService) RecordCycleCount(ctx context.Context, payload *pb.RecordCycleCountRequest) (*pb.CycleCount, error) {
+	entries := make([]CountEntryDto, len(payload.Entries))
+	for i, entry := range payload.Entries {
+		entries[i] = CountEntryDto{
+			ProductId:  entry.ProductId,
+			LocationId: entry.LocationId,
+			Quantity:   entry.Quantity,
+		}
+	}
+
+	return s.store.RecordCycleCount(ctx, &RecordCycleCountDto{
+		CountId: payload.CountId,
+		Counter: payload.Counter,
+		Entries: entries,
+	})
+}
+
+func (s *inventoryService) SubmitCycleCount(ctx context.Context, id int64) (*pb.CycleCount, error) {
+	return s.store.SubmitCycleCount(ctx, id)
+}
+
+func (s *inventoryService) ApproveCycleCount(ctx context.Context, payload *pb.ApproveCycleCountRequest) (*pb.CycleCount, error) {
+	return s.store.ApproveCycleCount(ctx, &ApproveCycleCountDto{
+		CountId: payload.CountId,
+		LineIds: payload.LineIds,
+		All:     payload.All,
+	})
+}
+
+func (s *inventoryService) PostCycleCount(ctx context.Context, id int64) (*pb.CycleCount, error) {
+	return s.store.PostCycleCount(ctx, id)
+}
+
+func (s *inventoryService) CancelCycleCount(ctx context.Context, id int64) (*pb.CycleCount, error) {
+	return s.store.CancelCycleCount(ctx, id)
+}
+
+type alertBroker struct {
+	mu          sync.Mutex
+	subscribers map[chan *pb.StockAlert]struct{}
+}
+
+func newAlertBroker() *alertBroker {
+	return &alertBroker{
+		subscribers: make(map[chan *pb.StockAlert]struct{}),
+	}
+}
+
+func (b *alertBroker) Subscribe() (<-chan *pb.StockAlert, func()) {
+	ch := make(chan *pb.StockAlert, 16)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+	}
+}
+
+// slow subscribers miss alerts rather than holding up the others, they can
+// catch up through ListStockAlerts
+func (b *alertBroker) Publish(alert *pb.StockAlert) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- alert:
+		default:
+		}
+	}
+}
+
+func (s *inventoryService) ListStockAlerts(ctx context.Context, payload *pb.ListStockAlertsRequest) ([]*pb.StockAlert, error) {
+	return s.store.ListAlerts(ctx, &ListAlertsDto{
 		ProductId: payload.ProductId,
-		Change:    payload.Quantity,
-		Note:      payload.Note,
-		Type:      "correction",
+		Status:    payload.Status,
 	})
 }
 
-func (s *inventoryService) ListStockMovements(ctx context.Context, productId int64) ([]*pb.StockMovement, error) {
-	return s.store.ListStockMovements(ctx, productId)
+func (s *inventoryService) SubscribeStockAlerts() (<-chan *pb.StockAlert, func()) {
+	return s.alerts.Subscribe()
+}
+
+// alerts are raised inside stock transactions, polling the table publishes
+// them only once they are committed. Each alert is marked once published, so
+// one committed late behind a newer alert is still picked up and a restart
+// does not publish old alerts again.
+func (s *inventoryService) RunAlertDispatcher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			alerts, err := s.store.ListUndispatchedAlerts(ctx)
+			if err != nil {
+				Logger.LogError("alert dispatcher", "failed to list alerts: %v", err)
+				continue
+			}
+
+			ids := make([]int64, len(alerts))
+			for i, alert := range alerts {
+				Logger.Log("alert dispatcher", "product %d is low on stock: %d left, reorder level %d", alert.ProductId, alert.StockQuantity, alert.ReorderLevel)
+				s.alerts.Publish(alert)
+				ids[i] = alert.Id
+			}
+
+			// an alert that cannot be marked goes out again on the next tick
+			if err := s.store.MarkAlertsDispatched(ctx, ids); err != nil {
+				Logger.LogError("alert dispatcher", "failed to mark %d alerts dispatched: %v", len(ids), err)
+			}
+		}
+	}
 }
@@ -1,12 +1,18 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/logan2k02/ims/shared/schema"
 	"github.com/logan2k02/ims/shared/utils"
 
 	pb "github.com/logan2k02/ims/shared/protobuf"
@@ -54,6 +60,12 @@ func (s *inventoryStore) Close() error {
 	return nil
 }
 
+// every movement type, tables created before a type was added are migrated to it
+const movementTypeDefinition = `ENUM('purchase', 'supply', 'correction', 'restock', 'transfer_out', 'transfer_in', 'reversal', 'assembly_out', 'assembly_in', 'return', 'state_out', 'state_in') NOT NULL`
+
+// every serial unit status, a unit in a held stock state is on hand but cannot be sold
+const serialStatusDefinition = `ENUM('in_stock', 'sold', 'returned', 'quarantined', 'damaged', 'in_transit', 'scrapped') NOT NULL`
+
 func (s *inventoryStore) Init() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
 	defer cancel()
@@ -62,40 +74,4187 @@ func (s *inventoryStore) Init() error {
 		return err
 	}
 
-	defer func() {
-		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
-			Logger.LogError("init products store", "failed to rollback transaction: %v", err)
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("init products store", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS locations (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		code VARCHAR(50) NOT NULL UNIQUE,
+		name VARCHAR(255) NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT IGNORE INTO locations (id, code, name) VALUES (?, 'MAIN', 'Main warehouse')`, defaultLocationId)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS location_stock (
+		location_id INT NOT NULL,
+		product_id INT NOT NULL,
+		quantity INT NOT NULL DEFAULT 0,
+		PRIMARY KEY (location_id, product_id),
+		FOREIGN KEY (location_id) REFERENCES locations(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS location_stock_states (
+		location_id INT NOT NULL,
+		product_id INT NOT NULL,
+		state ENUM('quarantined', 'damaged', 'in_transit') NOT NULL,
+		quantity INT NOT NULL DEFAULT 0,
+		PRIMARY KEY (location_id, product_id, state),
+		FOREIGN KEY (location_id) REFERENCES locations(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS stock_movements (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		product_id INT NOT NULL,
+		location_id INT NOT NULL DEFAULT 1,
+		quantity_change INT NOT NULL,
+    	type `+movementTypeDefinition+`,
+		reference VARCHAR(100),
+    	note TEXT,
+		reversal_of INT,
+		reversed_by INT,
+		unit_cost DECIMAL(12, 4),
+		total_cost DECIMAL(14, 4),
+		unit VARCHAR(20),
+		unit_quantity INT,
+		unit_factor INT,
+		reason_code VARCHAR(30),
+		stock_state ENUM('available', 'quarantined', 'damaged', 'in_transit'),
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (location_id) REFERENCES locations(id) ON UPDATE CASCADE,
+		FOREIGN KEY (reversal_of) REFERENCES stock_movements(id) ON UPDATE CASCADE,
+		FOREIGN KEY (reversed_by) REFERENCES stock_movements(id) ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.ModifyColumn(ctx, tx, "stock_movements", "type", movementTypeDefinition); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_movements", "location_id", "INT NOT NULL DEFAULT 1"); err != nil {
+		return err
+	}
+
+	if err := schema.AddForeignKey(ctx, tx, "stock_movements", "fk_stock_movements_location", "location_id", "locations(id) ON UPDATE CASCADE"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_movements", "reversal_of", "INT"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_movements", "reversed_by", "INT"); err != nil {
+		return err
+	}
+
+	if err := schema.AddForeignKey(ctx, tx, "stock_movements", "fk_stock_movements_reversal_of", "reversal_of", "stock_movements(id) ON UPDATE CASCADE"); err != nil {
+		return err
+	}
+
+	if err := schema.AddForeignKey(ctx, tx, "stock_movements", "fk_stock_movements_reversed_by", "reversed_by", "stock_movements(id) ON UPDATE CASCADE"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_movements", "unit_cost", "DECIMAL(12, 4)"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_movements", "total_cost", "DECIMAL(14, 4)"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_movements", "unit", "VARCHAR(20)"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_movements", "unit_quantity", "INT"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_movements", "unit_factor", "INT"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_movements", "reason_code", "VARCHAR(30)"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_movements", "stock_state", "ENUM('available', 'quarantined', 'damaged', 'in_transit')"); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS stock_reservations (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		product_id INT NOT NULL,
+		location_id INT NOT NULL DEFAULT 1,
+		quantity INT NOT NULL,
+		reference VARCHAR(100),
+		status ENUM('active', 'committed', 'released', 'expired') NOT NULL DEFAULT 'active',
+		unit VARCHAR(20),
+		unit_quantity INT,
+		unit_factor INT,
+		committed_quantity INT NOT NULL DEFAULT 0,
+		movement_id INT,
+		expires_at TIMESTAMP NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (location_id) REFERENCES locations(id) ON UPDATE CASCADE,
+		FOREIGN KEY (movement_id) REFERENCES stock_movements(id) ON DELETE SET NULL ON UPDATE CASCADE,
+		INDEX (status, expires_at)
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_reservations", "location_id", "INT NOT NULL DEFAULT 1"); err != nil {
+		return err
+	}
+
+	if err := schema.AddForeignKey(ctx, tx, "stock_reservations", "fk_stock_reservations_location", "location_id", "locations(id) ON UPDATE CASCADE"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_reservations", "unit", "VARCHAR(20)"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_reservations", "unit_quantity", "INT"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_reservations", "unit_factor", "INT"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "stock_reservations", "committed_quantity", "INT NOT NULL DEFAULT 0", `UPDATE stock_reservations SET committed_quantity = quantity WHERE status = 'committed'`); err != nil {
+		return err
+	}
+
+	if err := schema.ModifyColumn(ctx, tx, "stock_reservations", "expires_at", "TIMESTAMP NULL"); err != nil {
+		return err
+	}
+
+	// every commit of a reservation, a reservation can be committed in parts
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS reservation_commits (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		reservation_id INT NOT NULL,
+		movement_id INT NOT NULL UNIQUE,
+		quantity INT NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (reservation_id) REFERENCES stock_reservations(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (movement_id) REFERENCES stock_movements(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS stock_batches (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		product_id INT NOT NULL,
+		location_id INT NOT NULL,
+		lot_number VARCHAR(100) NOT NULL DEFAULT '',
+		expires_at DATE,
+		received_quantity INT NOT NULL,
+		remaining_quantity INT NOT NULL,
+		movement_id INT NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (location_id) REFERENCES locations(id) ON UPDATE CASCADE,
+		FOREIGN KEY (movement_id) REFERENCES stock_movements(id) ON UPDATE CASCADE,
+		INDEX (product_id, location_id, expires_at)
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS stock_batch_movements (
+		batch_id INT NOT NULL,
+		movement_id INT NOT NULL,
+		quantity INT NOT NULL,
+		PRIMARY KEY (batch_id, movement_id),
+		FOREIGN KEY (batch_id) REFERENCES stock_batches(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (movement_id) REFERENCES stock_movements(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS serial_units (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		product_id INT NOT NULL,
+		serial_number VARCHAR(100) NOT NULL,
+		location_id INT NOT NULL,
+		status `+serialStatusDefinition+` DEFAULT 'in_stock',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		UNIQUE (product_id, serial_number),
+		INDEX (serial_number),
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (location_id) REFERENCES locations(id) ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.ModifyColumn(ctx, tx, "serial_units", "status", serialStatusDefinition+" DEFAULT 'in_stock'"); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS serial_unit_events (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		serial_unit_id INT NOT NULL,
+		movement_id INT,
+		status `+serialStatusDefinition+`,
+		location_id INT NOT NULL,
+		reference VARCHAR(100),
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (serial_unit_id) REFERENCES serial_units(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (movement_id) REFERENCES stock_movements(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (location_id) REFERENCES locations(id) ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.ModifyColumn(ctx, tx, "serial_unit_events", "status", serialStatusDefinition); err != nil {
+		return err
+	}
+
+	// units scrapped off a return are not moved by a movement
+	if err := schema.ModifyColumn(ctx, tx, "serial_unit_events", "movement_id", "INT"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "serial_unit_events", "reference", "VARCHAR(100)"); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS stock_snapshots (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		snapshot_date DATE NOT NULL UNIQUE,
+		last_movement_id INT NOT NULL DEFAULT 0,
+		taken_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		INDEX (taken_at)
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS stock_snapshot_levels (
+		snapshot_id INT NOT NULL,
+		product_id INT NOT NULL,
+		location_id INT NOT NULL,
+		quantity INT NOT NULL,
+		PRIMARY KEY (snapshot_id, product_id, location_id),
+		FOREIGN KEY (snapshot_id) REFERENCES stock_snapshots(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (location_id) REFERENCES locations(id) ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS cycle_counts (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		status ENUM('open', 'review', 'posted', 'cancelled') NOT NULL DEFAULT 'open',
+		note TEXT,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		posted_at TIMESTAMP NULL
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS cycle_count_lines (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		count_id INT NOT NULL,
+		product_id INT NOT NULL,
+		location_id INT NOT NULL,
+		system_quantity INT NOT NULL,
+		approved BOOLEAN NOT NULL DEFAULT FALSE,
+		movement_id INT,
+		UNIQUE (count_id, product_id, location_id),
+		FOREIGN KEY (count_id) REFERENCES cycle_counts(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (location_id) REFERENCES locations(id) ON UPDATE CASCADE,
+		FOREIGN KEY (movement_id) REFERENCES stock_movements(id) ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS cycle_count_entries (
+		line_id INT NOT NULL,
+		counter VARCHAR(100) NOT NULL,
+		quantity INT NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		PRIMARY KEY (line_id, counter),
+		FOREIGN KEY (line_id) REFERENCES cycle_count_lines(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS alerts (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		product_id INT NOT NULL,
+		type ENUM('low_stock') NOT NULL DEFAULT 'low_stock',
+		status ENUM('open', 'resolved') NOT NULL DEFAULT 'open',
+		stock_quantity INT NOT NULL,
+		reorder_level INT NOT NULL,
+		reorder_quantity INT NOT NULL,
+		movement_id INT,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		resolved_at TIMESTAMP NULL,
+		dispatched_at TIMESTAMP NULL,
+		INDEX (product_id, type, status),
+		INDEX (dispatched_at),
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (movement_id) REFERENCES stock_movements(id) ON DELETE SET NULL ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "alerts", "dispatched_at", "TIMESTAMP NULL", `UPDATE alerts SET dispatched_at = created_at`); err != nil {
+		return err
+	}
+
+	if err := schema.AddIndex(ctx, tx, "alerts", "idx_alerts_dispatched_at", "dispatched_at"); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS product_valuations (
+		product_id INT PRIMARY KEY,
+		quantity INT NOT NULL DEFAULT 0,
+		value DECIMAL(14, 4) NOT NULL DEFAULT 0,
+		last_unit_cost DECIMAL(12, 4) NOT NULL DEFAULT 0,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS cost_layers (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		product_id INT NOT NULL,
+		movement_id INT,
+		unit_cost DECIMAL(12, 4) NOT NULL,
+		received_quantity INT NOT NULL,
+		remaining_quantity INT NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		INDEX (product_id, remaining_quantity),
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (movement_id) REFERENCES stock_movements(id) ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS kit_assemblies (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		kit_id INT NOT NULL,
+		location_id INT NOT NULL,
+		quantity INT NOT NULL,
+		note TEXT,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (kit_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (location_id) REFERENCES locations(id) ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS correction_reasons (
+		code VARCHAR(30) PRIMARY KEY,
+		name VARCHAR(100) NOT NULL,
+		active BOOLEAN NOT NULL DEFAULT TRUE,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT IGNORE INTO correction_reasons (code, name) VALUES
+		('damage', 'Damage'),
+		('theft', 'Theft'),
+		('count_variance', 'Count variance'),
+		('expiry', 'Expiry')
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS stock_corrections (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		product_id INT NOT NULL,
+		location_id INT NOT NULL,
+		quantity INT NOT NULL,
+		unit VARCHAR(20),
+		unit_quantity INT,
+		unit_factor INT,
+		reason_code VARCHAR(30) NOT NULL,
+		note TEXT,
+		status ENUM('pending', 'posted', 'rejected') NOT NULL,
+		requested_by VARCHAR(100) NOT NULL,
+		reviewed_by VARCHAR(100),
+		change_quantity INT NOT NULL,
+		value DECIMAL(14, 4) NOT NULL DEFAULT 0,
+		movement_id INT,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		reviewed_at TIMESTAMP NULL,
+		INDEX (status),
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (location_id) REFERENCES locations(id) ON UPDATE CASCADE,
+		FOREIGN KEY (reason_code) REFERENCES correction_reasons(code) ON UPDATE CASCADE,
+		FOREIGN KEY (movement_id) REFERENCES stock_movements(id) ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS stock_correction_serials (
+		correction_id INT NOT NULL,
+		serial_number VARCHAR(100) NOT NULL,
+		PRIMARY KEY (correction_id, serial_number),
+		FOREIGN KEY (correction_id) REFERENCES stock_corrections(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	if err := seedLocationStock(ctx, tx, 0); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+const defaultLocationId = 1
+
+// products that have never been moved per location hold all of their stock
+// in the default location
+func seedLocationStock(ctx context.Context, tx *sql.Tx, productId int64) error {
+	query := `
+	INSERT INTO location_stock (location_id, product_id, quantity)
+	SELECT ?, p.id, p.stock_quantity
+	FROM products p
+	WHERE NOT EXISTS (SELECT 1 FROM location_stock ls WHERE ls.product_id = p.id)
+	`
+	args := []any{defaultLocationId}
+	if productId > 0 {
+		query += " AND p.id = ?"
+		args = append(args, productId)
+	}
+
+	_, err := tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+func resolveLocationId(locationId int64) int64 {
+	if locationId <= 0 {
+		return defaultLocationId
+	}
+	return locationId
+}
+
+type insufficientStockError struct {
+	ProductId  int64
+	LocationId int64
+	Available  int64
+	Requested  int64
+}
+
+func (e *insufficientStockError) Error() string {
+	if e.LocationId > 0 {
+		return fmt.Sprintf("insufficient stock for product %d at location %d: %d available, %d requested", e.ProductId, e.LocationId, e.Available, e.Requested)
+	}
+	return fmt.Sprintf("insufficient stock for product %d: %d available, %d requested", e.ProductId, e.Available, e.Requested)
+}
+
+type lockedProduct struct {
+	Available      int64
+	AllowBackorder bool
+	Serialized     bool
+	CostingMethod  string
+}
+
+// locks the product row so the availability check and the following update
+// happen atomically, every stock change takes this lock first
+func lockAvailableStock(ctx context.Context, tx *sql.Tx, productId int64, requested int64) (*lockedProduct, error) {
+	var product lockedProduct
+
+	row := tx.QueryRowContext(ctx, `SELECT stock_quantity - reserved_quantity - held_quantity, allow_backorder, serialized, costing_method FROM products WHERE id = ? FOR UPDATE`, productId)
+	if err := row.Scan(&product.Available, &product.AllowBackorder, &product.Serialized, &product.CostingMethod); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("product %d not found", productId)
+		}
+		return nil, err
+	}
+
+	if requested > 0 && !product.AllowBackorder && product.Available < requested {
+		return nil, &insufficientStockError{
+			ProductId: productId,
+			Available: max(product.Available, 0),
+			Requested: requested,
+		}
+	}
+
+	return &product, nil
+}
+
+func lockLocationStock(ctx context.Context, tx *sql.Tx, locationId int64, productId int64) (int64, error) {
+	var quantity int64
+	row := tx.QueryRowContext(ctx, `SELECT quantity FROM location_stock WHERE location_id = ? AND product_id = ? FOR UPDATE`, locationId, productId)
+	if err := row.Scan(&quantity); err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	return quantity, nil
+}
+
+// units at a location that are quarantined, damaged or in transit, these
+// are on hand but cannot be sold, transferred or consumed
+var errLocationNotFound = errors.New("location not found")
+
+func lockLocation(ctx context.Context, tx *sql.Tx, locationId int64) error {
+	var id int64
+	if err := tx.QueryRowContext(ctx, `SELECT id FROM locations WHERE id = ? FOR UPDATE`, locationId).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("location %d: %w", locationId, errLocationNotFound)
+		}
+		return err
+	}
+	return nil
+}
+
+// base units active reservations at the location still hold
+func lockReservedStock(ctx context.Context, tx *sql.Tx, locationId int64, productId int64) (int64, error) {
+	var quantity int64
+	row := tx.QueryRowContext(ctx, `SELECT COALESCE(SUM(quantity - committed_quantity), 0) FROM stock_reservations WHERE location_id = ? AND product_id = ? AND status = 'active' FOR UPDATE`, locationId, productId)
+	if err := row.Scan(&quantity); err != nil {
+		return 0, err
+	}
+	return quantity, nil
+}
+
+func lockHeldStock(ctx context.Context, tx *sql.Tx, locationId int64, productId int64) (int64, error) {
+	var quantity int64
+	row := tx.QueryRowContext(ctx, `SELECT COALESCE(SUM(quantity), 0) FROM location_stock_states WHERE location_id = ? AND product_id = ? FOR UPDATE`, locationId, productId)
+	if err := row.Scan(&quantity); err != nil {
+		return 0, err
+	}
+	return quantity, nil
+}
+
+func changeLocationStock(ctx context.Context, tx *sql.Tx, locationId int64, productId int64, change int64) error {
+	query := `
+	INSERT INTO location_stock (location_id, product_id, quantity)
+	VALUES (?, ?, ?)
+	ON DUPLICATE KEY UPDATE quantity = quantity + ?
+	`
+	_, err := tx.ExecContext(ctx, query, locationId, productId, change, change)
+	return err
+}
+
+type UpdateStockDto struct {
+	ProductId     int64
+	LocationId    int64
+	Change        int64
+	Reference     string
+	Note          string
+	Type          string
+	LotNumber     string
+	ExpiresAt     string
+	SerialNumbers []string
+	ReversalOf    int64
+	UnitCost      float64
+	// Change is given in this unit of measure and converted to base units,
+	// unless UnitFactor is set because it was converted already
+	Unit         string
+	UnitQuantity int64
+	UnitFactor   int64
+	ReasonCode   string
+}
+
+func (s *inventoryStore) UpdateStockQuantity(ctx context.Context, payload *UpdateStockDto) (*pb.StockMovement, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("update stock quantity", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	record, err := s.updateStockQuantity(ctx, tx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (s *inventoryStore) updateStockQuantity(ctx context.Context, tx *sql.Tx, payload *UpdateStockDto) (*pb.StockMovement, error) {
+	locationId := resolveLocationId(payload.LocationId)
+
+	if payload.Unit != "" && payload.UnitFactor == 0 {
+		factor, err := unitFactor(ctx, tx, payload.ProductId, payload.Unit)
+		if err != nil {
+			return nil, err
+		}
+
+		change, err := toBaseUnits(payload.Change, factor)
+		if err != nil {
+			return nil, err
+		}
+
+		converted := *payload
+		converted.Change = change
+		converted.UnitQuantity = max(payload.Change, -payload.Change)
+		converted.UnitFactor = factor
+		payload = &converted
+	}
+
+	if err := seedLocationStock(ctx, tx, payload.ProductId); err != nil {
+		return nil, err
+	}
+
+	// kits sold beyond their assembled stock are assembled from their
+	// components on the spot, in the same transaction as the sale
+	if payload.Type == "purchase" {
+		if err := s.assembleShortfall(ctx, tx, payload.ProductId, locationId, -payload.Change, payload.Reference); err != nil {
+			return nil, err
+		}
+	}
+
+	var requested int64
+	if payload.Type != "correction" && payload.Change < 0 {
+		requested = -payload.Change
+	}
+
+	product, err := lockAvailableStock(ctx, tx, payload.ProductId, requested)
+	if err != nil {
+		return nil, err
+	}
+
+	locationQuantity, err := lockLocationStock(ctx, tx, locationId, payload.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	change := payload.Change
+	if payload.Type == "correction" {
+		change = payload.Change - locationQuantity
+	} else if change < 0 && !product.AllowBackorder {
+		held, err := lockHeldStock(ctx, tx, locationId, payload.ProductId)
+		if err != nil {
+			return nil, err
+		}
+
+		if available := locationQuantity - held; available+change < 0 {
+			return nil, &insufficientStockError{
+				ProductId:  payload.ProductId,
+				LocationId: locationId,
+				Available:  max(available, 0),
+				Requested:  -change,
+			}
+		}
+	}
+
+	// a correction names the units it adds or writes off, so it is checked
+	// against the difference to the level on hand
+	if err := checkSerialNumbers(payload.ProductId, product.Serialized, payload.Type, payload.SerialNumbers, change); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE products SET stock_quantity = stock_quantity + ? WHERE id = ?`, change, payload.ProductId); err != nil {
+		return nil, err
+	}
+
+	if err := changeLocationStock(ctx, tx, locationId, payload.ProductId, change); err != nil {
+		return nil, err
+	}
+
+	record, err := insertMovement(ctx, tx, &movementDto{
+		ProductId:    payload.ProductId,
+		LocationId:   locationId,
+		Quantity:     payload.Change,
+		Type:         payload.Type,
+		Reference:    payload.Reference,
+		Note:         payload.Note,
+		ReversalOf:   payload.ReversalOf,
+		Unit:         payload.Unit,
+		UnitQuantity: payload.UnitQuantity,
+		UnitFactor:   payload.UnitFactor,
+		ReasonCode:   payload.ReasonCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// every supply is received as a batch, anything taken out is drawn from
+	// batches first-expired-first-out, reversals go back to the batches the
+	// original movement touched
+	if payload.Type == "reversal" {
+		if err := reverseBatches(ctx, tx, payload.ReversalOf, record.Id, change); err != nil {
+			return nil, err
+		}
+	} else if payload.Type == "supply" {
+		if _, err := insertBatch(ctx, tx, &batchDto{
+			ProductId:  payload.ProductId,
+			LocationId: locationId,
+			LotNumber:  payload.LotNumber,
+			ExpiresAt:  payload.ExpiresAt,
+			Quantity:   change,
+			MovementId: record.Id,
+		}); err != nil {
+			return nil, err
+		}
+	} else if change < 0 {
+		if _, err := consumeBatches(ctx, tx, payload.ProductId, locationId, -change, record.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := moveSerialUnits(ctx, tx, record, change, payload.SerialNumbers); err != nil {
+		return nil, err
+	}
+
+	if err := valueMovement(ctx, tx, product.CostingMethod, record, change, payload.UnitCost); err != nil {
+		return nil, err
+	}
+
+	if err := evaluateLowStock(ctx, tx, payload.ProductId, record.Id); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+type movementDto struct {
+	ProductId    int64
+	LocationId   int64
+	Quantity     int64
+	Type         string
+	Reference    string
+	Note         string
+	ReversalOf   int64
+	Unit         string
+	UnitQuantity int64
+	UnitFactor   int64
+	ReasonCode   string
+	State        string
+}
+
+const movementColumns = `id, product_id, location_id, quantity_change, type, reference, note, COALESCE(reversal_of, 0), COALESCE(reversed_by, 0), COALESCE(unit_cost, 0), COALESCE(total_cost, 0), COALESCE(unit, ''), COALESCE(unit_quantity, 0), COALESCE(unit_factor, 0), COALESCE(reason_code, ''), COALESCE(stock_state, ''), created_at`
+
+func scanMovement(row rowScanner) (*pb.StockMovement, error) {
+	var record pb.StockMovement
+	if err := row.Scan(&record.Id, &record.ProductId, &record.LocationId, &record.Change, &record.Type, &record.Reference, &record.Note, &record.ReversalOf, &record.ReversedBy, &record.UnitCost, &record.TotalCost, &record.Unit, &record.UnitQuantity, &record.UnitFactor, &record.ReasonCode, &record.State, &record.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// replays one ledger entry onto a location level, corrections record the
+// level they set and reversals a signed change
+func applyMovement(level int64, movementType string, quantity int64) int64 {
+	switch movementType {
+	case "correction":
+		return quantity
+	case "reversal":
+		return level + quantity
+	case "purchase", "transfer_out", "assembly_out", "state_out":
+		return level - quantity
+	}
+	return level + quantity
+}
+
+type stockKey struct {
+	productId, locationId int64
+}
+
+type ledgerEntry struct {
+	key          stockKey
+	quantity     int64
+	movementType string
+}
+
+// takes the movements, newest first, back off the levels they left
+func rewindLevels(levels map[stockKey]int64, movements []ledgerEntry) error {
+	for _, movement := range movements {
+		level, ok := unapplyMovement(levels[movement.key], movement.movementType, movement.quantity)
+		if !ok {
+			return fmt.Errorf("product %d at location %d was corrected since: %w", movement.key.productId, movement.key.locationId, errStockHistoryUnavailable)
+		}
+		levels[movement.key] = level
+	}
+	return nil
+}
+
+// takes one ledger entry back off the level it left, the level before a
+// correction is not recorded so corrections cannot be undone
+func unapplyMovement(level int64, movementType string, quantity int64) (int64, bool) {
+	switch movementType {
+	case "correction":
+		return 0, false
+	case "reversal":
+		return level - quantity, true
+	case "purchase", "transfer_out", "assembly_out", "state_out":
+		return level + quantity, true
+	}
+	return level - quantity, true
+}
+
+// the ledger stores unsigned quantities, the direction follows from the type,
+// corrections store the level they set which may be negative on backorder and
+// reversals keep their sign since they can go either way
+func insertMovement(ctx context.Context, tx *sql.Tx, payload *movementDto) (*pb.StockMovement, error) {
+	quantityChange := payload.Quantity
+	if quantityChange < 0 && payload.Type != "correction" && payload.Type != "reversal" {
+		quantityChange = -quantityChange
+	}
+
+	var reversalOf any
+	if payload.ReversalOf > 0 {
+		reversalOf = payload.ReversalOf
+	}
+
+	var unit, unitQuantity, unitFactor any
+	if payload.Unit != "" {
+		unit, unitQuantity, unitFactor = payload.Unit, payload.UnitQuantity, payload.UnitFactor
+	}
+
+	var reasonCode, state any
+	if payload.ReasonCode != "" {
+		reasonCode = payload.ReasonCode
+	}
+	if payload.State != "" {
+		state = payload.State
+	}
+
+	query := `
+	INSERT INTO stock_movements (product_id, location_id, quantity_change, type, reference, note, reversal_of, unit, unit_quantity, unit_factor, reason_code, stock_state)
+	VALUES (?,?,?,?,?,?,?,?,?,?,?,?)
+	`
+
+	result, err := tx.ExecContext(ctx, query, payload.ProductId, payload.LocationId, quantityChange, payload.Type, payload.Reference, payload.Note, reversalOf, unit, unitQuantity, unitFactor, reasonCode, state)
+	if err != nil {
+		return nil, err
+	}
+
+	insertedId, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMovement(tx.QueryRowContext(ctx, `SELECT `+movementColumns+` FROM stock_movements WHERE id=?`, insertedId))
+}
+
+type ListMovementsDto struct {
+	ProductId  int64
+	LocationId int64
+	Type       string
+	Reference  string
+	From       time.Time
+	To         time.Time
+	AfterId    int64
+	Limit      int64
+	Ascending  bool
+}
+
+// pages are keyed on the movement id, which follows the order movements were
+// written in, the total count ignores the cursor
+func (s *inventoryStore) ListStockMovements(ctx context.Context, payload *ListMovementsDto) ([]*pb.StockMovement, int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get stock movements", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var conditions []string
+	var args []any
+
+	if payload.ProductId > 0 {
+		conditions = append(conditions, "product_id = ?")
+		args = append(args, payload.ProductId)
+	}
+
+	if payload.LocationId > 0 {
+		conditions = append(conditions, "location_id = ?")
+		args = append(args, payload.LocationId)
+	}
+
+	if payload.Type != "" {
+		conditions = append(conditions, "type = ?")
+		args = append(args, payload.Type)
+	}
+
+	if payload.Reference != "" {
+		conditions = append(conditions, "reference = ?")
+		args = append(args, payload.Reference)
+	}
+
+	if !payload.From.IsZero() {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, payload.From)
+	}
+
+	if !payload.To.IsZero() {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, payload.To)
+	}
+
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM stock_movements`
+	if len(conditions) > 0 {
+		countQuery += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	if err := tx.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	order := "DESC"
+	if payload.Ascending {
+		order = "ASC"
+	}
+
+	if payload.AfterId > 0 {
+		if payload.Ascending {
+			conditions = append(conditions, "id > ?")
+		} else {
+			conditions = append(conditions, "id < ?")
+		}
+		args = append(args, payload.AfterId)
+	}
+
+	query := `SELECT ` + movementColumns + ` FROM stock_movements`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id " + order + " LIMIT ?"
+	args = append(args, payload.Limit)
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var records []*pb.StockMovement
+	for rows.Next() {
+		record, err := scanMovement(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		records = append(records, record)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return records, total, nil
+}
+
+var errReservationNotActive = errors.New("reservation is not active")
+
+type ReserveStockDto struct {
+	ProductId  int64
+	LocationId int64
+	Quantity   int64
+	Reference  string
+	TtlSeconds int64 // 0 holds the stock until the reservation is committed or released
+	Unit       string
+}
+
+const reservationColumns = `id, product_id, location_id, quantity, reference, status, COALESCE(expires_at, ''), created_at, COALESCE(unit, ''), COALESCE(unit_quantity, 0), committed_quantity`
+
+func scanReservation(row rowScanner) (*pb.StockReservation, error) {
+	var reservation pb.StockReservation
+	if err := row.Scan(&reservation.Id, &reservation.ProductId, &reservation.LocationId, &reservation.Quantity, &reservation.Reference, &reservation.Status, &reservation.ExpiresAt, &reservation.CreatedAt, &reservation.Unit, &reservation.UnitQuantity, &reservation.CommittedQuantity); err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+func (s *inventoryStore) ReserveStock(ctx context.Context, payload *ReserveStockDto) (*pb.StockReservation, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("reserve stock", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	product, err := lockAvailableStock(ctx, tx, payload.ProductId, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// reservations hold base units, the unit they were asked in is kept for
+	// the movement posted on commit
+	quantity := payload.Quantity
+	var unit, unitQuantity, perUnit any
+	if payload.Unit != "" {
+		factor, err := unitFactor(ctx, tx, payload.ProductId, payload.Unit)
+		if err != nil {
+			return nil, err
+		}
+		if quantity, err = toBaseUnits(payload.Quantity, factor); err != nil {
+			return nil, err
+		}
+		unit, unitQuantity, perUnit = payload.Unit, payload.Quantity, factor
+	}
+
+	// kits can be reserved against what their components can still make, the
+	// shortfall is assembled when the reservation is committed. Component
+	// stock is not held, a commit fails if it was sold in the meantime.
+	if !product.AllowBackorder && product.Available < quantity {
+		buildable, err := kitBuildable(ctx, tx, payload.ProductId, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if available := max(product.Available, 0) + buildable; available < quantity {
+			return nil, &insufficientStockError{
+				ProductId: payload.ProductId,
+				Available: available,
+				Requested: quantity,
+			}
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE products SET reserved_quantity = reserved_quantity + ? WHERE id = ?`, quantity, payload.ProductId); err != nil {
+		return nil, err
+	}
+
+	query := `
+	INSERT INTO stock_reservations (product_id, location_id, quantity, reference, unit, unit_quantity, unit_factor, expires_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, IF(? > 0, DATE_ADD(CURRENT_TIMESTAMP, INTERVAL ? SECOND), NULL))
+	`
+
+	result, err := tx.ExecContext(ctx, query, payload.ProductId, resolveLocationId(payload.LocationId), quantity, payload.Reference, unit, unitQuantity, perUnit, payload.TtlSeconds, payload.TtlSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	insertedId, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	reservation, err := scanReservation(tx.QueryRowContext(ctx, `SELECT `+reservationColumns+` FROM stock_reservations WHERE id = ?`, insertedId))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+type CommitReservationDto struct {
+	Id            int64
+	SerialNumbers []string
+	Quantity      int64
+	Reference     string
+}
+
+// takes quantity base units of the reservation out of stock, or everything
+// still reserved when quantity is 0. The reservation is committed once nothing
+// is left reserved.
+func (s *inventoryStore) CommitReservation(ctx context.Context, payload *CommitReservationDto) (*pb.StockMovement, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("commit reservation", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	id := payload.Id
+
+	var productId, locationId, unitQuantity, factor, now int64
+	var reference, unit string
+	var movementId sql.NullInt64
+	var hold reservationHold
+
+	query := `
+	SELECT product_id, location_id, quantity, committed_quantity, reference, status, movement_id, UNIX_TIMESTAMP(expires_at), UNIX_TIMESTAMP(),
+		COALESCE(unit, ''), COALESCE(unit_quantity, 0), COALESCE(unit_factor, 0)
+	FROM stock_reservations
+	WHERE id = ?
+	FOR UPDATE
+	`
+	if err := tx.QueryRowContext(ctx, query, id).Scan(&productId, &locationId, &hold.Quantity, &hold.Committed, &reference, &hold.Status, &movementId, &hold.ExpiresAt, &now, &unit, &unitQuantity, &factor); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("reservation %d not found", id)
+		}
+		return nil, err
+	}
+
+	// committing twice hands back the movement posted the first time
+	if hold.Status == "committed" && movementId.Valid && payload.Quantity == 0 {
+		return scanMovement(tx.QueryRowContext(ctx, `SELECT `+movementColumns+` FROM stock_movements WHERE id=?`, movementId.Int64))
+	}
+
+	change, err := hold.commitQuantity(payload.Quantity, now)
+	if err != nil {
+		return nil, fmt.Errorf("reservation %d %w", id, err)
+	}
+
+	// the sell unit only describes the movement when it takes the whole reservation
+	if change != hold.Quantity {
+		unit, unitQuantity, factor = "", 0, 0
+	}
+
+	if payload.Reference != "" {
+		reference = payload.Reference
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE products SET reserved_quantity = reserved_quantity - ? WHERE id = ?`, change, productId); err != nil {
+		return nil, err
+	}
+
+	record, err := s.updateStockQuantity(ctx, tx, &UpdateStockDto{
+		ProductId:     productId,
+		LocationId:    locationId,
+		Change:        -change,
+		Reference:     reference,
+		Type:          "purchase",
+		SerialNumbers: payload.SerialNumbers,
+		Unit:          unit,
+		UnitQuantity:  unitQuantity,
+		UnitFactor:    factor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `INSERT INTO reservation_commits (reservation_id, movement_id, quantity) VALUES (?, ?, ?)`, id, record.Id, change); err != nil {
+		return nil, err
+	}
+
+	// MySQL applies the assignments left to right, so status and movement see
+	// the new committed quantity
+	_, err = tx.ExecContext(ctx, `
+	UPDATE stock_reservations
+	SET committed_quantity = committed_quantity + ?,
+		status = IF(committed_quantity = quantity, 'committed', status),
+		movement_id = IF(committed_quantity = quantity, ?, movement_id)
+	WHERE id = ?
+	`, change, record.Id, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// a reservation as a commit sees it, ExpiresAt is in unix seconds and null
+// for reservations held until they are committed or released
+type reservationHold struct {
+	Status    string
+	Quantity  int64
+	Committed int64
+	ExpiresAt sql.NullInt64
+}
+
+func (h reservationHold) expired(now int64) bool {
+	return h.ExpiresAt.Valid && h.ExpiresAt.Int64 <= now
+}
+
+// base units a commit of requested takes at now, everything still reserved
+// when requested is 0
+func (h reservationHold) commitQuantity(requested int64, now int64) (int64, error) {
+	if h.Status != "active" || h.expired(now) {
+		return 0, fmt.Errorf("is %s: %w", reservationStatusLabel(h.Status, h.expired(now)), errReservationNotActive)
+	}
+
+	remaining := h.Quantity - h.Committed
+	change := requested
+	if change == 0 {
+		change = remaining
+	}
+
+	if change < 0 || change > remaining {
+		return 0, fmt.Errorf("has %d units left to commit, %d requested: %w", remaining, change, errReservationNotActive)
+	}
+	return change, nil
+}
+
+func reservationStatusLabel(reservationStatus string, expired bool) string {
+	if reservationStatus == "active" && expired {
+		return "expired"
+	}
+	return reservationStatus
+}
+
+func (s *inventoryStore) ReleaseReservation(ctx context.Context, id int64) (*pb.StockReservation, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("release reservation", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	reservation, err := scanReservation(tx.QueryRowContext(ctx, `SELECT `+reservationColumns+` FROM stock_reservations WHERE id = ? FOR UPDATE`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("reservation %d not found", id)
+		}
+		return nil, err
+	}
+
+	switch reservation.Status {
+	case "released", "expired":
+		return reservation, nil
+	case "committed":
+		return nil, fmt.Errorf("reservation %d is committed: %w", id, errReservationNotActive)
+	}
+
+	// units already committed stay out of stock, only the rest is released
+	if _, err := tx.ExecContext(ctx, `UPDATE products SET reserved_quantity = reserved_quantity - ? WHERE id = ?`, reservation.Quantity-reservation.CommittedQuantity, reservation.ProductId); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE stock_reservations SET status = 'released' WHERE id = ?`, id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	reservation.Status = "released"
+	return reservation, nil
+}
+
+func (s *inventoryStore) ExpireReservations(ctx context.Context) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("expire reservations", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	query := `
+	SELECT id, product_id, quantity - committed_quantity
+	FROM stock_reservations
+	WHERE status = 'active' AND expires_at <= CURRENT_TIMESTAMP
+	FOR UPDATE
+	`
+
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	type expiredReservation struct {
+		id, productId, quantity int64
+	}
+
+	var expired []expiredReservation
+	for rows.Next() {
+		var r expiredReservation
+		if err := rows.Scan(&r.id, &r.productId, &r.quantity); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		expired = append(expired, r)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, r := range expired {
+		if _, err := tx.ExecContext(ctx, `UPDATE products SET reserved_quantity = reserved_quantity - ? WHERE id = ?`, r.quantity, r.productId); err != nil {
+			return 0, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE stock_reservations SET status = 'expired' WHERE id = ?`, r.id); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int64(len(expired)), nil
+}
+
+type CreateLocationDto struct {
+	Code string
+	Name string
+}
+
+const locationRollupQuery = `
+SELECT
+	l.id,
+	l.code,
+	l.name,
+	COALESCE(SUM(ls.quantity), 0),
+	COUNT(CASE WHEN ls.quantity <> 0 THEN 1 END),
+	l.created_at
+FROM locations l
+LEFT JOIN location_stock ls ON ls.location_id = l.id
+`
+
+func scanLocation(row rowScanner) (*pb.Location, error) {
+	var location pb.Location
+	if err := row.Scan(&location.Id, &location.Code, &location.Name, &location.TotalQuantity, &location.ProductCount, &location.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
+
+func (s *inventoryStore) CreateLocation(ctx context.Context, payload *CreateLocationDto) (*pb.Location, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("create location", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO locations (code, name) VALUES (?, ?)`, payload.Code, payload.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	insertedId, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := scanLocation(tx.QueryRowContext(ctx, locationRollupQuery+` WHERE l.id = ? GROUP BY l.id`, insertedId))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+func (s *inventoryStore) ListLocations(ctx context.Context) ([]*pb.Location, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("list locations", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if err := seedLocationStock(ctx, tx, 0); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, locationRollupQuery+` GROUP BY l.id ORDER BY l.id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var locations []*pb.Location
+	for rows.Next() {
+		location, err := scanLocation(rows)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, location)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return locations, nil
+}
+
+func (s *inventoryStore) GetLocationStock(ctx context.Context, locationId int64) (*pb.LocationStockResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get location stock", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if err := seedLocationStock(ctx, tx, 0); err != nil {
+		return nil, err
+	}
+
+	location, err := scanLocation(tx.QueryRowContext(ctx, locationRollupQuery+` WHERE l.id = ? GROUP BY l.id`, locationId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	levels, err := queryStockLevels(ctx, tx, "ls.location_id = ? AND ls.quantity <> 0", locationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &pb.LocationStockResponse{
+		Location: location,
+		Levels:   levels,
+	}, nil
+}
+
+type TransferStockDto struct {
+	ProductId      int64
+	FromLocationId int64
+	ToLocationId   int64
+	Quantity       int64
+	Note           string
+	SerialNumbers  []string
+}
+
+func (s *inventoryStore) TransferStock(ctx context.Context, payload *TransferStockDto) (*pb.TransferStockResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("transfer stock", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	fromLocationId := resolveLocationId(payload.FromLocationId)
+	toLocationId := resolveLocationId(payload.ToLocationId)
+
+	if err := seedLocationStock(ctx, tx, payload.ProductId); err != nil {
+		return nil, err
+	}
+
+	product, err := lockAvailableStock(ctx, tx, payload.ProductId, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkSerialNumbers(payload.ProductId, product.Serialized, "transfer_out", payload.SerialNumbers, payload.Quantity); err != nil {
+		return nil, err
+	}
+
+	// lock both locations and their rows in a fixed order so opposite
+	// transfers cannot deadlock
+	quantities := make(map[int64]int64, 2)
+	for _, locationId := range []int64{min(fromLocationId, toLocationId), max(fromLocationId, toLocationId)} {
+		if err := lockLocation(ctx, tx, locationId); err != nil {
+			return nil, err
+		}
+
+		quantity, err := lockLocationStock(ctx, tx, locationId, payload.ProductId)
+		if err != nil {
+			return nil, err
+		}
+		quantities[locationId] = quantity
+	}
+
+	held, err := lockHeldStock(ctx, tx, fromLocationId, payload.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	// units reserved at the source stay there for the reservation to commit
+	reserved, err := lockReservedStock(ctx, tx, fromLocationId, payload.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	if available := quantities[fromLocationId] - held - reserved; available < payload.Quantity {
+		return nil, &insufficientStockError{
+			ProductId:  payload.ProductId,
+			LocationId: fromLocationId,
+			Available:  max(available, 0),
+			Requested:  payload.Quantity,
+		}
+	}
+
+	if err := changeLocationStock(ctx, tx, fromLocationId, payload.ProductId, -payload.Quantity); err != nil {
+		return nil, err
+	}
+
+	if err := changeLocationStock(ctx, tx, toLocationId, payload.ProductId, payload.Quantity); err != nil {
+		return nil, err
+	}
+
+	out, err := insertMovement(ctx, tx, &movementDto{
+		ProductId:  payload.ProductId,
+		LocationId: fromLocationId,
+		Quantity:   payload.Quantity,
+		Type:       "transfer_out",
+		Note:       payload.Note,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// both halves of the transfer share a reference derived from the outgoing movement
+	out.Reference = fmt.Sprintf("transfer-%d", out.Id)
+	if _, err := tx.ExecContext(ctx, `UPDATE stock_movements SET reference = ? WHERE id = ?`, out.Reference, out.Id); err != nil {
+		return nil, err
+	}
+
+	in, err := insertMovement(ctx, tx, &movementDto{
+		ProductId:  payload.ProductId,
+		LocationId: toLocationId,
+		Quantity:   payload.Quantity,
+		Type:       "transfer_in",
+		Reference:  out.Reference,
+		Note:       payload.Note,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := moveSerialUnits(ctx, tx, out, -payload.Quantity, payload.SerialNumbers); err != nil {
+		return nil, err
+	}
+
+	if err := moveSerialUnits(ctx, tx, in, payload.Quantity, payload.SerialNumbers); err != nil {
+		return nil, err
+	}
+
+	consumed, err := consumeBatches(ctx, tx, payload.ProductId, fromLocationId, payload.Quantity, out.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, batch := range consumed {
+		if _, err := insertBatch(ctx, tx, &batchDto{
+			ProductId:  payload.ProductId,
+			LocationId: toLocationId,
+			LotNumber:  batch.LotNumber,
+			ExpiresAt:  batch.ExpiresAt,
+			Quantity:   batch.Quantity,
+			MovementId: in.Id,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &pb.TransferStockResponse{
+		Out: out,
+		In:  in,
+	}, nil
+}
+
+type batchDto struct {
+	ProductId  int64
+	LocationId int64
+	LotNumber  string
+	ExpiresAt  string
+	Quantity   int64
+	MovementId int64
+}
+
+func insertBatch(ctx context.Context, tx *sql.Tx, payload *batchDto) (int64, error) {
+	var expiresAt any
+	if payload.ExpiresAt != "" {
+		expiresAt = payload.ExpiresAt
+	}
+
+	query := `
+	INSERT INTO stock_batches (product_id, location_id, lot_number, expires_at, received_quantity, remaining_quantity, movement_id)
+	VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := tx.ExecContext(ctx, query, payload.ProductId, payload.LocationId, payload.LotNumber, expiresAt, payload.Quantity, payload.Quantity, payload.MovementId)
+	if err != nil {
+		return 0, err
+	}
+
+	batchId, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `INSERT INTO stock_batch_movements (batch_id, movement_id, quantity) VALUES (?, ?, ?)`, batchId, payload.MovementId, payload.Quantity); err != nil {
+		return 0, err
+	}
+
+	return batchId, nil
+}
+
+type consumedBatch struct {
+	BatchId   int64
+	LotNumber string
+	ExpiresAt string
+	Quantity  int64
+}
+
+// picks the batches a consumption of quantity draws from, first expiry first
+// out. Batches without an expiry go last and ties go to the oldest batch.
+// Quantity on the batches given is what each still holds.
+func pickBatches(batches []consumedBatch, quantity int64) []consumedBatch {
+	ordered := slices.Clone(batches)
+	slices.SortStableFunc(ordered, func(a, b consumedBatch) int {
+		if (a.ExpiresAt == "") != (b.ExpiresAt == "") {
+			if a.ExpiresAt == "" {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Or(cmp.Compare(a.ExpiresAt, b.ExpiresAt), cmp.Compare(a.BatchId, b.BatchId))
+	})
+
+	var picked []consumedBatch
+	remaining := quantity
+	for _, batch := range ordered {
+		if remaining <= 0 {
+			break
+		}
+		if batch.Quantity <= 0 {
+			continue
+		}
+		batch.Quantity = min(batch.Quantity, remaining)
+		remaining -= batch.Quantity
+		picked = append(picked, batch)
+	}
+	return picked
+}
+
+// stock that never came in through a supply (initial quantities, corrections,
+// restocks) is not batched, so a consumption may cover less than quantity
+func consumeBatches(ctx context.Context, tx *sql.Tx, productId int64, locationId int64, quantity int64, movementId int64) ([]consumedBatch, error) {
+	query := `
+	SELECT id, lot_number, COALESCE(DATE_FORMAT(expires_at, '%Y-%m-%d'), ''), remaining_quantity
+	FROM stock_batches
+	WHERE product_id = ? AND location_id = ? AND remaining_quantity > 0
+	ORDER BY id
+	FOR UPDATE
+	`
+
+	rows, err := tx.QueryContext(ctx, query, productId, locationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var batches []consumedBatch
+	for rows.Next() {
+		var batch consumedBatch
+		if err := rows.Scan(&batch.BatchId, &batch.LotNumber, &batch.ExpiresAt, &batch.Quantity); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		batches = append(batches, batch)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	consumed := pickBatches(batches, quantity)
+	for _, batch := range consumed {
+		if _, err := tx.ExecContext(ctx, `UPDATE stock_batches SET remaining_quantity = remaining_quantity - ? WHERE id = ?`, batch.Quantity, batch.BatchId); err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `INSERT INTO stock_batch_movements (batch_id, movement_id, quantity) VALUES (?, ?, ?)`, batch.BatchId, movementId, batch.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	return consumed, nil
+}
+
+const batchColumns = `id, product_id, location_id, lot_number, COALESCE(DATE_FORMAT(expires_at, '%Y-%m-%d'), ''), received_quantity, remaining_quantity, created_at`
+
+func scanBatch(row rowScanner) (*pb.StockBatch, error) {
+	var batch pb.StockBatch
+	if err := row.Scan(&batch.Id, &batch.ProductId, &batch.LocationId, &batch.LotNumber, &batch.ExpiresAt, &batch.ReceivedQuantity, &batch.RemainingQuantity, &batch.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &batch, nil
+}
+
+type ListBatchesDto struct {
+	ProductId    int64
+	LocationId   int64
+	IncludeEmpty bool
+	ExpiringDays int64
+}
+
+func (s *inventoryStore) ListBatches(ctx context.Context, payload *ListBatchesDto) ([]*pb.StockBatch, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("list batches", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var conditions []string
+	var args []any
+
+	if payload.ProductId > 0 {
+		conditions = append(conditions, "product_id = ?")
+		args = append(args, payload.ProductId)
+	}
+
+	if payload.LocationId > 0 {
+		conditions = append(conditions, "location_id = ?")
+		args = append(args, payload.LocationId)
+	}
+
+	if !payload.IncludeEmpty {
+		conditions = append(conditions, "remaining_quantity > 0")
+	}
+
+	if payload.ExpiringDays > 0 {
+		conditions = append(conditions, "expires_at IS NOT NULL AND expires_at <= DATE_ADD(CURRENT_DATE, INTERVAL ? DAY)")
+		args = append(args, payload.ExpiringDays)
+	}
+
+	query := `SELECT ` + batchColumns + ` FROM stock_batches`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY expires_at IS NULL, expires_at, id"
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batches []*pb.StockBatch
+	for rows.Next() {
+		batch, err := scanBatch(rows)
+		if err != nil {
+			return nil, err
+		}
+		batches = append(batches, batch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return batches, nil
+}
+
+var (
+	errInvalidSerialNumbers = errors.New("invalid serial numbers")
+	errSerialUnavailable    = errors.New("serial unit unavailable")
+)
+
+// movements that name the serial units they move, any other movement of a
+// serialized product would leave the serial units out of step with the stock.
+// Scraps of returned units name them without moving stock.
+var serialMovementTypes = map[string]bool{
+	"supply":        true,
+	"purchase":      true,
+	"restock":       true,
+	"return":        true,
+	"transfer_out":  true,
+	"correction":    true,
+	"state_out":     true,
+	"scrap":         true,
+	"scrap_restore": true,
+}
+
+// serialized products need one serial number per unit on every movement that
+// adds, removes or moves units. Reversals are the exception, a reversal of a
+// movement that moved serial units is refused before it gets here.
+func checkSerialNumbers(productId int64, serialized bool, movementType string, serialNumbers []string, quantity int64) error {
+	if !serialized {
+		if len(serialNumbers) > 0 {
+			return fmt.Errorf("product %d is not serialized: %w", productId, errInvalidSerialNumbers)
+		}
+		return nil
+	}
+
+	if !serialMovementTypes[movementType] {
+		if movementType != "reversal" {
+			return fmt.Errorf("product %d is serialized, %s movements cannot name its units: %w", productId, movementType, errInvalidSerialNumbers)
+		}
+		if len(serialNumbers) > 0 {
+			return fmt.Errorf("%s movements do not take serial numbers: %w", movementType, errInvalidSerialNumbers)
+		}
+		return nil
+	}
+
+	if quantity < 0 {
+		quantity = -quantity
+	}
+
+	if int64(len(serialNumbers)) != quantity {
+		return fmt.Errorf("product %d is serialized, %d serial numbers given for %d units: %w", productId, len(serialNumbers), quantity, errInvalidSerialNumbers)
+	}
+
+	seen := make(map[string]bool, len(serialNumbers))
+	for _, serialNumber := range serialNumbers {
+		if strings.TrimSpace(serialNumber) == "" {
+			return fmt.Errorf("serial numbers cannot be empty: %w", errInvalidSerialNumbers)
+		}
+		if seen[serialNumber] {
+			return fmt.Errorf("serial number %s is given more than once: %w", serialNumber, errInvalidSerialNumbers)
+		}
+		seen[serialNumber] = true
+	}
+
+	return nil
+}
+
+// stock state of a unit with the status, empty for units that are not on hand
+func serialState(status string) string {
+	switch status {
+	case "in_stock", "returned":
+		return "available"
+	case "quarantined", "damaged", "in_transit":
+		return status
+	}
+	return ""
+}
+
+// status of a unit moved into the stock state
+func serialStatus(state string) string {
+	if state == "available" {
+		return "in_stock"
+	}
+	return state
+}
+
+type serialUnitState struct {
+	Exists     bool
+	Status     string
+	LocationId int64
+}
+
+// status the unit moves to on the movement, change is the signed quantity the
+// movement took out or put in at its location
+func (u serialUnitState) next(serialNumber string, movement *pb.StockMovement, change int64) (string, error) {
+	here := u.Exists && u.LocationId == movement.LocationId
+	onHand := u.Exists && serialState(u.Status) != ""
+	available := here && serialState(u.Status) == "available"
+
+	switch movement.Type {
+	case "supply":
+		if onHand {
+			return "", fmt.Errorf("serial %s of product %d is already in stock: %w", serialNumber, movement.ProductId, errSerialUnavailable)
+		}
+		return "in_stock", nil
+	case "purchase", "transfer_out":
+		if !available {
+			return "", fmt.Errorf("serial %s of product %d is not in stock at location %d: %w", serialNumber, movement.ProductId, movement.LocationId, errSerialUnavailable)
+		}
+		if movement.Type == "purchase" {
+			return "sold", nil
+		}
+	case "restock", "return":
+		if !u.Exists || u.Status != "sold" {
+			return "", fmt.Errorf("serial %s of product %d has not been sold: %w", serialNumber, movement.ProductId, errSerialUnavailable)
+		}
+		return "returned", nil
+	case "transfer_in":
+		if !u.Exists {
+			return "", fmt.Errorf("serial %s of product %d not found", serialNumber, movement.ProductId)
+		}
+	case "correction":
+		// units corrected out are written off whatever state they are held
+		// in, units corrected in were missing from the count
+		if change < 0 {
+			if !here || !onHand {
+				return "", fmt.Errorf("serial %s of product %d is not on hand at location %d: %w", serialNumber, movement.ProductId, movement.LocationId, errSerialUnavailable)
+			}
+			return "scrapped", nil
+		}
+		if onHand {
+			return "", fmt.Errorf("serial %s of product %d is already in stock: %w", serialNumber, movement.ProductId, errSerialUnavailable)
+		}
+		return "in_stock", nil
+	case "state_out":
+		if !here || serialState(u.Status) != movement.State {
+			return "", fmt.Errorf("serial %s of product %d is not %s at location %d: %w", serialNumber, movement.ProductId, movement.State, movement.LocationId, errSerialUnavailable)
+		}
+	case "state_in":
+		return serialStatus(movement.State), nil
+	case "scrap":
+		if !u.Exists || u.Status != "sold" {
+			return "", fmt.Errorf("serial %s of product %d has not been sold: %w", serialNumber, movement.ProductId, errSerialUnavailable)
+		}
+		return "scrapped", nil
+	case "scrap_restore":
+		if !u.Exists || u.Status != "scrapped" {
+			return "", fmt.Errorf("serial %s of product %d has not been scrapped: %w", serialNumber, movement.ProductId, errSerialUnavailable)
+		}
+		return "sold", nil
+	}
+
+	return u.Status, nil
+}
+
+func moveSerialUnits(ctx context.Context, tx *sql.Tx, movement *pb.StockMovement, change int64, serialNumbers []string) error {
+	for _, serialNumber := range serialNumbers {
+		var id int64
+		var unit serialUnitState
+
+		err := tx.QueryRowContext(ctx, `SELECT id, location_id, status FROM serial_units WHERE product_id = ? AND serial_number = ? FOR UPDATE`, movement.ProductId, serialNumber).Scan(&id, &unit.LocationId, &unit.Status)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		unit.Exists = err == nil
+
+		unitStatus, err := unit.next(serialNumber, movement, change)
+		if err != nil {
+			return err
+		}
+
+		if unit.Exists {
+			if _, err := tx.ExecContext(ctx, `UPDATE serial_units SET status = ?, location_id = ? WHERE id = ?`, unitStatus, movement.LocationId, id); err != nil {
+				return err
+			}
+		} else {
+			result, err := tx.ExecContext(ctx, `INSERT INTO serial_units (product_id, serial_number, location_id, status) VALUES (?, ?, ?, ?)`, movement.ProductId, serialNumber, movement.LocationId, unitStatus)
+			if err != nil {
+				return err
+			}
+
+			if id, err = result.LastInsertId(); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, `INSERT INTO serial_unit_events (serial_unit_id, movement_id, status, location_id) VALUES (?, ?, ?, ?)`, id, movement.Id, unitStatus, movement.LocationId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const serialUnitColumns = `id, product_id, serial_number, location_id, status, created_at, updated_at`
+
+func scanSerialUnit(row rowScanner) (*pb.SerialUnit, error) {
+	var unit pb.SerialUnit
+	if err := row.Scan(&unit.Id, &unit.ProductId, &unit.SerialNumber, &unit.LocationId, &unit.Status, &unit.CreatedAt, &unit.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &unit, nil
+}
+
+func querySerialUnits(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]*pb.SerialUnit, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var units []*pb.SerialUnit
+	for rows.Next() {
+		unit, err := scanSerialUnit(rows)
+		if err != nil {
+			return nil, err
+		}
+		units = append(units, unit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return units, nil
+}
+
+type ListSerialUnitsDto struct {
+	ProductId  int64
+	LocationId int64
+	Status     string
+}
+
+func (s *inventoryStore) ListSerialUnits(ctx context.Context, payload *ListSerialUnitsDto) ([]*pb.SerialUnit, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("list serial units", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	conditions := []string{"product_id = ?"}
+	args := []any{payload.ProductId}
+
+	if payload.LocationId > 0 {
+		conditions = append(conditions, "location_id = ?")
+		args = append(args, payload.LocationId)
+	}
+
+	if payload.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, payload.Status)
+	}
+
+	query := `SELECT ` + serialUnitColumns + ` FROM serial_units WHERE ` + strings.Join(conditions, " AND ") + ` ORDER BY serial_number`
+
+	return querySerialUnits(ctx, tx, query, args...)
+}
+
+func (s *inventoryStore) GetSerialHistory(ctx context.Context, serialNumber string, productId int64) ([]*pb.SerialUnit, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get serial history", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	query := `SELECT ` + serialUnitColumns + ` FROM serial_units WHERE serial_number = ?`
+	args := []any{serialNumber}
+	if productId > 0 {
+		query += " AND product_id = ?"
+		args = append(args, productId)
+	}
+	query += " ORDER BY product_id"
+
+	units, err := querySerialUnits(ctx, tx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsQuery := `
+	SELECT COALESCE(e.movement_id, 0), COALESCE(m.type, IF(e.status = 'scrapped', 'scrap', 'scrap_restore')), e.status, e.location_id, COALESCE(m.reference, e.reference, ''), e.created_at
+	FROM serial_unit_events e
+	LEFT JOIN stock_movements m ON m.id = e.movement_id
+	WHERE e.serial_unit_id = ?
+	ORDER BY e.id
+	`
+
+	for _, unit := range units {
+		rows, err := tx.QueryContext(ctx, eventsQuery, unit.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for rows.Next() {
+			var event pb.SerialUnitEvent
+			if err := rows.Scan(&event.MovementId, &event.Type, &event.Status, &event.LocationId, &event.Reference, &event.CreatedAt); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			unit.Events = append(unit.Events, &event)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return units, nil
+}
+
+type ScrapSerialUnitsDto struct {
+	ProductId     int64
+	Quantity      int64
+	SerialNumbers []string
+	Reference     string
+}
+
+// units that come back on a return and are not put back in stock are scrapped
+// by serial number. They left stock when they were sold, so no movement is
+// posted and the events only carry the reference. Restoring puts the units
+// the reference scrapped back to sold, for a disposition that failed after
+// the scrap. Products that are not serialized have nothing to scrap.
+func (s *inventoryStore) ScrapSerialUnits(ctx context.Context, payload *ScrapSerialUnitsDto, restore bool) ([]*pb.SerialUnit, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("scrap serial units", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	product, err := lockAvailableStock(ctx, tx, payload.ProductId, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	scrap := &pb.StockMovement{ProductId: payload.ProductId, Type: "scrap"}
+	if restore {
+		scrap.Type = "scrap_restore"
+	}
+
+	if err := checkSerialNumbers(payload.ProductId, product.Serialized, scrap.Type, payload.SerialNumbers, payload.Quantity); err != nil {
+		return nil, err
+	}
+
+	var units []*pb.SerialUnit
+	for _, serialNumber := range payload.SerialNumbers {
+		var id int64
+		var unit serialUnitState
+
+		err := tx.QueryRowContext(ctx, `SELECT id, location_id, status FROM serial_units WHERE product_id = ? AND serial_number = ? FOR UPDATE`, payload.ProductId, serialNumber).Scan(&id, &unit.LocationId, &unit.Status)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		unit.Exists = err == nil
+
+		unitStatus, err := unit.next(serialNumber, scrap, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if restore {
+			var reference string
+			err := tx.QueryRowContext(ctx, `SELECT COALESCE(reference, '') FROM serial_unit_events WHERE serial_unit_id = ? AND movement_id IS NULL ORDER BY id DESC LIMIT 1`, id).Scan(&reference)
+			if err != nil && err != sql.ErrNoRows {
+				return nil, err
+			}
+			if reference != payload.Reference {
+				return nil, fmt.Errorf("serial %s of product %d was not scrapped by %s: %w", serialNumber, payload.ProductId, payload.Reference, errSerialUnavailable)
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE serial_units SET status = ? WHERE id = ?`, unitStatus, id); err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `INSERT INTO serial_unit_events (serial_unit_id, status, location_id, reference) VALUES (?, ?, ?, ?)`, id, unitStatus, unit.LocationId, payload.Reference); err != nil {
+			return nil, err
+		}
+
+		scanned, err := scanSerialUnit(tx.QueryRowContext(ctx, `SELECT `+serialUnitColumns+` FROM serial_units WHERE id = ?`, id))
+		if err != nil {
+			return nil, err
+		}
+		units = append(units, scanned)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return units, nil
+}
+
+// snapshots are taken at most once a day, the last movement id marks where
+// the ledger has to be replayed from
+func (s *inventoryStore) TakeDailySnapshot(ctx context.Context) (bool, error) {
+	var taken bool
+	if err := s.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM stock_snapshots WHERE snapshot_date = CURRENT_DATE)`).Scan(&taken); err != nil {
+		return false, err
+	}
+
+	if taken {
+		return false, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("take stock snapshot", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	// every stock change locks its product row before writing a movement, so
+	// holding all of them waits out the movements in flight and keeps new ones
+	// back while the boundary id and the levels are read. Both are read by
+	// INSERT ... SELECT, which sees the latest committed rows, so the levels
+	// hold exactly the movements up to the boundary.
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM products ORDER BY id FOR SHARE`)
+	if err != nil {
+		return false, err
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+
+	if err := seedLocationStock(ctx, tx, 0); err != nil {
+		return false, err
+	}
+
+	query := `
+	INSERT IGNORE INTO stock_snapshots (snapshot_date, last_movement_id)
+	SELECT CURRENT_DATE, COALESCE(MAX(id), 0) FROM stock_movements
+	`
+
+	result, err := tx.ExecContext(ctx, query)
+	if err != nil {
+		return false, err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return false, err
+	}
+
+	snapshotId, err := result.LastInsertId()
+	if err != nil {
+		return false, err
+	}
+
+	query = `
+	INSERT INTO stock_snapshot_levels (snapshot_id, product_id, location_id, quantity)
+	SELECT ?, product_id, location_id, quantity FROM location_stock WHERE quantity <> 0
+	`
+
+	if _, err := tx.ExecContext(ctx, query, snapshotId); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+type StockAsOfDto struct {
+	ProductIds []int64
+	LocationId int64
+	At         time.Time
+}
+
+func inFilter(column string, ids []int64) (string, []any) {
+	placeholders := make([]string, len(ids))
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+	return column + " IN (" + strings.Join(placeholders, ", ") + ")", args
+}
+
+var errStockHistoryUnavailable = errors.New("stock history unavailable")
+
+// starts from the latest snapshot taken before the requested time and
+// replays the movements written after it. Without such a snapshot the current
+// levels are rewound through the movements written since, which cannot pass
+// a correction since it records the level it set and not the change.
+func (s *inventoryStore) GetStockAsOf(ctx context.Context, payload *StockAsOfDto) (*pb.StockAsOfResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get stock as of", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var snapshotId, lastMovementId int64
+	row := tx.QueryRowContext(ctx, `SELECT id, last_movement_id FROM stock_snapshots WHERE taken_at <= ? ORDER BY taken_at DESC LIMIT 1`, payload.At)
+	if err := row.Scan(&snapshotId, &lastMovementId); err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	var conditions []string
+	var args []any
+
+	if len(payload.ProductIds) > 0 {
+		condition, productArgs := inFilter("product_id", payload.ProductIds)
+		conditions = append(conditions, condition)
+		args = append(args, productArgs...)
+	}
+
+	if payload.LocationId > 0 {
+		conditions = append(conditions, "location_id = ?")
+		args = append(args, payload.LocationId)
+	}
+
+	levels := make(map[stockKey]int64)
+
+	// levels at the snapshot with the movements to replay after it, or the
+	// current levels with the movements to rewind
+	levelsQuery := `SELECT product_id, location_id, quantity FROM stock_snapshot_levels WHERE ` + strings.Join(append([]string{"snapshot_id = ?"}, conditions...), " AND ")
+	levelsArgs := append([]any{snapshotId}, args...)
+	movementsQuery := `SELECT product_id, location_id, quantity_change, type FROM stock_movements WHERE ` +
+		strings.Join(append([]string{"id > ?", "created_at <= ?"}, conditions...), " AND ") +
+		` ORDER BY id`
+	movementsArgs := append([]any{lastMovementId, payload.At}, args...)
+
+	if snapshotId == 0 {
+		if err := seedLocationStock(ctx, tx, 0); err != nil {
+			return nil, err
+		}
+
+		levelsQuery = `SELECT product_id, location_id, quantity FROM location_stock`
+		if len(conditions) > 0 {
+			levelsQuery += ` WHERE ` + strings.Join(conditions, " AND ")
+		}
+		levelsArgs = args
+		movementsQuery = `SELECT product_id, location_id, quantity_change, type FROM stock_movements WHERE ` +
+			strings.Join(append([]string{"created_at > ?"}, conditions...), " AND ") +
+			` ORDER BY id DESC`
+		movementsArgs = append([]any{payload.At}, args...)
+	}
+
+	rows, err := tx.QueryContext(ctx, levelsQuery, levelsArgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		var key stockKey
+		var quantity int64
+		if err := rows.Scan(&key.productId, &key.locationId, &quantity); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		levels[key] = quantity
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = tx.QueryContext(ctx, movementsQuery, movementsArgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	var movements []ledgerEntry
+	for rows.Next() {
+		var movement ledgerEntry
+		if err := rows.Scan(&movement.key.productId, &movement.key.locationId, &movement.quantity, &movement.movementType); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		movements = append(movements, movement)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if snapshotId > 0 {
+		for _, movement := range movements {
+			levels[movement.key] = applyMovement(levels[movement.key], movement.movementType, movement.quantity)
+		}
+	} else if err := rewindLevels(levels, movements); err != nil {
+		return nil, fmt.Errorf("no snapshot was taken before %s: %w", payload.At.Format(time.RFC3339), err)
+	}
+
+	totals := make(map[int64]int64)
+	for _, id := range payload.ProductIds {
+		totals[id] = 0
+	}
+	for key, quantity := range levels {
+		totals[key.productId] += quantity
+	}
+
+	response := &pb.StockAsOfResponse{
+		At:         payload.At.Format(time.RFC3339),
+		SnapshotId: snapshotId,
+	}
+	for productId, quantity := range totals {
+		response.Levels = append(response.Levels, &pb.LocationStockLevel{
+			ProductId: productId,
+			Quantity:  quantity,
+		})
+	}
+	slices.SortFunc(response.Levels, func(a, b *pb.LocationStockLevel) int {
+		return cmp.Compare(a.ProductId, b.ProductId)
+	})
+
+	return response, nil
+}
+
+type ReconcileStockDto struct {
+	ProductIds []int64
+	Repair     bool
+}
+
+// location levels are compared with a replay of the ledger and product totals
+// with the sum of their locations. a repair trusts the stock on hand: the
+// ledger gets a correction recording the location level, and the product
+// total is set to the sum of its locations
+func (s *inventoryStore) ReconcileStock(ctx context.Context, payload *ReconcileStockDto) (*pb.ReconcileStockResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("reconcile stock", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if err := seedLocationStock(ctx, tx, 0); err != nil {
+		return nil, err
+	}
+
+	var productsFilter, filter string
+	var args []any
+	if len(payload.ProductIds) > 0 {
+		condition, _ := inFilter("id", payload.ProductIds)
+		productsFilter = " WHERE " + condition
+
+		condition, args = inFilter("product_id", payload.ProductIds)
+		filter = " WHERE " + condition
+	}
+
+	lock := ""
+	if payload.Repair {
+		lock = " FOR UPDATE"
+	}
+
+	// product rows are locked before location rows like every other stock change
+	productsQuery := `SELECT id, stock_quantity FROM products` + productsFilter + ` ORDER BY id` + lock
+	rows, err := tx.QueryContext(ctx, productsQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var productIds []int64
+	productTotals := make(map[int64]int64)
+	for rows.Next() {
+		var productId, quantity int64
+		if err := rows.Scan(&productId, &quantity); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		productIds = append(productIds, productId)
+		productTotals[productId] = quantity
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	recorded := make(map[stockKey]int64)
+	locationTotals := make(map[int64]int64)
+	firstLocation := make(map[int64]int64)
+
+	rows, err = tx.QueryContext(ctx, `SELECT product_id, location_id, quantity FROM location_stock`+filter+` ORDER BY product_id, location_id`+lock, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		var key stockKey
+		var quantity int64
+		if err := rows.Scan(&key.productId, &key.locationId, &quantity); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		recorded[key] = quantity
+		locationTotals[key.productId] += quantity
+		if _, ok := firstLocation[key.productId]; !ok {
+			firstLocation[key.productId] = key.locationId
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	expected := make(map[stockKey]int64)
+
+	rows, err = tx.QueryContext(ctx, `SELECT product_id, location_id, quantity_change, type FROM stock_movements`+filter+` ORDER BY id`, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		var key stockKey
+		var quantity int64
+		var movementType string
+		if err := rows.Scan(&key.productId, &key.locationId, &quantity, &movementType); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		expected[key] = applyMovement(expected[key], movementType, quantity)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	keys := make([]stockKey, 0, len(recorded))
+	for key := range recorded {
+		keys = append(keys, key)
+	}
+	for key := range expected {
+		if _, ok := recorded[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, func(a, b stockKey) int {
+		return cmp.Or(cmp.Compare(a.productId, b.productId), cmp.Compare(a.locationId, b.locationId))
+	})
+
+	response := &pb.ReconcileStockResponse{
+		CheckedProducts: int64(len(productIds)),
+		Repaired:        payload.Repair,
+	}
+
+	for _, key := range keys {
+		if _, ok := productTotals[key.productId]; !ok || expected[key] == recorded[key] {
+			continue
+		}
+
+		discrepancy := &pb.StockDiscrepancy{
+			ProductId:        key.productId,
+			LocationId:       key.locationId,
+			ExpectedQuantity: expected[key],
+			RecordedQuantity: recorded[key],
+		}
+
+		if payload.Repair {
+			record, err := insertMovement(ctx, tx, &movementDto{
+				ProductId:  key.productId,
+				LocationId: key.locationId,
+				Quantity:   recorded[key],
+				Type:       "correction",
+				Reference:  "reconciliation",
+				Note:       fmt.Sprintf("ledger expected %d, %d on hand", expected[key], recorded[key]),
+			})
+			if err != nil {
+				return nil, err
+			}
+			discrepancy.CorrectionId = record.Id
+		}
+
+		response.Discrepancies = append(response.Discrepancies, discrepancy)
+	}
+
+	for _, productId := range productIds {
+		if productTotals[productId] == locationTotals[productId] {
+			continue
+		}
+
+		discrepancy := &pb.StockDiscrepancy{
+			ProductId:        productId,
+			ExpectedQuantity: locationTotals[productId],
+			RecordedQuantity: productTotals[productId],
+		}
+
+		// the ledger keeps location levels, which were brought in line above,
+		// so the repair is recorded as a correction that keeps the level of
+		// one of the product's locations
+		if payload.Repair {
+			key := stockKey{productId: productId, locationId: resolveLocationId(firstLocation[productId])}
+			record, err := insertMovement(ctx, tx, &movementDto{
+				ProductId:  productId,
+				LocationId: key.locationId,
+				Quantity:   recorded[key],
+				Type:       "correction",
+				Reference:  "reconciliation",
+				Note:       fmt.Sprintf("product total %d, locations hold %d", productTotals[productId], locationTotals[productId]),
+			})
+			if err != nil {
+				return nil, err
+			}
+			discrepancy.CorrectionId = record.Id
+
+			if _, err := tx.ExecContext(ctx, `UPDATE products SET stock_quantity = ? WHERE id = ?`, locationTotals[productId], productId); err != nil {
+				return nil, err
+			}
+		}
+
+		response.Discrepancies = append(response.Discrepancies, discrepancy)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+var (
+	errMovementNotFound        = errors.New("stock movement not found")
+	errMovementNotReversible   = errors.New("stock movement cannot be reversed")
+	errMovementAlreadyReversed = errors.New("stock movement is already reversed")
+)
+
+type ReverseMovementDto struct {
+	Id   int64
+	Note string
+}
+
+// only movements that put stock in or took it out on their own can be undone,
+// corrections and transfers are fixed with a new correction or transfer
+func (s *inventoryStore) ReverseStockMovement(ctx context.Context, payload *ReverseMovementDto) (*pb.StockMovement, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("reverse stock movement", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	original, err := scanMovement(tx.QueryRowContext(ctx, `SELECT `+movementColumns+` FROM stock_movements WHERE id = ? FOR UPDATE`, payload.Id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("movement %d: %w", payload.Id, errMovementNotFound)
+		}
+		return nil, err
+	}
+
+	if original.ReversedBy > 0 {
+		return nil, fmt.Errorf("movement %d was reversed by movement %d: %w", original.Id, original.ReversedBy, errMovementAlreadyReversed)
+	}
+
+	var change int64
+	switch original.Type {
+	case "supply", "restock", "return":
+		change = -original.Change
+	case "purchase":
+		change = original.Change
+	default:
+		return nil, fmt.Errorf("movement %d is a %s: %w", original.Id, original.Type, errMovementNotReversible)
+	}
+
+	var movedSerials bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM serial_unit_events WHERE movement_id = ?)`, original.Id).Scan(&movedSerials); err != nil {
+		return nil, err
+	}
+
+	if movedSerials {
+		return nil, fmt.Errorf("movement %d moved serialized units: %w", original.Id, errMovementNotReversible)
+	}
+
+	note := payload.Note
+	if note == "" {
+		note = fmt.Sprintf("reversal of movement %d", original.Id)
+	}
+
+	record, err := s.updateStockQuantity(ctx, tx, &UpdateStockDto{
+		ProductId:  original.ProductId,
+		LocationId: original.LocationId,
+		Change:     change,
+		Reference:  original.Reference,
+		Note:       note,
+		Type:       "reversal",
+		ReversalOf: original.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE stock_movements SET reversed_by = ? WHERE id = ?`, record.Id, original.Id); err != nil {
+		return nil, err
+	}
+
+	if err := reverseReservationCommit(ctx, tx, original.Id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// a reversed commit puts its units back on the reservation they were taken
+// from, unless the reservation was released or expired since
+func reverseReservationCommit(ctx context.Context, tx *sql.Tx, movementId int64) error {
+	var reservationId, quantity, productId int64
+	var reservationStatus string
+	query := `
+	SELECT rc.reservation_id, rc.quantity, r.product_id, r.status
+	FROM reservation_commits rc
+	JOIN stock_reservations r ON r.id = rc.reservation_id
+	WHERE rc.movement_id = ?
+	FOR UPDATE
+	`
+	if err := tx.QueryRowContext(ctx, query, movementId).Scan(&reservationId, &quantity, &productId, &reservationStatus); err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM reservation_commits WHERE movement_id = ?`, movementId); err != nil {
+		return err
+	}
+
+	if reservationStatus != "active" && reservationStatus != "committed" {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `
+	UPDATE stock_reservations
+	SET committed_quantity = committed_quantity - ?, status = 'active', movement_id = NULL
+	WHERE id = ?
+	`, quantity, reservationId)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE products SET reserved_quantity = reserved_quantity + ? WHERE id = ?`, quantity, productId)
+	return err
+}
+
+func reverseBatches(ctx context.Context, tx *sql.Tx, originalId int64, movementId int64, change int64) error {
+	query := `
+	SELECT bm.batch_id, bm.quantity, b.remaining_quantity
+	FROM stock_batch_movements bm
+	JOIN stock_batches b ON b.id = bm.batch_id
+	WHERE bm.movement_id = ?
+	ORDER BY bm.batch_id
+	FOR UPDATE
+	`
+
+	rows, err := tx.QueryContext(ctx, query, originalId)
+	if err != nil {
+		return err
+	}
+
+	type batchShare struct {
+		batchId, quantity int64
+	}
+
+	var shares []batchShare
+	remaining := max(change, -change)
+	for remaining > 0 && rows.Next() {
+		var share batchShare
+		var batchRemaining int64
+		if err := rows.Scan(&share.batchId, &share.quantity, &batchRemaining); err != nil {
+			rows.Close()
+			return err
+		}
+
+		// a received batch may have been partly sold since, the rest of a
+		// reversed supply then comes out of unbatched stock
+		if change < 0 {
+			share.quantity = min(share.quantity, batchRemaining)
+		}
+		share.quantity = min(share.quantity, remaining)
+		if share.quantity <= 0 {
+			continue
+		}
+
+		remaining -= share.quantity
+		shares = append(shares, share)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, share := range shares {
+		quantity := share.quantity
+		if change < 0 {
+			quantity = -quantity
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE stock_batches SET remaining_quantity = remaining_quantity + ? WHERE id = ?`, quantity, share.batchId); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `INSERT INTO stock_batch_movements (batch_id, movement_id, quantity) VALUES (?, ?, ?)`, share.batchId, movementId, share.quantity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var (
+	errCycleCountNotFound = errors.New("cycle count not found")
+	errCycleCountState    = errors.New("cycle count is not in the required state")
+	errInvalidCycleCount  = errors.New("invalid cycle count")
+)
+
+type OpenCycleCountDto struct {
+	ProductIds  []int64
+	LocationIds []int64
+	Note        string
+}
+
+// the count covers every given product at every given location, when only
+// one side is given it covers the stock rows that exist for it. system
+// quantities are frozen when the count is opened
+func (s *inventoryStore) OpenCycleCount(ctx context.Context, payload *OpenCycleCountDto) (*pb.CycleCount, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("open cycle count", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if err := seedLocationStock(ctx, tx, 0); err != nil {
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO cycle_counts (note) VALUES (?)`, payload.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	countId, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	var query string
+	args := []any{countId}
+
+	if len(payload.ProductIds) > 0 && len(payload.LocationIds) > 0 {
+		productCondition, productArgs := inFilter("p.id", payload.ProductIds)
+		locationCondition, locationArgs := inFilter("l.id", payload.LocationIds)
+		query = `
+		INSERT INTO cycle_count_lines (count_id, product_id, location_id, system_quantity)
+		SELECT ?, p.id, l.id, COALESCE(ls.quantity, 0)
+		FROM products p
+		CROSS JOIN locations l
+		LEFT JOIN location_stock ls ON ls.product_id = p.id AND ls.location_id = l.id
+		WHERE ` + productCondition + ` AND ` + locationCondition
+		args = append(append(args, productArgs...), locationArgs...)
+	} else {
+		var condition string
+		var conditionArgs []any
+		if len(payload.ProductIds) > 0 {
+			condition, conditionArgs = inFilter("product_id", payload.ProductIds)
+		} else {
+			condition, conditionArgs = inFilter("location_id", payload.LocationIds)
+		}
+		query = `
+		INSERT INTO cycle_count_lines (count_id, product_id, location_id, system_quantity)
+		SELECT ?, product_id, location_id, quantity
+		FROM location_stock
+		WHERE ` + condition
+		args = append(args, conditionArgs...)
+	}
+
+	result, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if lines, err := result.RowsAffected(); err != nil {
+		return nil, err
+	} else if lines == 0 {
+		return nil, fmt.Errorf("nothing to count for the given products and locations: %w", errInvalidCycleCount)
+	}
+
+	count, err := getCycleCount(ctx, tx, countId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+func scanCycleCount(row rowScanner) (*pb.CycleCount, error) {
+	var count pb.CycleCount
+	if err := row.Scan(&count.Id, &count.Status, &count.Note, &count.CreatedAt, &count.PostedAt); err != nil {
+		return nil, err
+	}
+	return &count, nil
+}
+
+const cycleCountColumns = `id, status, COALESCE(note, ''), created_at, COALESCE(posted_at, '')`
+
+func getCycleCount(ctx context.Context, tx *sql.Tx, id int64) (*pb.CycleCount, error) {
+	count, err := scanCycleCount(tx.QueryRowContext(ctx, `SELECT `+cycleCountColumns+` FROM cycle_counts WHERE id = ?`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("cycle count %d: %w", id, errCycleCountNotFound)
+		}
+		return nil, err
+	}
+
+	query := `
+	SELECT l.id, l.product_id, l.location_id, l.system_quantity, l.approved, COALESCE(l.movement_id, 0), COUNT(e.counter), COALESCE(SUM(e.quantity), 0)
+	FROM cycle_count_lines l
+	LEFT JOIN cycle_count_entries e ON e.line_id = l.id
+	WHERE l.count_id = ?
+	GROUP BY l.id
+	ORDER BY l.product_id, l.location_id
+	`
+
+	rows, err := tx.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make(map[int64]*pb.CycleCountLine)
+	for rows.Next() {
+		var line pb.CycleCountLine
+		var counters int64
+		if err := rows.Scan(&line.Id, &line.ProductId, &line.LocationId, &line.SystemQuantity, &line.Approved, &line.MovementId, &counters, &line.CountedQuantity); err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		line.Counted = counters > 0
+		if line.Counted {
+			line.Variance = line.CountedQuantity - line.SystemQuantity
+		}
+		lines[line.Id] = &line
+		count.Lines = append(count.Lines, &line)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	query = `
+	SELECT e.line_id, e.counter, e.quantity, e.created_at
+	FROM cycle_count_entries e
+	JOIN cycle_count_lines l ON l.id = e.line_id
+	WHERE l.count_id = ?
+	ORDER BY e.created_at, e.counter
+	`
+
+	rows, err = tx.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var lineId int64
+		var entry pb.CycleCountEntry
+		if err := rows.Scan(&lineId, &entry.Counter, &entry.Quantity, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		if line, ok := lines[lineId]; ok {
+			line.Entries = append(line.Entries, &entry)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+func lockCycleCount(ctx context.Context, tx *sql.Tx, id int64, allowed ...string) error {
+	var countStatus string
+	if err := tx.QueryRowContext(ctx, `SELECT status FROM cycle_counts WHERE id = ? FOR UPDATE`, id).Scan(&countStatus); err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("cycle count %d: %w", id, errCycleCountNotFound)
+		}
+		return err
+	}
+
+	if !slices.Contains(allowed, countStatus) {
+		return fmt.Errorf("cycle count %d is %s: %w", id, countStatus, errCycleCountState)
+	}
+
+	return nil
+}
+
+func (s *inventoryStore) GetCycleCount(ctx context.Context, id int64) (*pb.CycleCount, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get cycle count", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	return getCycleCount(ctx, tx, id)
+}
+
+func (s *inventoryStore) ListCycleCounts(ctx context.Context, countStatus string) ([]*pb.CycleCount, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("list cycle counts", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	query := `SELECT ` + cycleCountColumns + ` FROM cycle_counts`
+	var args []any
+	if countStatus != "" {
+		query += " WHERE status = ?"
+		args = append(args, countStatus)
+	}
+	query += " ORDER BY id DESC"
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []*pb.CycleCount
+	for rows.Next() {
+		count, err := scanCycleCount(rows)
+		if err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+type CountEntryDto struct {
+	ProductId  int64
+	LocationId int64
+	Quantity   int64
+}
+
+type RecordCycleCountDto struct {
+	CountId int64
+	Counter string
+	Entries []CountEntryDto
+}
+
+// each counter holds one entry per line, recounting replaces it. the counted
+// quantity of a line is the sum over its counters
+func (s *inventoryStore) RecordCycleCount(ctx context.Context, payload *RecordCycleCountDto) (*pb.CycleCount, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("record cycle count", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if err := lockCycleCount(ctx, tx, payload.CountId, "open"); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range payload.Entries {
+		var lineId int64
+		row := tx.QueryRowContext(ctx, `SELECT id FROM cycle_count_lines WHERE count_id = ? AND product_id = ? AND location_id = ?`, payload.CountId, entry.ProductId, resolveLocationId(entry.LocationId))
+		if err := row.Scan(&lineId); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("product %d at location %d is not part of cycle count %d: %w", entry.ProductId, resolveLocationId(entry.LocationId), payload.CountId, errInvalidCycleCount)
+			}
+			return nil, err
+		}
+
+		query := `
+		INSERT INTO cycle_count_entries (line_id, counter, quantity)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE quantity = VALUES(quantity)
+		`
+		if _, err := tx.ExecContext(ctx, query, lineId, payload.Counter, entry.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	count, err := getCycleCount(ctx, tx, payload.CountId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+func (s *inventoryStore) setCycleCountStatus(ctx context.Context, id int64, countStatus string, allowed ...string) (*pb.CycleCount, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("set cycle count status", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if err := lockCycleCount(ctx, tx, id, allowed...); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE cycle_counts SET status = ? WHERE id = ?`, countStatus, id); err != nil {
+		return nil, err
+	}
+
+	count, err := getCycleCount(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+func (s *inventoryStore) SubmitCycleCount(ctx context.Context, id int64) (*pb.CycleCount, error) {
+	return s.setCycleCountStatus(ctx, id, "review", "open")
+}
+
+func (s *inventoryStore) CancelCycleCount(ctx context.Context, id int64) (*pb.CycleCount, error) {
+	return s.setCycleCountStatus(ctx, id, "cancelled", "open", "review")
+}
+
+type ApproveCycleCountDto struct {
+	CountId int64
+	LineIds []int64
+	All     bool
+}
+
+func (s *inventoryStore) ApproveCycleCount(ctx context.Context, payload *ApproveCycleCountDto) (*pb.CycleCount, error) {
+	if !payload.All && len(payload.LineIds) == 0 {
+		return nil, fmt.Errorf("cycle count %d: line ids are required unless approving all lines: %w", payload.CountId, errInvalidCycleCount)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("approve cycle count", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if err := lockCycleCount(ctx, tx, payload.CountId, "review"); err != nil {
+		return nil, err
+	}
+
+	query := `UPDATE cycle_count_lines SET approved = TRUE WHERE count_id = ?`
+	args := []any{payload.CountId}
+	if !payload.All {
+		condition, lineArgs := inFilter("id", payload.LineIds)
+		query += " AND " + condition
+		args = append(args, lineArgs...)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, err
+	}
+
+	count, err := getCycleCount(ctx, tx, payload.CountId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+// approved variances are applied on top of the current level so stock that
+// moved while the count was running is kept
+func (s *inventoryStore) PostCycleCount(ctx context.Context, id int64) (*pb.CycleCount, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("post cycle count", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if err := lockCycleCount(ctx, tx, id, "review"); err != nil {
+		return nil, err
+	}
+
+	count, err := getCycleCount(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// lines come ordered by product so product rows are locked in order
+	for _, line := range count.Lines {
+		if !line.Approved || !line.Counted || line.Variance == 0 {
+			continue
+		}
+
+		if err := seedLocationStock(ctx, tx, line.ProductId); err != nil {
+			return nil, err
+		}
+
+		if _, err := lockAvailableStock(ctx, tx, line.ProductId, 0); err != nil {
+			return nil, err
+		}
+
+		current, err := lockLocationStock(ctx, tx, line.LocationId, line.ProductId)
+		if err != nil {
+			return nil, err
+		}
+
+		record, err := s.updateStockQuantity(ctx, tx, &UpdateStockDto{
+			ProductId:  line.ProductId,
+			LocationId: line.LocationId,
+			Change:     current + line.Variance,
+			Reference:  fmt.Sprintf("count-%d", id),
+			Note:       fmt.Sprintf("cycle count variance %+d", line.Variance),
+			Type:       "correction",
+			ReasonCode: "count_variance",
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE cycle_count_lines SET movement_id = ? WHERE id = ?`, record.Id, line.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE cycle_counts SET status = 'posted', posted_at = CURRENT_TIMESTAMP WHERE id = ?`, id); err != nil {
+		return nil, err
+	}
+
+	count, err = getCycleCount(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+// a product has at most one open low stock alert, it is resolved once stock
+// is back at the reorder level. callers hold the product row lock
+func evaluateLowStock(ctx context.Context, tx *sql.Tx, productId int64, movementId int64) error {
+	var stockQuantity, reorderLevel, reorderQuantity int64
+	row := tx.QueryRowContext(ctx, `SELECT stock_quantity, reorder_level, reorder_quantity FROM products WHERE id = ?`, productId)
+	if err := row.Scan(&stockQuantity, &reorderLevel, &reorderQuantity); err != nil {
+		return err
+	}
+
+	var openAlertId int64
+	row = tx.QueryRowContext(ctx, `SELECT id FROM alerts WHERE product_id = ? AND type = 'low_stock' AND status = 'open'`, productId)
+	if err := row.Scan(&openAlertId); err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	switch {
+	case stockQuantity < reorderLevel && openAlertId == 0:
+		query := `
+		INSERT INTO alerts (product_id, type, stock_quantity, reorder_level, reorder_quantity, movement_id)
+		VALUES (?, 'low_stock', ?, ?, ?, ?)
+		`
+		_, err := tx.ExecContext(ctx, query, productId, stockQuantity, reorderLevel, reorderQuantity, movementId)
+		return err
+	case stockQuantity >= reorderLevel && openAlertId > 0:
+		_, err := tx.ExecContext(ctx, `UPDATE alerts SET status = 'resolved', resolved_at = CURRENT_TIMESTAMP WHERE id = ?`, openAlertId)
+		return err
+	}
+
+	return nil
+}
+
+const alertColumns = `id, product_id, type, status, stock_quantity, reorder_level, reorder_quantity, COALESCE(movement_id, 0), created_at, COALESCE(resolved_at, '')`
+
+func scanAlert(row rowScanner) (*pb.StockAlert, error) {
+	var alert pb.StockAlert
+	if err := row.Scan(&alert.Id, &alert.ProductId, &alert.Type, &alert.Status, &alert.StockQuantity, &alert.ReorderLevel, &alert.ReorderQuantity, &alert.MovementId, &alert.CreatedAt, &alert.ResolvedAt); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+type ListAlertsDto struct {
+	ProductId int64
+	Status    string
+}
+
+func (s *inventoryStore) queryAlerts(ctx context.Context, query string, args ...any) ([]*pb.StockAlert, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var alerts []*pb.StockAlert
+	for rows.Next() {
+		alert, err := scanAlert(rows)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+func (s *inventoryStore) ListAlerts(ctx context.Context, payload *ListAlertsDto) ([]*pb.StockAlert, error) {
+	var conditions []string
+	var args []any
+
+	if payload.ProductId > 0 {
+		conditions = append(conditions, "product_id = ?")
+		args = append(args, payload.ProductId)
+	}
+
+	if payload.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, payload.Status)
+	}
+
+	query := `SELECT ` + alertColumns + ` FROM alerts`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id DESC"
+
+	return s.queryAlerts(ctx, query, args...)
+}
+
+// committed alerts that were not published yet, oldest first
+func (s *inventoryStore) ListUndispatchedAlerts(ctx context.Context) ([]*pb.StockAlert, error) {
+	return s.queryAlerts(ctx, `SELECT `+alertColumns+` FROM alerts WHERE dispatched_at IS NULL ORDER BY id`)
+}
+
+func (s *inventoryStore) MarkAlertsDispatched(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	condition, args := inFilter("id", ids)
+	_, err := s.db.ExecContext(ctx, `UPDATE alerts SET dispatched_at = CURRENT_TIMESTAMP WHERE dispatched_at IS NULL AND `+condition, args...)
+	return err
+}
+
+type productValuation struct {
+	Quantity     int64
+	Value        float64
+	LastUnitCost float64
+}
+
+// the cost stock moves at when the movement brings none of its own, the
+// average of what is on hand or else the last cost paid
+func (v *productValuation) unitCost() float64 {
+	if v.Quantity > 0 {
+		return v.Value / float64(v.Quantity)
+	}
+	return v.LastUnitCost
+}
+
+// products valued for the first time start from the stock they held before
+// this movement at no cost, fifo products get it as their oldest layer
+func lockValuation(ctx context.Context, tx *sql.Tx, productId int64, costingMethod string, change int64) (*productValuation, error) {
+	result, err := tx.ExecContext(ctx, `
+	INSERT IGNORE INTO product_valuations (product_id, quantity)
+	SELECT id, stock_quantity - ? FROM products WHERE id = ?
+	`, change, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	var valuation productValuation
+	row := tx.QueryRowContext(ctx, `SELECT quantity, value, last_unit_cost FROM product_valuations WHERE product_id = ? FOR UPDATE`, productId)
+	if err := row.Scan(&valuation.Quantity, &valuation.Value, &valuation.LastUnitCost); err != nil {
+		return nil, err
+	}
+
+	if seeded, err := result.RowsAffected(); err != nil {
+		return nil, err
+	} else if seeded > 0 && costingMethod == "fifo" && valuation.Quantity > 0 {
+		_, err := tx.ExecContext(ctx, `
+		INSERT INTO cost_layers (product_id, unit_cost, received_quantity, remaining_quantity)
+		VALUES (?, 0, ?, ?)
+		`, productId, valuation.Quantity, valuation.Quantity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &valuation, nil
+}
+
+// draws the quantity from the oldest cost layers, a reversal empties the
+// layer of the movement it undoes first. Anything the layers cannot cover,
+// stock sold on backorder, goes at the fallback cost.
+func consumeCostLayers(ctx context.Context, tx *sql.Tx, productId int64, quantity int64, preferMovementId int64, fallbackUnitCost float64) (float64, error) {
+	rows, err := tx.QueryContext(ctx, `
+	SELECT id, unit_cost, remaining_quantity
+	FROM cost_layers
+	WHERE product_id = ? AND remaining_quantity > 0
+	ORDER BY (movement_id <=> ?) DESC, id
+	FOR UPDATE
+	`, productId, preferMovementId)
+	if err != nil {
+		return 0, err
+	}
+
+	type layerDraw struct {
+		id       int64
+		quantity int64
+	}
+
+	var draws []layerDraw
+	var totalCost float64
+	remaining := quantity
+	for remaining > 0 && rows.Next() {
+		var draw layerDraw
+		var unitCost float64
+		var layerQuantity int64
+		if err := rows.Scan(&draw.id, &unitCost, &layerQuantity); err != nil {
+			rows.Close()
+			return 0, err
+		}
+
+		draw.quantity = min(layerQuantity, remaining)
+		remaining -= draw.quantity
+		totalCost += unitCost * float64(draw.quantity)
+		draws = append(draws, draw)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, draw := range draws {
+		if _, err := tx.ExecContext(ctx, `UPDATE cost_layers SET remaining_quantity = remaining_quantity - ? WHERE id = ?`, draw.quantity, draw.id); err != nil {
+			return 0, err
+		}
+	}
+
+	return totalCost + fallbackUnitCost*float64(remaining), nil
+}
+
+// costs a stock change with the product's costing method and records the cost
+// on the movement. Supplies and assembled kits bring their own unit cost,
+// reversals go at the cost of the movement they undo and everything else at
+// the current cost, or for fifo outflows the cost of the layers drawn.
+func valueMovement(ctx context.Context, tx *sql.Tx, costingMethod string, record *pb.StockMovement, change int64, unitCost float64) error {
+	if change == 0 {
+		return nil
+	}
+
+	valuation, err := lockValuation(ctx, tx, record.ProductId, costingMethod, change)
+	if err != nil {
+		return err
+	}
+
+	if record.ReversalOf > 0 {
+		if err := tx.QueryRowContext(ctx, `SELECT COALESCE(unit_cost, 0) FROM stock_movements WHERE id = ?`, record.ReversalOf).Scan(&unitCost); err != nil {
+			return err
+		}
+	} else if record.Type != "supply" && record.Type != "assembly_in" {
+		unitCost = 0
+	}
+
+	var totalCost float64
+	if change > 0 {
+		if unitCost <= 0 {
+			unitCost = valuation.unitCost()
+		}
+		totalCost = unitCost * float64(change)
+
+		// units filling a backorder were already costed when they went out
+		layered := change
+		if valuation.Quantity < 0 {
+			layered = max(change+valuation.Quantity, 0)
+		}
+
+		if costingMethod == "fifo" && layered > 0 {
+			_, err := tx.ExecContext(ctx, `
+			INSERT INTO cost_layers (product_id, movement_id, unit_cost, received_quantity, remaining_quantity)
+			VALUES (?, ?, ?, ?, ?)
+			`, record.ProductId, record.Id, unitCost, layered, layered)
+			if err != nil {
+				return err
+			}
+		}
+
+		if record.Type == "supply" || record.Type == "assembly_in" {
+			valuation.LastUnitCost = unitCost
+		}
+		valuation.Value += totalCost
+	} else {
+		quantity := -change
+		switch {
+		case costingMethod == "fifo":
+			totalCost, err = consumeCostLayers(ctx, tx, record.ProductId, quantity, record.ReversalOf, valuation.unitCost())
+			if err != nil {
+				return err
+			}
+		case unitCost > 0:
+			totalCost = unitCost * float64(quantity)
+		default:
+			totalCost = valuation.unitCost() * float64(quantity)
+		}
+		valuation.Value -= totalCost
+	}
+
+	valuation.Quantity += change
+	if valuation.Quantity == 0 {
+		valuation.Value = 0
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE product_valuations SET quantity = ?, value = ?, last_unit_cost = ? WHERE product_id = ?
+	`, valuation.Quantity, valuation.Value, valuation.LastUnitCost, record.ProductId)
+	if err != nil {
+		return err
+	}
+
+	record.TotalCost = totalCost
+	record.UnitCost = totalCost / float64(max(change, -change))
+
+	_, err = tx.ExecContext(ctx, `UPDATE stock_movements SET unit_cost = ?, total_cost = ? WHERE id = ?`, record.UnitCost, record.TotalCost, record.Id)
+	return err
+}
+
+type InventoryValuationDto struct {
+	ProductIds []int64
+	From       time.Time
+	To         time.Time
+}
+
+// cost of goods sold is what purchases took out less what restocks and
+// returns brought back, reversals count against the movement they undo
+const cogsQuery = `
+SELECT m.product_id,
+	SUM(CASE
+		WHEN m.type = 'purchase' THEN m.quantity_change
+		WHEN m.type IN ('restock', 'return') THEN -m.quantity_change
+		WHEN m.type = 'reversal' AND o.type IN ('purchase', 'restock', 'return') THEN -m.quantity_change
+		ELSE 0
+	END),
+	SUM(CASE
+		WHEN m.type = 'purchase' THEN COALESCE(m.total_cost, 0)
+		WHEN m.type IN ('restock', 'return') THEN -COALESCE(m.total_cost, 0)
+		WHEN m.type = 'reversal' AND o.type = 'purchase' THEN -COALESCE(m.total_cost, 0)
+		WHEN m.type = 'reversal' AND o.type IN ('restock', 'return') THEN COALESCE(m.total_cost, 0)
+		ELSE 0
+	END)
+FROM stock_movements m
+LEFT JOIN stock_movements o ON o.id = m.reversal_of
+WHERE m.type IN ('purchase', 'restock', 'return', 'reversal') AND m.created_at < ?`
+
+func (s *inventoryStore) GetInventoryValuation(ctx context.Context, payload *InventoryValuationDto) (*pb.InventoryValuationResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get inventory valuation", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	query := `
+	SELECT p.id, p.costing_method, COALESCE(v.quantity, p.stock_quantity), COALESCE(v.value, 0)
+	FROM products p
+	LEFT JOIN product_valuations v ON v.product_id = p.id`
+	var args []any
+	if len(payload.ProductIds) > 0 {
+		condition, conditionArgs := inFilter("p.id", payload.ProductIds)
+		query += " WHERE " + condition
+		args = conditionArgs
+	}
+	query += " ORDER BY p.id"
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.InventoryValuationResponse{}
+	products := make(map[int64]*pb.ProductValuation)
+	for rows.Next() {
+		var product pb.ProductValuation
+		if err := rows.Scan(&product.ProductId, &product.CostingMethod, &product.Quantity, &product.Value); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if product.Quantity > 0 {
+			product.UnitCost = product.Value / float64(product.Quantity)
+		}
+		response.TotalValue += product.Value
+		response.Products = append(response.Products, &product)
+		products[product.ProductId] = &product
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	query = cogsQuery
+	args = []any{payload.To}
+	if !payload.From.IsZero() {
+		query += " AND m.created_at >= ?"
+		args = append(args, payload.From)
+	}
+	if len(payload.ProductIds) > 0 {
+		condition, conditionArgs := inFilter("m.product_id", payload.ProductIds)
+		query += " AND " + condition
+		args = append(args, conditionArgs...)
+	}
+	query += " GROUP BY m.product_id"
+
+	rows, err = tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var productId, soldQuantity int64
+		var cogs float64
+		if err := rows.Scan(&productId, &soldQuantity, &cogs); err != nil {
+			return nil, err
+		}
+
+		if product, ok := products[productId]; ok {
+			product.SoldQuantity = soldQuantity
+			product.Cogs = cogs
+			response.TotalCogs += cogs
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+var errNotAKit = errors.New("product is not a kit")
+
+type kitComponent struct {
+	ProductId int64
+	Quantity  int64
+}
+
+// components come back in id order so assemblies lock them in the same order
+func kitComponents(ctx context.Context, tx *sql.Tx, kitId int64) ([]kitComponent, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT component_id, quantity FROM kit_components WHERE kit_id = ? ORDER BY component_id`, kitId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var components []kitComponent
+	for rows.Next() {
+		var component kitComponent
+		if err := rows.Scan(&component.ProductId, &component.Quantity); err != nil {
+			return nil, err
+		}
+		components = append(components, component)
+	}
+
+	return components, rows.Err()
+}
+
+// units of a kit the available stock of its components can make at one
+// location, or across all locations when locationId is 0. 0 for plain products.
+func kitBuildable(ctx context.Context, tx *sql.Tx, kitId int64, locationId int64) (int64, error) {
+	available := "p.stock_quantity - p.reserved_quantity - p.held_quantity"
+	var join string
+	var args []any
+	if locationId > 0 {
+		available = "LEAST(" + available + ", COALESCE(ls.quantity, 0) - COALESCE(h.quantity, 0))"
+		join = `
+		LEFT JOIN location_stock ls ON ls.product_id = k.component_id AND ls.location_id = ?
+		LEFT JOIN (
+			SELECT product_id, SUM(quantity) AS quantity FROM location_stock_states WHERE location_id = ? GROUP BY product_id
+		) h ON h.product_id = k.component_id`
+		args = append(args, locationId, locationId)
+	}
+	args = append(args, kitId)
+
+	query := `
+	SELECT COALESCE(CAST(MIN(FLOOR(GREATEST(` + available + `, 0) / k.quantity)) AS SIGNED), 0)
+	FROM kit_components k
+	JOIN products p ON p.id = k.component_id
+	` + join + `
+	WHERE k.kit_id = ?
+	`
+
+	var buildable int64
+	err := tx.QueryRowContext(ctx, query, args...).Scan(&buildable)
+	return buildable, err
+}
+
+type AssembleKitDto struct {
+	KitId      int64
+	LocationId int64
+	Quantity   int64
+	Note       string
+}
+
+func (s *inventoryStore) AssembleKit(ctx context.Context, payload *AssembleKitDto) (*pb.KitAssembly, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("assemble kit", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	assembly, err := s.assembleKit(ctx, tx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return assembly, nil
+}
+
+// takes the components of the given number of kits out of stock and puts the
+// kits in at the cost of the components drawn. Every movement carries the
+// assembly's reference so the two sides can be traced to each other.
+func (s *inventoryStore) assembleKit(ctx context.Context, tx *sql.Tx, payload *AssembleKitDto) (*pb.KitAssembly, error) {
+	components, err := kitComponents(ctx, tx, payload.KitId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(components) == 0 {
+		return nil, fmt.Errorf("product %d: %w", payload.KitId, errNotAKit)
+	}
+
+	locationId := resolveLocationId(payload.LocationId)
+
+	// the kit is locked before its components, the same order a kit purchase
+	// takes them in
+	if err := seedLocationStock(ctx, tx, payload.KitId); err != nil {
+		return nil, err
+	}
+
+	if _, err := lockAvailableStock(ctx, tx, payload.KitId, 0); err != nil {
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO kit_assemblies (kit_id, location_id, quantity, note) VALUES (?, ?, ?, ?)`, payload.KitId, locationId, payload.Quantity, payload.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	assemblyId, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	assembly := &pb.KitAssembly{
+		Id:         assemblyId,
+		Reference:  fmt.Sprintf("ASM-%06d", assemblyId),
+		KitId:      payload.KitId,
+		LocationId: locationId,
+		Quantity:   payload.Quantity,
+		Note:       payload.Note,
+	}
+
+	if err := tx.QueryRowContext(ctx, `SELECT created_at FROM kit_assemblies WHERE id = ?`, assemblyId).Scan(&assembly.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	var totalCost float64
+	for _, component := range components {
+		record, err := s.updateStockQuantity(ctx, tx, &UpdateStockDto{
+			ProductId:  component.ProductId,
+			LocationId: locationId,
+			Change:     -component.Quantity * payload.Quantity,
+			Reference:  assembly.Reference,
+			Note:       payload.Note,
+			Type:       "assembly_out",
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		totalCost += record.TotalCost
+		assembly.Components = append(assembly.Components, record)
+	}
+
+	assembly.Output, err = s.updateStockQuantity(ctx, tx, &UpdateStockDto{
+		ProductId:  payload.KitId,
+		LocationId: locationId,
+		Change:     payload.Quantity,
+		Reference:  assembly.Reference,
+		Note:       payload.Note,
+		Type:       "assembly_in",
+		UnitCost:   totalCost / float64(payload.Quantity),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assembly, nil
+}
+
+// assembles the part of a kit purchase the assembled stock at the location
+// cannot cover. Backordered kits only assemble what the components can make
+// and leave the rest on backorder. Plain products are left alone.
+func (s *inventoryStore) assembleShortfall(ctx context.Context, tx *sql.Tx, kitId int64, locationId int64, requested int64, reference string) error {
+	components, err := kitComponents(ctx, tx, kitId)
+	if err != nil || len(components) == 0 {
+		return err
+	}
+
+	product, err := lockAvailableStock(ctx, tx, kitId, 0)
+	if err != nil {
+		return err
+	}
+
+	locationQuantity, err := lockLocationStock(ctx, tx, locationId, kitId)
+	if err != nil {
+		return err
+	}
+
+	held, err := lockHeldStock(ctx, tx, locationId, kitId)
+	if err != nil {
+		return err
+	}
+
+	shortfall := requested - max(min(product.Available, locationQuantity-held), 0)
+	if shortfall <= 0 {
+		return nil
+	}
+
+	if product.AllowBackorder {
+		for _, component := range components {
+			if err := seedLocationStock(ctx, tx, component.ProductId); err != nil {
+				return err
+			}
+		}
+
+		buildable, err := kitBuildable(ctx, tx, kitId, locationId)
+		if err != nil {
+			return err
+		}
+
+		if shortfall = min(shortfall, buildable); shortfall <= 0 {
+			return nil
+		}
+	}
+
+	note := "assembled for purchase"
+	if reference != "" {
+		note += " " + reference
+	}
+
+	_, err = s.assembleKit(ctx, tx, &AssembleKitDto{
+		KitId:      kitId,
+		LocationId: locationId,
+		Quantity:   shortfall,
+		Note:       note,
+	})
+	return err
+}
+
+var (
+	errUnknownUnit       = errors.New("unknown unit of measure")
+	errUnitQuantityLimit = errors.New("quantity is too large in base units")
+)
+
+// base units in one of the product's units of measure, each is the base unit
+// every product has
+func unitFactor(ctx context.Context, tx *sql.Tx, productId int64, unit string) (int64, error) {
+	if unit == "each" {
+		return 1, nil
+	}
+
+	var factor int64
+	err := tx.QueryRowContext(ctx, `SELECT factor FROM product_units WHERE product_id = ? AND code = ?`, productId, unit).Scan(&factor)
+	if err == sql.ErrNoRows {
+		return 0, fmt.Errorf("product %d has no unit %s: %w", productId, unit, errUnknownUnit)
+	}
+	return factor, err
+}
+
+// quantity in a unit of factor base units, refusing quantities whose base
+// units do not fit the INT quantity columns
+func toBaseUnits(quantity int64, factor int64) (int64, error) {
+	if factor <= 0 {
+		return 0, fmt.Errorf("unit factor %d: %w", factor, errUnknownUnit)
+	}
+	if quantity > math.MaxInt32/factor || quantity < math.MinInt32/factor {
+		return 0, fmt.Errorf("%d units of %d: %w", quantity, factor, errUnitQuantityLimit)
+	}
+	return quantity * factor, nil
+}
+
+var (
+	errInvalidReasonCode      = errors.New("invalid correction reason")
+	errCorrectionNotFound     = errors.New("stock correction not found")
+	errCorrectionNotPending   = errors.New("stock correction is not pending")
+	errCorrectionSelfApproval = errors.New("stock correction must be reviewed by another user")
+)
+
+func (s *inventoryStore) ListCorrectionReasons(ctx context.Context, includeInactive bool) ([]*pb.CorrectionReason, error) {
+	query := `SELECT code, name, active FROM correction_reasons`
+	if !includeInactive {
+		query += " WHERE active"
+	}
+	query += " ORDER BY code"
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reasons []*pb.CorrectionReason
+	for rows.Next() {
+		var reason pb.CorrectionReason
+		if err := rows.Scan(&reason.Code, &reason.Name, &reason.Active); err != nil {
+			return nil, err
+		}
+		reasons = append(reasons, &reason)
+	}
+
+	return reasons, rows.Err()
+}
+
+// reasons are never deleted since corrections keep referring to them, they
+// are deactivated instead
+func (s *inventoryStore) SaveCorrectionReason(ctx context.Context, reason *pb.CorrectionReason) (*pb.CorrectionReason, error) {
+	query := `
+	INSERT INTO correction_reasons (code, name, active)
+	VALUES (?, ?, ?)
+	ON DUPLICATE KEY UPDATE name = VALUES(name), active = VALUES(active)
+	`
+	if _, err := s.db.ExecContext(ctx, query, reason.Code, reason.Name, reason.Active); err != nil {
+		return nil, err
+	}
+
+	return reason, nil
+}
+
+type CorrectionThresholds struct {
+	Quantity int64
+	Value    float64
+}
+
+type CorrectStockDto struct {
+	ProductId     int64
+	LocationId    int64
+	Quantity      int64
+	Unit          string
+	ReasonCode    string
+	Note          string
+	RequestedBy   string
+	SerialNumbers []string
+}
+
+const correctionColumns = `id, product_id, location_id, quantity, COALESCE(unit, ''), COALESCE(unit_quantity, 0), reason_code, COALESCE(note, ''), status, requested_by, COALESCE(reviewed_by, ''), change_quantity, value, created_at, COALESCE(reviewed_at, ''), COALESCE(movement_id, 0)`
+
+func scanCorrection(row rowScanner) (*pb.StockCorrection, int64, error) {
+	var correction pb.StockCorrection
+	var movementId int64
+	if err := row.Scan(&correction.Id, &correction.ProductId, &correction.LocationId, &correction.Quantity, &correction.Unit, &correction.UnitQuantity, &correction.ReasonCode, &correction.Note, &correction.Status, &correction.RequestedBy, &correction.ReviewedBy, &correction.Change, &correction.Value, &correction.CreatedAt, &correction.ReviewedAt, &movementId); err != nil {
+		return nil, 0, err
+	}
+	return &correction, movementId, nil
+}
+
+func getCorrection(ctx context.Context, tx *sql.Tx, id int64) (*pb.StockCorrection, error) {
+	correction, movementId, err := scanCorrection(tx.QueryRowContext(ctx, `SELECT `+correctionColumns+` FROM stock_corrections WHERE id = ?`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("correction %d: %w", id, errCorrectionNotFound)
+		}
+		return nil, err
+	}
+
+	if correction.SerialNumbers, err = correctionSerialNumbers(ctx, tx, id); err != nil {
+		return nil, err
+	}
+
+	if movementId > 0 {
+		if correction.Movement, err = scanMovement(tx.QueryRowContext(ctx, `SELECT `+movementColumns+` FROM stock_movements WHERE id = ?`, movementId)); err != nil {
+			return nil, err
 		}
-	}()
+	}
 
-	_, err = tx.ExecContext(ctx, `
-	CREATE TABLE IF NOT EXISTS stock_movements (
-		id INT AUTO_INCREMENT PRIMARY KEY,
-		product_id INT NOT NULL,
-		quantity_change INT NOT NULL,
-    	type ENUM('purchase', 'supply', 'correction') NOT NULL,
-		reference VARCHAR(100),
-    	note TEXT,
-		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE
-	);
-	`)
+	return correction, nil
+}
+
+func correctionSerialNumbers(ctx context.Context, tx *sql.Tx, id int64) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT serial_number FROM stock_correction_serials WHERE correction_id = ? ORDER BY serial_number`, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer rows.Close()
 
-	return tx.Commit()
-}
+	var serialNumbers []string
+	for rows.Next() {
+		var serialNumber string
+		if err := rows.Scan(&serialNumber); err != nil {
+			return nil, err
+		}
+		serialNumbers = append(serialNumbers, serialNumber)
+	}
 
-type UpdateStockDto struct {
-	ProductId int64
-	Change    int64
-	Reference string
-	Note      string
-	Type      string
+	return serialNumbers, rows.Err()
 }
 
-func (s *inventoryStore) UpdateStockQuantity(ctx context.Context, payload *UpdateStockDto) (*pb.StockMovement, error) {
+// corrections moving more units or value than the thresholds allow are held
+// as pending until another user approves them, the rest post right away
+func (s *inventoryStore) CorrectStock(ctx context.Context, payload *CorrectStockDto, thresholds CorrectionThresholds) (*pb.StockCorrection, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -103,50 +4262,96 @@ func (s *inventoryStore) UpdateStockQuantity(ctx context.Context, payload *Updat
 
 	defer func() {
 		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
-			Logger.LogError("update stock quantity", "failed to rollback transaction: %v", err)
+			Logger.LogError("correct stock", "failed to rollback transaction: %v", err)
 		}
 	}()
 
-	query := `
-	UPDATE products
-	SET stock_quantity = stock_quantity + ?
-	WHERE id = ?
-	`
-	if payload.Type == "correction" {
-		query = `
-		UPDATE products
-		SET stock_quantity = ?
-		WHERE id = ?
-		`
+	var active bool
+	if err := tx.QueryRowContext(ctx, `SELECT active FROM correction_reasons WHERE code = ?`, payload.ReasonCode).Scan(&active); err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if !active {
+		return nil, fmt.Errorf("reason %q is not an active correction reason: %w", payload.ReasonCode, errInvalidReasonCode)
+	}
+
+	locationId := resolveLocationId(payload.LocationId)
+
+	quantity := payload.Quantity
+	var unit, unitQuantity, perUnit any
+	if payload.Unit != "" {
+		factor, err := unitFactor(ctx, tx, payload.ProductId, payload.Unit)
+		if err != nil {
+			return nil, err
+		}
+		if quantity, err = toBaseUnits(payload.Quantity, factor); err != nil {
+			return nil, err
+		}
+		unit, unitQuantity, perUnit = payload.Unit, payload.Quantity, factor
 	}
 
-	if _, err := tx.ExecContext(ctx, query, payload.Change, payload.ProductId); err != nil {
+	if err := seedLocationStock(ctx, tx, payload.ProductId); err != nil {
 		return nil, err
 	}
 
-	quantityChange := payload.Change
-	if quantityChange < 0 {
-		quantityChange = -quantityChange
+	product, err := lockAvailableStock(ctx, tx, payload.ProductId, 0)
+	if err != nil {
+		return nil, err
 	}
 
-	query = `
-	INSERT INTO stock_movements (product_id, quantity_change, type, reference, note)
-	VALUES (?,?,?,?,?)
+	current, err := lockLocationStock(ctx, tx, locationId, payload.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	var valuation productValuation
+	err = tx.QueryRowContext(ctx, `SELECT quantity, value, last_unit_cost FROM product_valuations WHERE product_id = ?`, payload.ProductId).Scan(&valuation.Quantity, &valuation.Value, &valuation.LastUnitCost)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	change := quantity - current
+	value := float64(max(change, -change)) * valuation.unitCost()
+
+	// serialized stock is corrected by naming the units found or written off
+	if err := checkSerialNumbers(payload.ProductId, product.Serialized, "correction", payload.SerialNumbers, change); err != nil {
+		return nil, err
+	}
+
+	correctionStatus := "posted"
+	if max(change, -change) > thresholds.Quantity || value > thresholds.Value {
+		correctionStatus = "pending"
+	}
+
+	query := `
+	INSERT INTO stock_corrections (product_id, location_id, quantity, unit, unit_quantity, unit_factor, reason_code, note, status, requested_by, change_quantity, value)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	result, err := tx.ExecContext(ctx, query, payload.ProductId, quantityChange, payload.Type, payload.Reference, payload.Note)
+	result, err := tx.ExecContext(ctx, query, payload.ProductId, locationId, quantity, unit, unitQuantity, perUnit, payload.ReasonCode, payload.Note, correctionStatus, payload.RequestedBy, change, value)
 	if err != nil {
 		return nil, err
 	}
 
-	insertedId, err := result.LastInsertId()
+	id, err := result.LastInsertId()
 	if err != nil {
 		return nil, err
 	}
-	var record pb.StockMovement
 
-	row := tx.QueryRowContext(ctx, `SELECT id, product_id, quantity_change, type, reference, note, created_at FROM stock_movements WHERE id=?`, insertedId)
-	if err := row.Scan(&record.Id, &record.ProductId, &record.Change, &record.Type, &record.Reference, &record.Note, &record.CreatedAt); err != nil {
+	for _, serialNumber := range payload.SerialNumbers {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO stock_correction_serials (correction_id, serial_number) VALUES (?, ?)`, id, serialNumber); err != nil {
+			return nil, err
+		}
+	}
+
+	if correctionStatus == "posted" {
+		if err := s.postCorrection(ctx, tx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	correction, err := getCorrection(ctx, tx, id)
+	if err != nil {
 		return nil, err
 	}
 
@@ -154,10 +4359,51 @@ func (s *inventoryStore) UpdateStockQuantity(ctx context.Context, payload *Updat
 		return nil, err
 	}
 
-	return &record, nil
+	return correction, nil
+}
+
+// sets the level the correction asked for, an approved correction sets it
+// as counted even if stock moved while it was pending
+func (s *inventoryStore) postCorrection(ctx context.Context, tx *sql.Tx, id int64) error {
+	var productId, locationId, quantity, unitQuantity, factor int64
+	var unit, reasonCode, note string
+
+	query := `
+	SELECT product_id, location_id, quantity, COALESCE(unit, ''), COALESCE(unit_quantity, 0), COALESCE(unit_factor, 0), reason_code, COALESCE(note, '')
+	FROM stock_corrections
+	WHERE id = ?
+	`
+	if err := tx.QueryRowContext(ctx, query, id).Scan(&productId, &locationId, &quantity, &unit, &unitQuantity, &factor, &reasonCode, &note); err != nil {
+		return err
+	}
+
+	serialNumbers, err := correctionSerialNumbers(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	record, err := s.updateStockQuantity(ctx, tx, &UpdateStockDto{
+		ProductId:     productId,
+		LocationId:    locationId,
+		Change:        quantity,
+		Reference:     fmt.Sprintf("correction-%d", id),
+		Note:          note,
+		Type:          "correction",
+		Unit:          unit,
+		UnitQuantity:  unitQuantity,
+		UnitFactor:    factor,
+		ReasonCode:    reasonCode,
+		SerialNumbers: serialNumbers,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE stock_corrections SET status = 'posted', movement_id = ? WHERE id = ?`, record.Id, id)
+	return err
 }
 
-func (s *inventoryStore) ListStockMovements(ctx context.Context, productId int64) ([]*pb.StockMovement, error) {
+func (s *inventoryStore) ReviewStockCorrection(ctx context.Context, id int64, reviewedBy string, approve bool) (*pb.StockCorrection, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -165,37 +4411,327 @@ func (s *inventoryStore) ListStockMovements(ctx context.Context, productId int64
 
 	defer func() {
 		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
-			Logger.LogError("get stock movements", "failed to rollback transaction: %v", err)
+			Logger.LogError("review stock correction", "failed to rollback transaction: %v", err)
 		}
 	}()
 
-	query := `
-	SELECT id, product_id, quantity_change, type, reference, note, created_at FROM stock_movements
-	`
+	var correctionStatus, requestedBy string
+	if err := tx.QueryRowContext(ctx, `SELECT status, requested_by FROM stock_corrections WHERE id = ? FOR UPDATE`, id).Scan(&correctionStatus, &requestedBy); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("correction %d: %w", id, errCorrectionNotFound)
+		}
+		return nil, err
+	}
+
+	if correctionStatus != "pending" {
+		return nil, fmt.Errorf("correction %d is %s: %w", id, correctionStatus, errCorrectionNotPending)
+	}
+
+	if strings.EqualFold(requestedBy, reviewedBy) {
+		return nil, fmt.Errorf("correction %d was requested by %s: %w", id, requestedBy, errCorrectionSelfApproval)
+	}
+
+	if approve {
+		if err := s.postCorrection(ctx, tx, id); err != nil {
+			return nil, err
+		}
+	} else if _, err := tx.ExecContext(ctx, `UPDATE stock_corrections SET status = 'rejected' WHERE id = ?`, id); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE stock_corrections SET reviewed_by = ?, reviewed_at = CURRENT_TIMESTAMP WHERE id = ?`, reviewedBy, id); err != nil {
+		return nil, err
+	}
+
+	correction, err := getCorrection(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return correction, nil
+}
+
+func (s *inventoryStore) ListStockCorrections(ctx context.Context, correctionStatus string, productId int64) ([]*pb.StockCorrection, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("list stock corrections", "failed to rollback transaction: %v", err)
+		}
+	}()
 
+	var conditions []string
 	var args []any
+	if correctionStatus != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, correctionStatus)
+	}
 	if productId > 0 {
-		query += " WHERE id = ?"
+		conditions = append(conditions, "product_id = ?")
 		args = append(args, productId)
 	}
 
+	query := `SELECT id FROM stock_corrections`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id DESC"
+
 	rows, err := tx.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	corrections := make([]*pb.StockCorrection, 0, len(ids))
+	for _, id := range ids {
+		correction, err := getCorrection(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		corrections = append(corrections, correction)
+	}
+
+	return corrections, nil
+}
+
+var errInsufficientState = errors.New("insufficient stock in state")
+
+// on hand quantity of every product and location matching the condition,
+// split by stock state. Available is what is not held in another state.
+const stockLevelsQuery = `
+SELECT ls.location_id, ls.product_id, ls.quantity,
+	COALESCE(SUM(CASE WHEN s.state = 'quarantined' THEN s.quantity END), 0),
+	COALESCE(SUM(CASE WHEN s.state = 'damaged' THEN s.quantity END), 0),
+	COALESCE(SUM(CASE WHEN s.state = 'in_transit' THEN s.quantity END), 0)
+FROM location_stock ls
+LEFT JOIN location_stock_states s ON s.location_id = ls.location_id AND s.product_id = ls.product_id
+WHERE `
+
+func queryStockLevels(ctx context.Context, tx *sql.Tx, condition string, args ...any) ([]*pb.LocationStockLevel, error) {
+	rows, err := tx.QueryContext(ctx, stockLevelsQuery+condition+` GROUP BY ls.location_id, ls.product_id, ls.quantity ORDER BY ls.location_id, ls.product_id`, args...)
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
 
-	var records []*pb.StockMovement
+	var levels []*pb.LocationStockLevel
 	for rows.Next() {
-		var record pb.StockMovement
-		if err := rows.Scan(&record.Id, &record.ProductId, &record.Change, &record.Type, &record.Reference, &record.Note, &record.CreatedAt); err != nil {
+		var level pb.LocationStockLevel
+		if err := rows.Scan(&level.LocationId, &level.ProductId, &level.Quantity, &level.Quarantined, &level.Damaged, &level.InTransit); err != nil {
 			return nil, err
 		}
-		records = append(records, &record)
+		level.Available = level.Quantity - level.Quarantined - level.Damaged - level.InTransit
+		levels = append(levels, &level)
 	}
-	if err = rows.Err(); err != nil {
+
+	return levels, rows.Err()
+}
+
+type MoveStockStateDto struct {
+	ProductId     int64
+	LocationId    int64
+	Quantity      int64
+	FromState     string
+	ToState       string
+	Note          string
+	SerialNumbers []string
+}
+
+// moves on hand units between the available bucket and the held states at
+// one location. The total on hand does not change, the pair of state movements
+// records where the units went and held_quantity on the product keeps them out
+// of what can be sold. The serial units of a serialized product take the state
+// they are moved into as their status.
+func (s *inventoryStore) MoveStockState(ctx context.Context, payload *MoveStockStateDto) (*pb.MoveStockStateResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("move stock state", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	locationId := resolveLocationId(payload.LocationId)
+
+	if err := seedLocationStock(ctx, tx, payload.ProductId); err != nil {
+		return nil, err
+	}
+
+	product, err := lockAvailableStock(ctx, tx, payload.ProductId, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkSerialNumbers(payload.ProductId, product.Serialized, "state_out", payload.SerialNumbers, payload.Quantity); err != nil {
+		return nil, err
+	}
+
+	locationQuantity, err := lockLocationStock(ctx, tx, locationId, payload.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	held, err := lockHeldStock(ctx, tx, locationId, payload.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	// units leaving the available bucket cannot be promised to a reservation
+	// or be stock the location does not have
+	if payload.FromState == "available" {
+		available := min(locationQuantity-held, product.Available)
+		if available < payload.Quantity {
+			return nil, &insufficientStockError{
+				ProductId:  payload.ProductId,
+				LocationId: locationId,
+				Available:  max(available, 0),
+				Requested:  payload.Quantity,
+			}
+		}
+	} else {
+		var quantity int64
+		err := tx.QueryRowContext(ctx, `SELECT quantity FROM location_stock_states WHERE location_id = ? AND product_id = ? AND state = ?`, locationId, payload.ProductId, payload.FromState).Scan(&quantity)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+
+		if quantity < payload.Quantity {
+			return nil, fmt.Errorf("product %d at location %d has %d %s, %d requested: %w", payload.ProductId, locationId, quantity, payload.FromState, payload.Quantity, errInsufficientState)
+		}
+	}
+
+	var heldChange int64
+	for _, bucket := range []struct {
+		state  string
+		change int64
+	}{{payload.FromState, -payload.Quantity}, {payload.ToState, payload.Quantity}} {
+		state, change := bucket.state, bucket.change
+		if state == "available" {
+			continue
+		}
+
+		query := `
+		INSERT INTO location_stock_states (location_id, product_id, state, quantity)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE quantity = quantity + ?
+		`
+		if _, err := tx.ExecContext(ctx, query, locationId, payload.ProductId, state, change, change); err != nil {
+			return nil, err
+		}
+		heldChange += change
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE products SET held_quantity = held_quantity + ? WHERE id = ?`, heldChange, payload.ProductId); err != nil {
+		return nil, err
+	}
+
+	out, err := insertMovement(ctx, tx, &movementDto{
+		ProductId:  payload.ProductId,
+		LocationId: locationId,
+		Quantity:   payload.Quantity,
+		Type:       "state_out",
+		Note:       payload.Note,
+		State:      payload.FromState,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// both halves share a reference derived from the outgoing movement, like transfers
+	out.Reference = fmt.Sprintf("state-%d", out.Id)
+	if _, err := tx.ExecContext(ctx, `UPDATE stock_movements SET reference = ? WHERE id = ?`, out.Reference, out.Id); err != nil {
+		return nil, err
+	}
+
+	in, err := insertMovement(ctx, tx, &movementDto{
+		ProductId:  payload.ProductId,
+		LocationId: locationId,
+		Quantity:   payload.Quantity,
+		Type:       "state_in",
+		Reference:  out.Reference,
+		Note:       payload.Note,
+		State:      payload.ToState,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := moveSerialUnits(ctx, tx, out, -payload.Quantity, payload.SerialNumbers); err != nil {
+		return nil, err
+	}
+
+	if err := moveSerialUnits(ctx, tx, in, payload.Quantity, payload.SerialNumbers); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &pb.MoveStockStateResponse{
+		Out: out,
+		In:  in,
+	}, nil
+}
+
+func (s *inventoryStore) GetProductStockStates(ctx context.Context, productId int64) (*pb.ProductStockStatesResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get product stock states", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if err := seedLocationStock(ctx, tx, productId); err != nil {
+		return nil, err
+	}
+
+	response := &pb.ProductStockStatesResponse{ProductId: productId}
+
+	row := tx.QueryRowContext(ctx, `SELECT stock_quantity, reserved_quantity, held_quantity FROM products WHERE id = ?`, productId)
+	if err := row.Scan(&response.OnHand, &response.Reserved, &response.Held); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	response.AvailableToSell = response.OnHand - response.Reserved - response.Held
+
+	if response.Levels, err = queryStockLevels(ctx, tx, "ls.product_id = ?", productId); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
-	return records, nil
+	return response, nil
 }
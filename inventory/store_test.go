@@ -0,0 +1,367 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	pb "github.com/logan2k02/ims/shared/protobuf"
+)
+
+func TestPickBatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		batches  []consumedBatch
+		quantity int64
+		want     []consumedBatch
+	}{
+		{
+			name: "earliest expiry first",
+			batches: []consumedBatch{
+				{BatchId: 1, LotNumber: "A", ExpiresAt: "2025-03-01", Quantity: 10},
+				{BatchId: 2, LotNumber: "B", ExpiresAt: "2025-01-01", Quantity: 10},
+			},
+			quantity: 5,
+			want:     []consumedBatch{{BatchId: 2, LotNumber: "B", ExpiresAt: "2025-01-01", Quantity: 5}},
+		},
+		{
+			name: "spills into the next lot",
+			batches: []consumedBatch{
+				{BatchId: 1, LotNumber: "A", ExpiresAt: "2025-03-01", Quantity: 10},
+				{BatchId: 2, LotNumber: "B", ExpiresAt: "2025-01-01", Quantity: 4},
+			},
+			quantity: 7,
+			want: []consumedBatch{
+				{BatchId: 2, LotNumber: "B", ExpiresAt: "2025-01-01", Quantity: 4},
+				{BatchId: 1, LotNumber: "A", ExpiresAt: "2025-03-01", Quantity: 3},
+			},
+		},
+		{
+			name: "lots without an expiry go last",
+			batches: []consumedBatch{
+				{BatchId: 1, LotNumber: "A", Quantity: 10},
+				{BatchId: 2, LotNumber: "B", ExpiresAt: "2030-12-31", Quantity: 2},
+			},
+			quantity: 5,
+			want: []consumedBatch{
+				{BatchId: 2, LotNumber: "B", ExpiresAt: "2030-12-31", Quantity: 2},
+				{BatchId: 1, LotNumber: "A", Quantity: 3},
+			},
+		},
+		{
+			name: "same expiry goes to the oldest lot",
+			batches: []consumedBatch{
+				{BatchId: 7, LotNumber: "B", ExpiresAt: "2025-01-01", Quantity: 10},
+				{BatchId: 3, LotNumber: "A", ExpiresAt: "2025-01-01", Quantity: 10},
+			},
+			quantity: 12,
+			want: []consumedBatch{
+				{BatchId: 3, LotNumber: "A", ExpiresAt: "2025-01-01", Quantity: 10},
+				{BatchId: 7, LotNumber: "B", ExpiresAt: "2025-01-01", Quantity: 2},
+			},
+		},
+		{
+			name: "empty lots are skipped",
+			batches: []consumedBatch{
+				{BatchId: 1, LotNumber: "A", ExpiresAt: "2025-01-01", Quantity: 0},
+				{BatchId: 2, LotNumber: "B", ExpiresAt: "2025-02-01", Quantity: 5},
+			},
+			quantity: 5,
+			want:     []consumedBatch{{BatchId: 2, LotNumber: "B", ExpiresAt: "2025-02-01", Quantity: 5}},
+		},
+		{
+			name: "unbatched stock covers the rest",
+			batches: []consumedBatch{
+				{BatchId: 1, LotNumber: "A", ExpiresAt: "2025-01-01", Quantity: 3},
+			},
+			quantity: 10,
+			want:     []consumedBatch{{BatchId: 1, LotNumber: "A", ExpiresAt: "2025-01-01", Quantity: 3}},
+		},
+		{
+			name:     "no lots",
+			quantity: 10,
+		},
+		{
+			name: "nothing to consume",
+			batches: []consumedBatch{
+				{BatchId: 1, LotNumber: "A", ExpiresAt: "2025-01-01", Quantity: 3},
+			},
+			quantity: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		original := slices.Clone(tt.batches)
+		if got := pickBatches(tt.batches, tt.quantity); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: pickBatches() = %+v, want %+v", tt.name, got, tt.want)
+		}
+		if !slices.Equal(tt.batches, original) {
+			t.Errorf("%s: pickBatches() changed the batches given", tt.name)
+		}
+	}
+}
+
+func TestToBaseUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity int64
+		factor   int64
+		want     int64
+		wantErr  error
+	}{
+		{name: "each", quantity: 7, factor: 1, want: 7},
+		{name: "cases of twelve", quantity: 3, factor: 12, want: 36},
+		{name: "removal keeps its sign", quantity: -3, factor: 12, want: -36},
+		{name: "zero", quantity: 0, factor: 24, want: 0},
+		{name: "largest that fits", quantity: math.MaxInt32 / 1000, factor: 1000, want: math.MaxInt32 / 1000 * 1000},
+		{name: "largest removal that fits", quantity: math.MinInt32 / 1000, factor: 1000, want: math.MinInt32 / 1000 * 1000},
+		{name: "too many base units", quantity: math.MaxInt32/1000 + 1, factor: 1000, wantErr: errUnitQuantityLimit},
+		{name: "too many base units removed", quantity: math.MinInt32/1000 - 1, factor: 1000, wantErr: errUnitQuantityLimit},
+		{name: "beyond int32 in base units", quantity: math.MaxInt32 + 1, factor: 1, wantErr: errUnitQuantityLimit},
+		{name: "zero factor", quantity: 3, factor: 0, wantErr: errUnknownUnit},
+		{name: "negative factor", quantity: 3, factor: -12, wantErr: errUnknownUnit},
+	}
+
+	for _, tt := range tests {
+		got, err := toBaseUnits(tt.quantity, tt.factor)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%s: toBaseUnits(%d, %d) error = %v, want %v", tt.name, tt.quantity, tt.factor, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: toBaseUnits(%d, %d) = %d, %v, want %d", tt.name, tt.quantity, tt.factor, got, err, tt.want)
+		}
+	}
+}
+
+func TestReservationHoldCommitQuantity(t *testing.T) {
+	created := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC).Unix()
+	ttl := int64((30 * time.Minute).Seconds())
+	expiresAt := sql.NullInt64{Int64: created + ttl, Valid: true}
+	afterTTL := created + ttl + int64(time.Hour.Seconds())
+
+	tests := []struct {
+		name      string
+		hold      reservationHold
+		requested int64
+		now       int64
+		want      int64
+		wantErr   error
+	}{
+		{
+			name:      "order hold commits after the ttl has passed",
+			hold:      reservationHold{Status: "active", Quantity: 5},
+			requested: 0,
+			now:       afterTTL,
+			want:      5,
+		},
+		{
+			name:      "order hold commits part after the ttl has passed",
+			hold:      reservationHold{Status: "active", Quantity: 5, Committed: 2},
+			requested: 2,
+			now:       afterTTL,
+			want:      2,
+		},
+		{
+			name:      "expiring hold commits before its ttl",
+			hold:      reservationHold{Status: "active", Quantity: 5, ExpiresAt: expiresAt},
+			requested: 0,
+			now:       created + ttl - 1,
+			want:      5,
+		},
+		{
+			name:    "expiring hold is rejected once its ttl has passed",
+			hold:    reservationHold{Status: "active", Quantity: 5, ExpiresAt: expiresAt},
+			now:     afterTTL,
+			wantErr: errReservationNotActive,
+		},
+		{
+			name:    "expiring hold is rejected at its expiry",
+			hold:    reservationHold{Status: "active", Quantity: 5, ExpiresAt: expiresAt},
+			now:     created + ttl,
+			wantErr: errReservationNotActive,
+		},
+		{
+			name:      "commits what is left",
+			hold:      reservationHold{Status: "active", Quantity: 5, Committed: 3},
+			requested: 0,
+			now:       created,
+			want:      2,
+		},
+		{
+			name:      "more than is left",
+			hold:      reservationHold{Status: "active", Quantity: 5, Committed: 3},
+			requested: 3,
+			now:       created,
+			wantErr:   errReservationNotActive,
+		},
+		{
+			name:    "released",
+			hold:    reservationHold{Status: "released", Quantity: 5},
+			now:     created,
+			wantErr: errReservationNotActive,
+		},
+		{
+			name:    "swept as expired",
+			hold:    reservationHold{Status: "expired", Quantity: 5, ExpiresAt: expiresAt},
+			now:     afterTTL,
+			wantErr: errReservationNotActive,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.hold.commitQuantity(tt.requested, tt.now)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%s: commitQuantity() error = %v, want %v", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: commitQuantity() = %d, %v, want %d", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestCheckSerialNumbers(t *testing.T) {
+	tests := []struct {
+		name          string
+		serialized    bool
+		movementType  string
+		serialNumbers []string
+		quantity      int64
+		wantErr       bool
+	}{
+		{name: "plain product", movementType: "purchase", quantity: 2},
+		{name: "plain product with serials", movementType: "supply", serialNumbers: []string{"A"}, quantity: 1, wantErr: true},
+		{name: "one per unit", serialized: true, movementType: "supply", serialNumbers: []string{"A", "B"}, quantity: 2},
+		{name: "removal counts units", serialized: true, movementType: "purchase", serialNumbers: []string{"A", "B"}, quantity: -2},
+		{name: "too few", serialized: true, movementType: "purchase", serialNumbers: []string{"A"}, quantity: 2, wantErr: true},
+		{name: "duplicate", serialized: true, movementType: "supply", serialNumbers: []string{"A", "A"}, quantity: 2, wantErr: true},
+		{name: "blank", serialized: true, movementType: "supply", serialNumbers: []string{" "}, quantity: 1, wantErr: true},
+		{name: "write off", serialized: true, movementType: "correction", serialNumbers: []string{"A"}, quantity: -1},
+		{name: "write off without serials", serialized: true, movementType: "correction", quantity: -3, wantErr: true},
+		{name: "correction to the level on hand", serialized: true, movementType: "correction", quantity: 0},
+		{name: "state move", serialized: true, movementType: "state_out", serialNumbers: []string{"A"}, quantity: 1},
+		{name: "state move without serials", serialized: true, movementType: "state_out", quantity: 1, wantErr: true},
+		{name: "assembly", serialized: true, movementType: "assembly_out", quantity: -1, wantErr: true},
+		{name: "reversal", serialized: true, movementType: "reversal", quantity: -1},
+		{name: "reversal with serials", serialized: true, movementType: "reversal", serialNumbers: []string{"A"}, quantity: -1, wantErr: true},
+		{name: "scrap", serialized: true, movementType: "scrap", serialNumbers: []string{"A", "B"}, quantity: 2},
+		{name: "scrap without serials", serialized: true, movementType: "scrap", quantity: 2, wantErr: true},
+		{name: "scrap of a plain product", movementType: "scrap", quantity: 2},
+	}
+
+	for _, tt := range tests {
+		err := checkSerialNumbers(1, tt.serialized, tt.movementType, tt.serialNumbers, tt.quantity)
+		if tt.wantErr {
+			if !errors.Is(err, errInvalidSerialNumbers) {
+				t.Errorf("%s: checkSerialNumbers() error = %v, want %v", tt.name, err, errInvalidSerialNumbers)
+			}
+		} else if err != nil {
+			t.Errorf("%s: checkSerialNumbers() error = %v", tt.name, err)
+		}
+	}
+}
+
+func TestSerialUnitStateNext(t *testing.T) {
+	const here, there = 1, 2
+
+	tests := []struct {
+		name     string
+		unit     serialUnitState
+		movement *pb.StockMovement
+		change   int64
+		want     string
+		wantErr  bool
+	}{
+		{name: "new unit supplied", movement: &pb.StockMovement{Type: "supply", LocationId: here}, change: 1, want: "in_stock"},
+		{name: "supplied twice", unit: serialUnitState{Exists: true, Status: "damaged", LocationId: there}, movement: &pb.StockMovement{Type: "supply", LocationId: here}, change: 1, wantErr: true},
+		{name: "sold", unit: serialUnitState{Exists: true, Status: "returned", LocationId: here}, movement: &pb.StockMovement{Type: "purchase", LocationId: here}, change: -1, want: "sold"},
+		{name: "held unit cannot be sold", unit: serialUnitState{Exists: true, Status: "quarantined", LocationId: here}, movement: &pb.StockMovement{Type: "purchase", LocationId: here}, change: -1, wantErr: true},
+		{name: "sold elsewhere", unit: serialUnitState{Exists: true, Status: "in_stock", LocationId: there}, movement: &pb.StockMovement{Type: "purchase", LocationId: here}, change: -1, wantErr: true},
+		{name: "written off", unit: serialUnitState{Exists: true, Status: "in_stock", LocationId: here}, movement: &pb.StockMovement{Type: "correction", LocationId: here}, change: -1, want: "scrapped"},
+		{name: "damaged unit written off", unit: serialUnitState{Exists: true, Status: "damaged", LocationId: here}, movement: &pb.StockMovement{Type: "correction", LocationId: here}, change: -1, want: "scrapped"},
+		{name: "sold unit cannot be written off", unit: serialUnitState{Exists: true, Status: "sold", LocationId: here}, movement: &pb.StockMovement{Type: "correction", LocationId: here}, change: -1, wantErr: true},
+		{name: "write off elsewhere", unit: serialUnitState{Exists: true, Status: "in_stock", LocationId: there}, movement: &pb.StockMovement{Type: "correction", LocationId: here}, change: -1, wantErr: true},
+		{name: "found in a count", movement: &pb.StockMovement{Type: "correction", LocationId: here}, change: 1, want: "in_stock"},
+		{name: "scrapped unit found", unit: serialUnitState{Exists: true, Status: "scrapped", LocationId: here}, movement: &pb.StockMovement{Type: "correction", LocationId: here}, change: 1, want: "in_stock"},
+		{name: "found while in stock", unit: serialUnitState{Exists: true, Status: "in_stock", LocationId: there}, movement: &pb.StockMovement{Type: "correction", LocationId: here}, change: 1, wantErr: true},
+		{name: "quarantined", unit: serialUnitState{Exists: true, Status: "in_stock", LocationId: here}, movement: &pb.StockMovement{Type: "state_out", State: "available", LocationId: here}, change: -1, want: "in_stock"},
+		{name: "not in the state moved out of", unit: serialUnitState{Exists: true, Status: "in_stock", LocationId: here}, movement: &pb.StockMovement{Type: "state_out", State: "damaged", LocationId: here}, change: -1, wantErr: true},
+		{name: "moved into a held state", unit: serialUnitState{Exists: true, Status: "in_stock", LocationId: here}, movement: &pb.StockMovement{Type: "state_in", State: "damaged", LocationId: here}, change: 1, want: "damaged"},
+		{name: "released from a held state", unit: serialUnitState{Exists: true, Status: "quarantined", LocationId: here}, movement: &pb.StockMovement{Type: "state_in", State: "available", LocationId: here}, change: 1, want: "in_stock"},
+		{name: "returned", unit: serialUnitState{Exists: true, Status: "sold", LocationId: there}, movement: &pb.StockMovement{Type: "return", LocationId: here}, change: 1, want: "returned"},
+		{name: "transfer keeps the status", unit: serialUnitState{Exists: true, Status: "returned", LocationId: here}, movement: &pb.StockMovement{Type: "transfer_out", LocationId: here}, change: -1, want: "returned"},
+		{name: "returned unit scrapped", unit: serialUnitState{Exists: true, Status: "sold", LocationId: here}, movement: &pb.StockMovement{Type: "scrap"}, want: "scrapped"},
+		{name: "unit in stock cannot be scrapped off a return", unit: serialUnitState{Exists: true, Status: "in_stock", LocationId: here}, movement: &pb.StockMovement{Type: "scrap"}, wantErr: true},
+		{name: "unknown unit scrapped", movement: &pb.StockMovement{Type: "scrap"}, wantErr: true},
+		{name: "scrap restored", unit: serialUnitState{Exists: true, Status: "scrapped", LocationId: here}, movement: &pb.StockMovement{Type: "scrap_restore"}, want: "sold"},
+		{name: "restore of a unit not scrapped", unit: serialUnitState{Exists: true, Status: "sold", LocationId: here}, movement: &pb.StockMovement{Type: "scrap_restore"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.unit.next("SN-1", tt.movement, tt.change)
+		if tt.wantErr {
+			if !errors.Is(err, errSerialUnavailable) {
+				t.Errorf("%s: next() error = %v, want %v", tt.name, err, errSerialUnavailable)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: next() = %q, %v, want %q", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestApproveCycleCountWithoutLines(t *testing.T) {
+	store := &inventoryStore{}
+
+	for _, lineIds := range [][]int64{nil, {}} {
+		_, err := store.ApproveCycleCount(context.Background(), &ApproveCycleCountDto{CountId: 1, LineIds: lineIds})
+		if !errors.Is(err, errInvalidCycleCount) {
+			t.Errorf("ApproveCycleCount(%v) error = %v, want %v", lineIds, err, errInvalidCycleCount)
+		}
+	}
+}
+
+func TestRewindLevels(t *testing.T) {
+	here := stockKey{productId: 1, locationId: 1}
+	there := stockKey{productId: 1, locationId: 2}
+
+	// 10 on hand here at the requested time, then replayed forwards
+	history := []ledgerEntry{
+		{key: here, quantity: 4, movementType: "purchase"},
+		{key: here, quantity: 3, movementType: "transfer_out"},
+		{key: there, quantity: 3, movementType: "transfer_in"},
+		{key: here, quantity: 6, movementType: "supply"},
+		{key: here, quantity: -2, movementType: "reversal"},
+		{key: there, quantity: 1, movementType: "state_out"},
+		{key: there, quantity: 1, movementType: "state_in"},
+	}
+	levels := map[stockKey]int64{here: 10}
+	for _, movement := range history {
+		levels[movement.key] = applyMovement(levels[movement.key], movement.movementType, movement.quantity)
+	}
+
+	newestFirst := slices.Clone(history)
+	slices.Reverse(newestFirst)
+	if err := rewindLevels(levels, newestFirst); err != nil {
+		t.Fatalf("rewindLevels() error = %v", err)
+	}
+	if levels[here] != 10 || levels[there] != 0 {
+		t.Errorf("rewindLevels() = %v, want 10 here and 0 there", levels)
+	}
+
+	levels = map[stockKey]int64{here: 7}
+	err := rewindLevels(levels, []ledgerEntry{{key: here, quantity: 7, movementType: "correction"}})
+	if !errors.Is(err, errStockHistoryUnavailable) {
+		t.Errorf("rewindLevels() through a correction error = %v, want %v", err, errStockHistoryUnavailable)
+	}
+}
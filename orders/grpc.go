@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/logan2k02/ims/shared/protobuf"
 	"google.golang.org/grpc/codes"
@@ -23,6 +24,16 @@ func (h *ordersGRPCHandler) CreateOrder(ctx context.Context, payload *pb.CreateO
 	order, err := h.service.CreateOrder(ctx, payload)
 
 	if err != nil {
+		var stockErr *lineStockError
+		if errors.As(err, &stockErr) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, errInvalidOrderItem) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, errPromotionNotApplicable) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -57,6 +68,10 @@ func (h *ordersGRPCHandler) DeleteOrder(ctx context.Context, payload *pb.OrderId
 	orders, err := h.service.DeleteOrder(ctx, payload)
 
 	if err != nil {
+		var stockErr *lineStockError
+		if errors.As(err, &stockErr) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -64,11 +79,285 @@ func (h *ordersGRPCHandler) DeleteOrder(ctx context.Context, payload *pb.OrderId
 }
 
 func (h *ordersGRPCHandler) ChangeOrderStatus(ctx context.Context, payload *pb.ChangeOrderStatusRequest) (*pb.Order, error) {
+	if _, ok := orderTransitions[payload.Status]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown order status")
+	}
+
+	if payload.ChangedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "changed by is required")
+	}
+
 	record, err := h.service.ChangeOrderStatus(ctx, payload)
 
 	if err != nil {
+		if errors.Is(err, errOrderNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		if errors.Is(err, errOrderTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		var stockErr *lineStockError
+		if errors.As(err, &stockErr) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		// restocks refused by the inventory service keep their code
+		if st, ok := status.FromError(err); ok {
+			return nil, status.Error(st.Code(), err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return record, nil
 }
+
+func returnsStatusError(err error) error {
+	switch {
+	case errors.Is(err, errOrderNotFound), errors.Is(err, errReturnNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errReturnState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errInvalidReturn):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// errors from the inventory service keep their code
+	if st, ok := status.FromError(err); ok {
+		return status.Error(st.Code(), err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (h *ordersGRPCHandler) CreateReturn(ctx context.Context, payload *pb.CreateReturnRequest) (*pb.Return, error) {
+	if payload.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	if len(payload.Lines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one line is required")
+	}
+
+	ret, err := h.service.CreateReturn(ctx, payload)
+
+	if err != nil {
+		return nil, returnsStatusError(err)
+	}
+
+	return ret, nil
+}
+
+func (h *ordersGRPCHandler) GetReturn(ctx context.Context, payload *pb.ReturnIdRequest) (*pb.Return, error) {
+	ret, err := h.service.GetReturn(ctx, payload.Id)
+
+	if err != nil {
+		return nil, returnsStatusError(err)
+	}
+
+	return ret, nil
+}
+
+func (h *ordersGRPCHandler) ListReturns(ctx context.Context, payload *pb.ListReturnsRequest) (*pb.ListReturnsResponse, error) {
+	switch payload.Status {
+	case "", "requested", "received", "closed", "cancelled":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "status must be one of requested, received, closed or cancelled")
+	}
+
+	returns, err := h.service.ListReturns(ctx, payload)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ListReturnsResponse{
+		Returns: returns,
+	}, nil
+}
+
+func (h *ordersGRPCHandler) ReceiveReturn(ctx context.Context, payload *pb.ReturnIdRequest) (*pb.Return, error) {
+	ret, err := h.service.ReceiveReturn(ctx, payload.Id)
+
+	if err != nil {
+		return nil, returnsStatusError(err)
+	}
+
+	return ret, nil
+}
+
+func (h *ordersGRPCHandler) DispositionReturn(ctx context.Context, payload *pb.DispositionReturnRequest) (*pb.Return, error) {
+	if len(payload.Lines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one line is required")
+	}
+
+	for _, line := range payload.Lines {
+		switch line.Disposition {
+		case "restock":
+		case "scrap":
+			if line.LocationId > 0 {
+				return nil, status.Error(codes.InvalidArgument, "scrapped lines do not take a location")
+			}
+		default:
+			return nil, status.Error(codes.InvalidArgument, "disposition must be restock or scrap")
+		}
+	}
+
+	ret, err := h.service.DispositionReturn(ctx, payload)
+
+	if err != nil {
+		return nil, returnsStatusError(err)
+	}
+
+	return ret, nil
+}
+
+func (h *ordersGRPCHandler) CancelReturn(ctx context.Context, payload *pb.ReturnIdRequest) (*pb.Return, error) {
+	ret, err := h.service.CancelReturn(ctx, payload.Id)
+
+	if err != nil {
+		return nil, returnsStatusError(err)
+	}
+
+	return ret, nil
+}
+
+func (h *ordersGRPCHandler) GetOrderStatusHistory(ctx context.Context, payload *pb.OrderIdRequest) (*pb.OrderStatusHistory, error) {
+	history, err := h.service.GetOrderStatusHistory(ctx, payload.Id)
+
+	if err != nil {
+		if errors.Is(err, errOrderNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return history, nil
+}
+
+func promotionsStatusError(err error) error {
+	switch {
+	case errors.Is(err, errPromotionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errInvalidPromotion):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (h *ordersGRPCHandler) CreatePromotion(ctx context.Context, payload *pb.Promotion) (*pb.Promotion, error) {
+	if payload.Id != 0 {
+		return nil, status.Error(codes.InvalidArgument, "id must not be set when creating a promotion")
+	}
+
+	promotion, err := h.service.SavePromotion(ctx, payload)
+
+	if err != nil {
+		return nil, promotionsStatusError(err)
+	}
+
+	return promotion, nil
+}
+
+func (h *ordersGRPCHandler) UpdatePromotion(ctx context.Context, payload *pb.Promotion) (*pb.Promotion, error) {
+	if payload.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	promotion, err := h.service.SavePromotion(ctx, payload)
+
+	if err != nil {
+		return nil, promotionsStatusError(err)
+	}
+
+	return promotion, nil
+}
+
+func (h *ordersGRPCHandler) GetPromotion(ctx context.Context, payload *pb.PromotionIdRequest) (*pb.Promotion, error) {
+	promotion, err := h.service.GetPromotion(ctx, payload.Id)
+
+	if err != nil {
+		return nil, promotionsStatusError(err)
+	}
+
+	return promotion, nil
+}
+
+func (h *ordersGRPCHandler) ListPromotions(ctx context.Context, payload *pb.ListPromotionsRequest) (*pb.ListPromotionsResponse, error) {
+	promotions, err := h.service.ListPromotions(ctx, payload.ActiveOnly)
+
+	if err != nil {
+		return nil, promotionsStatusError(err)
+	}
+
+	return &pb.ListPromotionsResponse{Promotions: promotions}, nil
+}
+
+func (h *ordersGRPCHandler) DeletePromotion(ctx context.Context, payload *pb.PromotionIdRequest) (*pb.DeletePromotionResponse, error) {
+	if err := h.service.DeletePromotion(ctx, payload.Id); err != nil {
+		return nil, promotionsStatusError(err)
+	}
+
+	return &pb.DeletePromotionResponse{}, nil
+}
+
+func shipmentsStatusError(err error) error {
+	switch {
+	case errors.Is(err, errOrderNotFound), errors.Is(err, errShipmentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errShipmentState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errInvalidShipment):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// errors from the inventory service keep their code
+	if st, ok := status.FromError(err); ok {
+		return status.Error(st.Code(), err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (h *ordersGRPCHandler) CreateShipment(ctx context.Context, payload *pb.CreateShipmentRequest) (*pb.Shipment, error) {
+	if payload.Carrier == "" {
+		return nil, status.Error(codes.InvalidArgument, "carrier is required")
+	}
+
+	if payload.TrackingNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "tracking number is required")
+	}
+
+	if len(payload.Lines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one line is required")
+	}
+
+	shipment, err := h.service.CreateShipment(ctx, payload)
+
+	if err != nil {
+		return nil, shipmentsStatusError(err)
+	}
+
+	return shipment, nil
+}
+
+func (h *ordersGRPCHandler) GetShipment(ctx context.Context, payload *pb.ShipmentIdRequest) (*pb.Shipment, error) {
+	shipment, err := h.service.GetShipment(ctx, payload.Id)
+
+	if err != nil {
+		return nil, shipmentsStatusError(err)
+	}
+
+	return shipment, nil
+}
+
+func (h *ordersGRPCHandler) ListShipments(ctx context.Context, payload *pb.OrderIdRequest) (*pb.ListShipmentsResponse, error) {
+	shipments, err := h.service.ListShipments(ctx, payload.Id)
+
+	if err != nil {
+		return nil, shipmentsStatusError(err)
+	}
+
+	return &pb.ListShipmentsResponse{Shipments: shipments}, nil
+}
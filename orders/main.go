@@ -14,9 +14,10 @@ import (
 )
 
 var (
-	gRPCPort   = utils.GetEnv("GRPC_PORT", "50053")
-	gRPCHost   = utils.GetEnv("GRPC_HOST", "localhost")
-	consulAddr = utils.GetEnv("CONSUL_ADDR", "localhost:8500")
+	gRPCPort     = utils.GetEnv("GRPC_PORT", "50053")
+	gRPCHost     = utils.GetEnv("GRPC_HOST", "localhost")
+	consulAddr   = utils.GetEnv("CONSUL_ADDR", "localhost:8500")
+	orderTaxRate = utils.GetEnv("ORDER_TAX_RATE", "0")
 
 	Logger = logger.NewLogger("orders-service")
 )
@@ -40,13 +41,34 @@ func main() {
 
 	Logger.Log("store init", "initialized successfully")
 
-	service := NewOrdersService(store)
-
 	consulCient, err := consul.NewClient(consulAddr)
 	if err != nil {
 		Logger.FatalLog("consul init", "failed to create client: %v", err)
 	}
 
+	inventoryClientConn, err := grpcservice.GetGRPCConnection(consulCient, "inventory-grpc-service")
+	if err != nil {
+		Logger.FatalLog("get inventory client connection", "failed to get gRPC connection: %v", err)
+	}
+	defer inventoryClientConn.Close()
+
+	inventoryClient := pb.NewInventoryServiceClient(inventoryClientConn)
+
+	productsClientConn, err := grpcservice.GetGRPCConnection(consulCient, "products-grpc-service")
+	if err != nil {
+		Logger.FatalLog("get products client connection", "failed to get gRPC connection: %v", err)
+	}
+	defer productsClientConn.Close()
+
+	productsClient := pb.NewProductsServiceClient(productsClientConn)
+
+	_taxRate, err := parsePercentage(orderTaxRate)
+	if err != nil {
+		Logger.FatalLog("service init", "invalid order tax rate: %v", err)
+	}
+
+	service := NewOrdersService(store, inventoryClient, productsClient, _taxRate)
+
 	_gRPCPort, _ := strconv.Atoi(gRPCPort)
 
 	gRPCServiceServer, err := grpcservice.NewServer(consulCient, "orders-grpc-service", gRPCHost, _gRPCPort)
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var errInvalidAmount = errors.New("invalid amount")
+
+// money is an exact amount in cents, order prices and totals never go through
+// floating point once they are captured
+type money int64
+
+// product prices are DECIMAL(10, 2) and arrive as doubles, rounding to the
+// nearest cent recovers the stored value exactly
+func moneyFromPrice(price float64) money {
+	return money(math.Round(price * 100))
+}
+
+// parses a non negative decimal amount with at most two places such as
+// "12", "12.5" or "12.50"
+func parseMoney(s string) (money, error) {
+	cents, err := parseFixed(s, 2)
+	if err != nil {
+		return 0, fmt.Errorf("%q: %w", s, errInvalidAmount)
+	}
+	return money(cents), nil
+}
+
+func (m money) String() string {
+	sign := ""
+	if m < 0 {
+		sign = "-"
+		m = -m
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, m/100, m%100)
+}
+
+// percentage is in ten-thousandths of a percent, 8.25% is 82500. Used for
+// tax rates and percentage discounts.
+type percentage int64
+
+const percentageScale = 10000
+
+func parsePercentage(s string) (percentage, error) {
+	p, err := parseFixed(s, 4)
+	if err != nil || p > 100*percentageScale {
+		return 0, fmt.Errorf("%q must be a percentage between 0 and 100 with at most four places: %w", s, errInvalidAmount)
+	}
+	return percentage(p), nil
+}
+
+func (p percentage) String() string {
+	return fmt.Sprintf("%d.%04d", p/percentageScale, p%percentageScale)
+}
+
+// share of a non negative amount, rounded half up to the cent. The product is
+// worked out in big integers since cents times percentage can overflow int64.
+func (p percentage) of(m money) money {
+	const denominator = 100 * percentageScale
+	share := new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(int64(p)))
+	share.Add(share, big.NewInt(denominator/2))
+	share.Quo(share, big.NewInt(denominator))
+	return money(share.Int64())
+}
+
+// parses a non negative decimal string into an integer scaled by 10^places
+func parseFixed(s string, places int) (int64, error) {
+	whole, frac, _ := strings.Cut(strings.TrimSpace(s), ".")
+	if whole == "" || len(frac) > places || strings.HasPrefix(whole, "+") || strings.HasPrefix(whole, "-") {
+		return 0, errInvalidAmount
+	}
+
+	w, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	frac += strings.Repeat("0", places-len(frac))
+	f, err := strconv.ParseInt(frac, 10, 64)
+	if err != nil || strings.HasPrefix(frac, "+") || strings.HasPrefix(frac, "-") {
+		return 0, errInvalidAmount
+	}
+
+	scale := int64(math.Pow10(places))
+	if w > math.MaxInt64/scale-1 {
+		return 0, errInvalidAmount
+	}
+	return w*scale + f, nil
+}
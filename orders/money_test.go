@@ -0,0 +1,132 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    money
+		wantErr bool
+	}{
+		{in: "12", want: 1200},
+		{in: "12.5", want: 1250},
+		{in: "12.50", want: 1250},
+		{in: " 0.01 ", want: 1},
+		{in: "0", want: 0},
+		{in: "", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "-1", wantErr: true},
+		{in: "+1", wantErr: true},
+		{in: ".5", wantErr: true},
+		{in: "1.-5", wantErr: true},
+		{in: "1.234", wantErr: true},
+		{in: "99999999999999999999", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseMoney(tt.in)
+		if tt.wantErr {
+			if !errors.Is(err, errInvalidAmount) {
+				t.Errorf("parseMoney(%q) error = %v, want errInvalidAmount", tt.in, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("parseMoney(%q) = %d, %v, want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		in   money
+		want string
+	}{
+		{0, "0.00"},
+		{5, "0.05"},
+		{1234, "12.34"},
+		{-1234, "-12.34"},
+		{-5, "-0.05"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("money(%d).String() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyFromPrice(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want money
+	}{
+		{19.99, 1999},
+		{0.29, 29},
+		{0.1 + 0.2, 30},
+		{0, 0},
+	}
+
+	for _, tt := range tests {
+		if got := moneyFromPrice(tt.in); got != tt.want {
+			t.Errorf("moneyFromPrice(%v) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParsePercentage(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    percentage
+		wantErr bool
+	}{
+		{in: "0", want: 0},
+		{in: "8.25", want: 82500},
+		{in: "8.2575", want: 82575},
+		{in: "100", want: 100 * percentageScale},
+		{in: "100.0001", wantErr: true},
+		{in: "8.12345", wantErr: true},
+		{in: "-5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parsePercentage(tt.in)
+		if tt.wantErr {
+			if !errors.Is(err, errInvalidAmount) {
+				t.Errorf("parsePercentage(%q) error = %v, want errInvalidAmount", tt.in, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("parsePercentage(%q) = %d, %v, want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestPercentageOf(t *testing.T) {
+	tests := []struct {
+		name string
+		p    percentage
+		m    money
+		want money
+	}{
+		{"zero percent", 0, 1999, 0},
+		{"hundred percent", 100 * percentageScale, 1999, 1999},
+		{"exact share", 10 * percentageScale, 1000, 100},
+		{"half a cent rounds up", 82500, 1000, 83},
+		{"below half a cent rounds down", 82500, 999, 82},
+		{"half of one cent", 50 * percentageScale, 1, 1},
+		{"nothing to take a share of", 82500, 0, 0},
+		{"does not overflow", 100 * percentageScale, math.MaxInt64, math.MaxInt64},
+	}
+
+	for _, tt := range tests {
+		if got := tt.p.of(tt.m); got != tt.want {
+			t.Errorf("%s: %s%% of %s = %s, want %s", tt.name, tt.p, tt.m, got, tt.want)
+		}
+	}
+}
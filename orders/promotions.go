@@ -0,0 +1,294 @@
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/logan2k02/ims/shared/protobuf"
+)
+
+var (
+	errPromotionNotFound      = errors.New("promotion not found")
+	errInvalidPromotion       = errors.New("invalid promotion")
+	errPromotionNotApplicable = errors.New("promotion cannot be applied")
+)
+
+var couponCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{1,50}$`)
+
+type PromotionDto struct {
+	Id            int64
+	Code          string
+	Name          string
+	Type          string
+	Percentage    percentage
+	Amount        money
+	ProductId     int64
+	BuyQuantity   int64
+	GetQuantity   int64
+	MinQuantity   int64
+	MinOrderTotal money
+	UsageLimit    int64
+	UsageCount    int64
+	StartsAt      *time.Time
+	EndsAt        *time.Time
+	Active        bool
+}
+
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// checks a promotion as given to the API or read back from the store and
+// converts its amounts to exact values
+func parsePromotion(p *pb.Promotion) (*PromotionDto, error) {
+	promotion := &PromotionDto{
+		Id:          p.Id,
+		Code:        normalizeCouponCode(p.Code),
+		Name:        strings.TrimSpace(p.Name),
+		Type:        p.Type,
+		ProductId:   p.ProductId,
+		MinQuantity: p.MinQuantity,
+		UsageLimit:  p.UsageLimit,
+		UsageCount:  p.UsageCount,
+		Active:      p.Active,
+	}
+
+	if promotion.Name == "" {
+		return nil, fmt.Errorf("name is required: %w", errInvalidPromotion)
+	}
+
+	if promotion.Code != "" && !couponCodePattern.MatchString(promotion.Code) {
+		return nil, fmt.Errorf("code must be up to 50 letters, digits, dashes or underscores: %w", errInvalidPromotion)
+	}
+
+	if p.ProductId < 0 || p.MinQuantity < 0 || p.UsageLimit < 0 {
+		return nil, fmt.Errorf("product, minimum quantity and usage limit cannot be negative: %w", errInvalidPromotion)
+	}
+
+	var err error
+	switch p.Type {
+	case "percentage":
+		if promotion.Percentage, err = parsePercentage(p.Percentage); err != nil || promotion.Percentage == 0 {
+			return nil, fmt.Errorf("percentage must be above 0 and at most 100: %w", errInvalidPromotion)
+		}
+	case "fixed":
+		if promotion.Amount, err = parseMoney(p.Amount); err != nil || promotion.Amount == 0 {
+			return nil, fmt.Errorf("amount must be above 0: %w", errInvalidPromotion)
+		}
+	case "buy_x_get_y":
+		if p.ProductId == 0 {
+			return nil, fmt.Errorf("buy x get y promotions need a product: %w", errInvalidPromotion)
+		}
+		if p.BuyQuantity <= 0 || p.GetQuantity <= 0 {
+			return nil, fmt.Errorf("buy and get quantities must be above 0: %w", errInvalidPromotion)
+		}
+		promotion.BuyQuantity, promotion.GetQuantity = p.BuyQuantity, p.GetQuantity
+	default:
+		return nil, fmt.Errorf("type must be percentage, fixed or buy_x_get_y: %w", errInvalidPromotion)
+	}
+
+	if p.MinOrderTotal != "" {
+		if promotion.MinOrderTotal, err = parseMoney(p.MinOrderTotal); err != nil {
+			return nil, fmt.Errorf("minimum order total: %w: %w", err, errInvalidPromotion)
+		}
+	}
+
+	if promotion.StartsAt, err = parsePromotionTime(p.StartsAt); err != nil {
+		return nil, fmt.Errorf("starts at must be an RFC 3339 timestamp: %w", errInvalidPromotion)
+	}
+	if promotion.EndsAt, err = parsePromotionTime(p.EndsAt); err != nil {
+		return nil, fmt.Errorf("ends at must be an RFC 3339 timestamp: %w", errInvalidPromotion)
+	}
+	if promotion.StartsAt != nil && promotion.EndsAt != nil && !promotion.EndsAt.After(*promotion.StartsAt) {
+		return nil, fmt.Errorf("ends at must be after starts at: %w", errInvalidPromotion)
+	}
+
+	return promotion, nil
+}
+
+func parsePromotionTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	t = t.UTC()
+	return &t, nil
+}
+
+// an order line as the discount engine sees it, Discount is what is already
+// taken off the line and grows as promotions apply
+type discountLine struct {
+	ProductId    int64
+	BaseQuantity int64
+	BasePrice    money
+	Subtotal     money
+	Discount     money
+}
+
+func (l *discountLine) remaining() money {
+	return l.Subtotal - l.Discount
+}
+
+// one promotion's discount on one order line
+type AppliedDiscountDto struct {
+	PromotionId int64
+	Code        string
+	Name        string
+	Line        int
+	Amount      money
+}
+
+// applies the automatic promotions the order qualifies for and every coupon
+// entered. Product promotions go first so order wide ones discount what is
+// left, a line is never discounted below zero. A coupon that does not apply
+// fails the order, an automatic promotion that does not apply is skipped.
+func applyPromotions(lines []discountLine, promotions []*PromotionDto, coupons []string) ([]AppliedDiscountDto, error) {
+	entered := make(map[string]bool)
+	for _, code := range coupons {
+		entered[code] = true
+	}
+
+	var candidates []*PromotionDto
+	for _, promotion := range promotions {
+		if promotion.Code == "" || entered[promotion.Code] {
+			candidates = append(candidates, promotion)
+			delete(entered, promotion.Code)
+		}
+	}
+
+	for _, code := range coupons {
+		if entered[code] {
+			return nil, fmt.Errorf("coupon %s is not valid: %w", code, errPromotionNotApplicable)
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b *PromotionDto) int {
+		if (a.ProductId == 0) != (b.ProductId == 0) {
+			if a.ProductId != 0 {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	var orderSubtotal money
+	for _, line := range lines {
+		orderSubtotal += line.Subtotal
+	}
+
+	var applied []AppliedDiscountDto
+	for _, promotion := range candidates {
+		var eligible []int
+		var quantity int64
+		for i, line := range lines {
+			if promotion.ProductId == 0 || line.ProductId == promotion.ProductId {
+				eligible = append(eligible, i)
+				quantity += line.BaseQuantity
+			}
+		}
+
+		var reason string
+		switch {
+		case promotion.UsageLimit > 0 && promotion.UsageCount >= promotion.UsageLimit:
+			reason = "has reached its usage limit"
+		case len(eligible) == 0:
+			reason = "does not apply to any item on the order"
+		case quantity < promotion.MinQuantity:
+			reason = fmt.Sprintf("needs at least %d units", promotion.MinQuantity)
+		case orderSubtotal < promotion.MinOrderTotal:
+			reason = fmt.Sprintf("needs an order subtotal of at least %s", promotion.MinOrderTotal)
+		}
+
+		if reason != "" {
+			if promotion.Code != "" {
+				return nil, fmt.Errorf("coupon %s %s: %w", promotion.Code, reason, errPromotionNotApplicable)
+			}
+			continue
+		}
+
+		caps := make([]money, len(eligible))
+		for i, line := range eligible {
+			caps[i] = lines[line].remaining()
+		}
+
+		var amounts []money
+		switch promotion.Type {
+		case "percentage":
+			amounts = make([]money, len(eligible))
+			for i := range eligible {
+				amounts[i] = promotion.Percentage.of(caps[i])
+			}
+		case "fixed":
+			amounts = allocateProportionally(promotion.Amount, caps)
+		case "buy_x_get_y":
+			free := quantity / (promotion.BuyQuantity + promotion.GetQuantity) * promotion.GetQuantity
+			amounts = allocateInOrder(money(free)*lines[eligible[0]].BasePrice, caps)
+		}
+
+		for i, line := range eligible {
+			if amounts[i] == 0 {
+				continue
+			}
+			lines[line].Discount += amounts[i]
+			applied = append(applied, AppliedDiscountDto{
+				PromotionId: promotion.Id,
+				Code:        promotion.Code,
+				Name:        promotion.Name,
+				Line:        line,
+				Amount:      amounts[i],
+			})
+		}
+	}
+
+	return applied, nil
+}
+
+// spreads amount over lines in proportion to what each can still take, the
+// cents left over by rounding down go to the first lines with room
+func allocateProportionally(amount money, caps []money) []money {
+	var total money
+	for _, c := range caps {
+		total += c
+	}
+
+	amounts := make([]money, len(caps))
+	if total == 0 {
+		return amounts
+	}
+	amount = min(amount, total)
+
+	var allocated money
+	for i, c := range caps {
+		share := new(big.Int).Mul(big.NewInt(int64(amount)), big.NewInt(int64(c)))
+		amounts[i] = money(share.Quo(share, big.NewInt(int64(total))).Int64())
+		allocated += amounts[i]
+	}
+
+	for i := 0; allocated < amount; i++ {
+		if amounts[i] < caps[i] {
+			amounts[i]++
+			allocated++
+		}
+	}
+	return amounts
+}
+
+// fills lines one after another up to what each can still take
+func allocateInOrder(amount money, caps []money) []money {
+	amounts := make([]money, len(caps))
+	for i, c := range caps {
+		amounts[i] = min(amount, c)
+		amount -= amounts[i]
+	}
+	return amounts
+}
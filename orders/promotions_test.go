@@ -0,0 +1,271 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	pb "github.com/logan2k02/ims/shared/protobuf"
+)
+
+func TestParsePromotion(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *pb.Promotion
+		wantErr bool
+	}{
+		{name: "percentage", in: &pb.Promotion{Name: "Sale", Type: "percentage", Percentage: "12.5"}},
+		{name: "full discount", in: &pb.Promotion{Name: "Free", Type: "percentage", Percentage: "100"}},
+		{name: "zero percent", in: &pb.Promotion{Name: "Nothing", Type: "percentage", Percentage: "0"}, wantErr: true},
+		{name: "over a hundred percent", in: &pb.Promotion{Name: "Too much", Type: "percentage", Percentage: "100.01"}, wantErr: true},
+		{name: "fixed", in: &pb.Promotion{Name: "Five off", Type: "fixed", Amount: "5"}},
+		{name: "zero fixed amount", in: &pb.Promotion{Name: "Nothing", Type: "fixed", Amount: "0.00"}, wantErr: true},
+		{name: "buy x get y", in: &pb.Promotion{Name: "B2G1", Type: "buy_x_get_y", ProductId: 1, BuyQuantity: 2, GetQuantity: 1}},
+		{name: "buy x get y without a product", in: &pb.Promotion{Name: "B2G1", Type: "buy_x_get_y", BuyQuantity: 2, GetQuantity: 1}, wantErr: true},
+		{name: "buy x get y without quantities", in: &pb.Promotion{Name: "B2G1", Type: "buy_x_get_y", ProductId: 1}, wantErr: true},
+		{name: "unknown type", in: &pb.Promotion{Name: "Sale", Type: "bogus"}, wantErr: true},
+		{name: "missing name", in: &pb.Promotion{Type: "fixed", Amount: "5"}, wantErr: true},
+		{name: "lower case code", in: &pb.Promotion{Name: "Sale", Code: " save10 ", Type: "fixed", Amount: "5"}},
+		{name: "code with spaces", in: &pb.Promotion{Name: "Sale", Code: "SAVE 10", Type: "fixed", Amount: "5"}, wantErr: true},
+		{name: "negative usage limit", in: &pb.Promotion{Name: "Sale", Type: "fixed", Amount: "5", UsageLimit: -1}, wantErr: true},
+		{name: "bad minimum order total", in: &pb.Promotion{Name: "Sale", Type: "fixed", Amount: "5", MinOrderTotal: "-1"}, wantErr: true},
+		{
+			name: "window",
+			in:   &pb.Promotion{Name: "Sale", Type: "fixed", Amount: "5", StartsAt: "2024-01-01T00:00:00Z", EndsAt: "2024-02-01T00:00:00Z"},
+		},
+		{
+			name:    "window ending before it starts",
+			in:      &pb.Promotion{Name: "Sale", Type: "fixed", Amount: "5", StartsAt: "2024-02-01T00:00:00Z", EndsAt: "2024-01-01T00:00:00Z"},
+			wantErr: true,
+		},
+		{name: "bad timestamp", in: &pb.Promotion{Name: "Sale", Type: "fixed", Amount: "5", StartsAt: "yesterday"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		_, err := parsePromotion(tt.in)
+		if tt.wantErr != errors.Is(err, errInvalidPromotion) || !tt.wantErr && err != nil {
+			t.Errorf("%s: parsePromotion() error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestApplyPromotions(t *testing.T) {
+	tests := []struct {
+		name          string
+		lines         []discountLine
+		promotions    []*PromotionDto
+		coupons       []string
+		want          []AppliedDiscountDto
+		wantDiscounts []money
+		wantErr       error
+	}{
+		{
+			name:          "percentage on every line",
+			lines:         []discountLine{{ProductId: 1, Subtotal: 1000}, {ProductId: 2, Subtotal: 1000}},
+			promotions:    []*PromotionDto{{Id: 1, Name: "Sale", Type: "percentage", Percentage: 10 * percentageScale}},
+			want:          []AppliedDiscountDto{{PromotionId: 1, Name: "Sale", Line: 0, Amount: 100}, {PromotionId: 1, Name: "Sale", Line: 1, Amount: 100}},
+			wantDiscounts: []money{100, 100},
+		},
+		{
+			name:          "zero percent takes nothing off",
+			lines:         []discountLine{{ProductId: 1, Subtotal: 1000}},
+			promotions:    []*PromotionDto{{Id: 1, Name: "Nothing", Type: "percentage", Percentage: 0}},
+			wantDiscounts: []money{0},
+		},
+		{
+			name:  "hundred percent leaves nothing for order wide promotions",
+			lines: []discountLine{{ProductId: 1, Subtotal: 1000}, {ProductId: 2, Subtotal: 2000}},
+			promotions: []*PromotionDto{
+				{Id: 1, Name: "Five off", Type: "fixed", Amount: 500},
+				{Id: 2, Name: "Free", Type: "percentage", Percentage: 100 * percentageScale, ProductId: 1},
+			},
+			want:          []AppliedDiscountDto{{PromotionId: 2, Name: "Free", Line: 0, Amount: 1000}, {PromotionId: 1, Name: "Five off", Line: 1, Amount: 500}},
+			wantDiscounts: []money{1000, 500},
+		},
+		{
+			name:       "fixed amount remainder leaves the last line its rounded down share",
+			lines:      []discountLine{{ProductId: 1, Subtotal: 1000}, {ProductId: 2, Subtotal: 1000}, {ProductId: 3, Subtotal: 1000}},
+			promotions: []*PromotionDto{{Id: 1, Name: "One off", Type: "fixed", Amount: 100}},
+			want: []AppliedDiscountDto{
+				{PromotionId: 1, Name: "One off", Line: 0, Amount: 34},
+				{PromotionId: 1, Name: "One off", Line: 1, Amount: 33},
+				{PromotionId: 1, Name: "One off", Line: 2, Amount: 33},
+			},
+			wantDiscounts: []money{34, 33, 33},
+		},
+		{
+			name:          "fixed amount larger than the order",
+			lines:         []discountLine{{ProductId: 1, Subtotal: 300}, {ProductId: 2, Subtotal: 200}},
+			promotions:    []*PromotionDto{{Id: 1, Name: "Big", Type: "fixed", Amount: 1000}},
+			want:          []AppliedDiscountDto{{PromotionId: 1, Name: "Big", Line: 0, Amount: 300}, {PromotionId: 1, Name: "Big", Line: 1, Amount: 200}},
+			wantDiscounts: []money{300, 200},
+		},
+		{
+			name:  "coupon stacks on what a product promotion left",
+			lines: []discountLine{{ProductId: 1, Subtotal: 1000}, {ProductId: 2, Subtotal: 3000}},
+			promotions: []*PromotionDto{
+				{Id: 1, Code: "SAVE10", Name: "Ten off", Type: "fixed", Amount: 1000},
+				{Id: 2, Name: "Sale", Type: "percentage", Percentage: 10 * percentageScale, ProductId: 2},
+			},
+			coupons: []string{"SAVE10"},
+			want: []AppliedDiscountDto{
+				{PromotionId: 2, Name: "Sale", Line: 1, Amount: 300},
+				{PromotionId: 1, Code: "SAVE10", Name: "Ten off", Line: 0, Amount: 271},
+				{PromotionId: 1, Code: "SAVE10", Name: "Ten off", Line: 1, Amount: 729},
+			},
+			wantDiscounts: []money{271, 1029},
+		},
+		{
+			name:          "buy two get one",
+			lines:         []discountLine{{ProductId: 1, BaseQuantity: 5, BasePrice: 200, Subtotal: 1000}},
+			promotions:    []*PromotionDto{{Id: 1, Name: "B2G1", Type: "buy_x_get_y", ProductId: 1, BuyQuantity: 2, GetQuantity: 1}},
+			want:          []AppliedDiscountDto{{PromotionId: 1, Name: "B2G1", Line: 0, Amount: 200}},
+			wantDiscounts: []money{200},
+		},
+		{
+			name: "buy two get one across lines",
+			lines: []discountLine{
+				{ProductId: 1, BaseQuantity: 2, BasePrice: 200, Subtotal: 400},
+				{ProductId: 2, BaseQuantity: 1, BasePrice: 500, Subtotal: 500},
+				{ProductId: 1, BaseQuantity: 1, BasePrice: 200, Subtotal: 200},
+			},
+			promotions:    []*PromotionDto{{Id: 1, Name: "B2G1", Type: "buy_x_get_y", ProductId: 1, BuyQuantity: 2, GetQuantity: 1}},
+			want:          []AppliedDiscountDto{{PromotionId: 1, Name: "B2G1", Line: 0, Amount: 200}},
+			wantDiscounts: []money{200, 0, 0},
+		},
+		{
+			name:          "automatic promotion at its usage limit is skipped",
+			lines:         []discountLine{{ProductId: 1, Subtotal: 1000}},
+			promotions:    []*PromotionDto{{Id: 1, Name: "Sale", Type: "percentage", Percentage: 10 * percentageScale, UsageLimit: 5, UsageCount: 5}},
+			wantDiscounts: []money{0},
+		},
+		{
+			name:          "promotion below its usage limit applies",
+			lines:         []discountLine{{ProductId: 1, Subtotal: 1000}},
+			promotions:    []*PromotionDto{{Id: 1, Name: "Sale", Type: "percentage", Percentage: 10 * percentageScale, UsageLimit: 5, UsageCount: 4}},
+			want:          []AppliedDiscountDto{{PromotionId: 1, Name: "Sale", Line: 0, Amount: 100}},
+			wantDiscounts: []money{100},
+		},
+		{
+			name:       "coupon at its usage limit fails the order",
+			lines:      []discountLine{{ProductId: 1, Subtotal: 1000}},
+			promotions: []*PromotionDto{{Id: 1, Code: "SAVE10", Name: "Ten off", Type: "fixed", Amount: 1000, UsageLimit: 5, UsageCount: 5}},
+			coupons:    []string{"SAVE10"},
+			wantErr:    errPromotionNotApplicable,
+		},
+		{
+			name:    "unknown coupon",
+			lines:   []discountLine{{ProductId: 1, Subtotal: 1000}},
+			coupons: []string{"NOPE"},
+			wantErr: errPromotionNotApplicable,
+		},
+		{
+			name:          "coupon not entered is ignored",
+			lines:         []discountLine{{ProductId: 1, Subtotal: 1000}},
+			promotions:    []*PromotionDto{{Id: 1, Code: "SAVE10", Name: "Ten off", Type: "fixed", Amount: 1000}},
+			wantDiscounts: []money{0},
+		},
+		{
+			name:          "automatic promotion below its minimum order total is skipped",
+			lines:         []discountLine{{ProductId: 1, Subtotal: 1000}},
+			promotions:    []*PromotionDto{{Id: 1, Name: "Sale", Type: "fixed", Amount: 100, MinOrderTotal: 1001}},
+			wantDiscounts: []money{0},
+		},
+		{
+			name:       "coupon below its minimum quantity fails the order",
+			lines:      []discountLine{{ProductId: 1, BaseQuantity: 2, Subtotal: 1000}},
+			promotions: []*PromotionDto{{Id: 1, Code: "BULK", Name: "Bulk", Type: "fixed", Amount: 100, MinQuantity: 3}},
+			coupons:    []string{"BULK"},
+			wantErr:    errPromotionNotApplicable,
+		},
+		{
+			name:       "coupon for a product not on the order fails the order",
+			lines:      []discountLine{{ProductId: 1, Subtotal: 1000}},
+			promotions: []*PromotionDto{{Id: 1, Code: "SHOES", Name: "Shoes", Type: "fixed", Amount: 100, ProductId: 2}},
+			coupons:    []string{"SHOES"},
+			wantErr:    errPromotionNotApplicable,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := applyPromotions(tt.lines, tt.promotions, tt.coupons)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%s: applyPromotions() error = %v, want %v", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: applyPromotions() error = %v", tt.name, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: applyPromotions() = %+v, want %+v", tt.name, got, tt.want)
+		}
+		for i, line := range tt.lines {
+			if line.Discount != tt.wantDiscounts[i] {
+				t.Errorf("%s: line %d discount = %s, want %s", tt.name, i, line.Discount, tt.wantDiscounts[i])
+			}
+		}
+	}
+}
+
+func TestAllocateProportionally(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount money
+		caps   []money
+		want   []money
+	}{
+		{"even split", 300, []money{100, 100, 100}, []money{100, 100, 100}},
+		{"one cent over goes to the first line", 100, []money{100, 100, 100}, []money{34, 33, 33}},
+		{"two cents over skip the last line", 200, []money{100, 100, 100}, []money{67, 67, 66}},
+		{"remainder passes over lines with no room", 1, []money{0, 1, 1}, []money{0, 1, 0}},
+		{"remainder on the last line with room", 1, []money{0, 0, 1}, []money{0, 0, 1}},
+		{"in proportion", 300, []money{100, 200}, []money{100, 200}},
+		{"capped at what the lines can take", 500, []money{100, 200}, []money{100, 200}},
+		{"nothing to discount", 100, []money{0, 0}, []money{0, 0}},
+		{"no lines", 100, nil, []money{}},
+		{"does not overflow", math.MaxInt64 / 2, []money{math.MaxInt64 / 4, math.MaxInt64 / 4}, []money{math.MaxInt64 / 4, math.MaxInt64 / 4}},
+	}
+
+	for _, tt := range tests {
+		got := allocateProportionally(tt.amount, tt.caps)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: allocateProportionally(%s, %v) = %v, want %v", tt.name, tt.amount, tt.caps, got, tt.want)
+		}
+
+		var total, allocated money
+		for i := range tt.caps {
+			total += tt.caps[i]
+			allocated += got[i]
+			if got[i] > tt.caps[i] {
+				t.Errorf("%s: line %d got %s over its cap %s", tt.name, i, got[i], tt.caps[i])
+			}
+		}
+		if allocated != min(tt.amount, total) {
+			t.Errorf("%s: allocated %s, want %s", tt.name, allocated, min(tt.amount, total))
+		}
+	}
+}
+
+func TestAllocateInOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount money
+		caps   []money
+		want   []money
+	}{
+		{"first line takes it all", 50, []money{100, 100}, []money{50, 0}},
+		{"spills to the next line", 150, []money{100, 100}, []money{100, 50}},
+		{"capped at what the lines can take", 500, []money{100, 100}, []money{100, 100}},
+		{"skips lines with no room", 50, []money{0, 100}, []money{0, 50}},
+	}
+
+	for _, tt := range tests {
+		if got := allocateInOrder(tt.amount, tt.caps); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: allocateInOrder(%s, %v) = %v, want %v", tt.name, tt.amount, tt.caps, got, tt.want)
+		}
+	}
+}
@@ -2,26 +2,326 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 
 	pb "github.com/logan2k02/ims/shared/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ordersService struct {
-	store *ordersStore
+	store           *ordersStore
+	inventoryClient pb.InventoryServiceClient
+	productsClient  pb.ProductsServiceClient
+	taxRate         percentage
 }
 
-func NewOrdersService(store *ordersStore) *ordersService {
+func NewOrdersService(store *ordersStore, inventoryClient pb.InventoryServiceClient, productsClient pb.ProductsServiceClient, taxRate percentage) *ordersService {
 	return &ordersService{
-		store: store,
+		store:           store,
+		inventoryClient: inventoryClient,
+		productsClient:  productsClient,
+		taxRate:         taxRate,
 	}
 }
 
+var (
+	errOrderNotFound    = errors.New("order not found")
+	errInvalidOrderItem = errors.New("invalid order item")
+	errOrderTransition  = errors.New("order status transition not allowed")
+)
+
+// statuses an order can be moved to from each status by ChangeOrderStatus.
+// partially_shipped is only reached by creating shipments, which also move a
+// partially shipped order on to shipped once its last line is sent, and
+// partially_returned and returned by receiving returns. A shipped order is
+// with the customer, so it is not cancelled but returned.
+var orderTransitions = map[string][]string{
+	"pending":            {"confirmed", "cancelled"},
+	"confirmed":          {"picking", "cancelled"},
+	"picking":            {"shipped", "cancelled"},
+	"partially_shipped":  {"cancelled"},
+	"shipped":            {"delivered"},
+	"delivered":          {},
+	"partially_returned": {},
+	"returned":           {},
+	"cancelled":          {},
+}
+
+// stock stays reserved until the order ships, a partially shipped order still
+// holds what it has not sent yet
+func holdsReservations(orderStatus string) bool {
+	return orderStatus == "pending" || orderStatus == "confirmed" || orderStatus == "picking" || orderStatus == "partially_shipped"
+}
+
+type lineStockError struct {
+	Line      int
+	ProductId int64
+	Quantity  int64
+	Reason    string
+}
+
+func (e *lineStockError) Error() string {
+	return fmt.Sprintf("line %d (product %d, quantity %d): %s", e.Line, e.ProductId, e.Quantity, e.Reason)
+}
+
+func inventoryLineError(err error, line int, item *pb.OrderItem, action string) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.FailedPrecondition:
+		return &lineStockError{
+			Line:      line,
+			ProductId: item.ProductId,
+			Quantity:  item.Quantity,
+			Reason:    st.Message(),
+		}
+	case codes.InvalidArgument:
+		return fmt.Errorf("line %d (product %d): %s: %w", line, item.ProductId, st.Message(), errInvalidOrderItem)
+	}
+
+	return fmt.Errorf("failed to %s line %d (product %d): %s", action, line, item.ProductId, st.Message())
+}
+
+func orderReference(orderId int64) string {
+	return fmt.Sprintf("order-%d", orderId)
+}
+
+// base unit prices of the ordered products as they are right now, later
+// price changes do not touch the order
+func (s *ordersService) snapshotPrices(ctx context.Context, items []*pb.OrderItem) (map[int64]money, error) {
+	prices := make(map[int64]money)
+	for i, item := range items {
+		if _, ok := prices[item.ProductId]; ok {
+			continue
+		}
+
+		product, err := s.productsClient.GetProduct(ctx, &pb.ProductIdRequest{Id: item.ProductId})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, fmt.Errorf("line %d: product %d not found: %w", i+1, item.ProductId, errInvalidOrderItem)
+			}
+			return nil, fmt.Errorf("failed to price line %d (product %d): %s", i+1, item.ProductId, status.Convert(err).Message())
+		}
+
+		prices[item.ProductId] = moneyFromPrice(product.Price)
+	}
+	return prices, nil
+}
+
+// active promotions the order may use, every coupon entered must be one of them
+func (s *ordersService) loadPromotions(ctx context.Context, coupons []string) ([]*PromotionDto, error) {
+	records, err := s.store.ApplicablePromotions(ctx, coupons, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]bool)
+	promotions := make([]*PromotionDto, len(records))
+	for i, record := range records {
+		if promotions[i], err = parsePromotion(record); err != nil {
+			return nil, fmt.Errorf("stored promotion %d: %w", record.Id, err)
+		}
+		found[promotions[i].Code] = true
+	}
+
+	for _, code := range coupons {
+		if !found[code] {
+			return nil, fmt.Errorf("coupon %s is not valid: %w", code, errPromotionNotApplicable)
+		}
+	}
+	return promotions, nil
+}
+
+// lines are priced on the base units their reservations hold, so a line in a
+// larger sell unit costs its conversion factor times the base price per unit.
+// Discounts given on a line come off first, then promotions, and tax is
+// charged on what is left.
+func (s *ordersService) priceLines(items []*pb.OrderItem, reservations []*pb.StockReservation, prices map[int64]money, promotions []*PromotionDto, coupons []string) ([]LinePricingDto, []AppliedDiscountDto, error) {
+	discountLines := make([]discountLine, len(items))
+	for i, item := range items {
+		line := discountLine{
+			ProductId:    item.ProductId,
+			BaseQuantity: reservations[i].Quantity,
+			BasePrice:    prices[item.ProductId],
+		}
+		line.Subtotal = line.BasePrice * money(line.BaseQuantity)
+
+		if item.Discount != "" {
+			var err error
+			if line.Discount, err = parseMoney(item.Discount); err != nil {
+				return nil, nil, fmt.Errorf("line %d: discount %w: %w", i+1, err, errInvalidOrderItem)
+			}
+			if line.Discount > line.Subtotal {
+				return nil, nil, fmt.Errorf("line %d: discount %s exceeds the line subtotal %s: %w", i+1, line.Discount, line.Subtotal, errInvalidOrderItem)
+			}
+		}
+
+		discountLines[i] = line
+	}
+
+	applied, err := applyPromotions(discountLines, promotions, coupons)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lines := make([]LinePricingDto, len(items))
+	for i, line := range discountLines {
+		tax := s.taxRate.of(line.remaining())
+		lines[i] = LinePricingDto{
+			UnitPrice: line.Subtotal / money(items[i].Quantity),
+			Subtotal:  line.Subtotal,
+			Discount:  line.Discount,
+			TaxRate:   s.taxRate,
+			Tax:       tax,
+			Total:     line.remaining() + tax,
+		}
+	}
+	return lines, applied, nil
+}
+
 func (s *ordersService) CreateOrder(ctx context.Context, payload *pb.CreateOrderRequest) (*pb.Order, error) {
+	prices, err := s.snapshotPrices(ctx, payload.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	var coupons []string
+	for _, code := range payload.CouponCodes {
+		if code = normalizeCouponCode(code); code != "" && !slices.Contains(coupons, code) {
+			coupons = append(coupons, code)
+		}
+	}
+
+	promotions, err := s.loadPromotions(ctx, coupons)
+	if err != nil {
+		return nil, err
+	}
+
 	order, err := s.store.CreateOrder(ctx, payload)
 	if err != nil {
 		return nil, err
 	}
-	return order, nil
+
+	reference := orderReference(order.Id)
+
+	// lines may be placed in sell units, the inventory service converts them
+	// and the reservation holds the base units until the order ships or is
+	// cancelled
+	var reservations []*pb.StockReservation
+	var reservationIds []int64
+	for i, item := range payload.Items {
+		reservation, err := s.inventoryClient.ReserveStock(ctx, &pb.ReserveStockRequest{
+			ProductId: item.ProductId,
+			Quantity:  item.Quantity,
+			Reference: reference,
+			Unit:      item.Unit,
+			NoExpiry:  true,
+		})
+		if err != nil {
+			s.rollbackOrder(ctx, order.Id, reservationIds)
+			return nil, inventoryLineError(err, i+1, item, "reserve")
+		}
+
+		reservations = append(reservations, reservation)
+		reservationIds = append(reservationIds, reservation.Id)
+	}
+
+	if err := s.store.SetOrderItemReservations(ctx, order.Id, reservations); err != nil {
+		s.rollbackOrder(ctx, order.Id, reservationIds)
+		return nil, err
+	}
+
+	pricing, discounts, err := s.priceLines(payload.Items, reservations, prices, promotions, coupons)
+	if err != nil {
+		s.rollbackOrder(ctx, order.Id, reservationIds)
+		return nil, err
+	}
+
+	if err := s.store.SetOrderPricing(ctx, order.Id, pricing, discounts); err != nil {
+		s.rollbackOrder(ctx, order.Id, reservationIds)
+		return nil, err
+	}
+
+	return s.store.GetOrder(ctx, &pb.OrderIdRequest{Id: order.Id})
+}
+
+func (s *ordersService) rollbackOrder(ctx context.Context, orderId int64, reservationIds []int64) {
+	ctx = context.WithoutCancel(ctx)
+
+	for _, id := range reservationIds {
+		if _, err := s.inventoryClient.ReleaseReservation(ctx, &pb.ReservationIdRequest{Id: id}); err != nil {
+			Logger.LogError("create order", "failed to release reservation %d of order %d: %v", id, orderId, err)
+		}
+	}
+
+	if err := s.store.DeleteOrder(ctx, &pb.OrderIdRequest{Id: orderId}); err != nil {
+		Logger.LogError("create order", "failed to delete order %d: %v", orderId, err)
+	}
+}
+
+// items already sent in full on shipments have nothing left reserved, the
+// rest commit whatever their reservation still holds
+func (s *ordersService) commitReservations(ctx context.Context, order *pb.Order) ([]ItemCommitDto, error) {
+	var commits []ItemCommitDto
+	for i, item := range order.Items {
+		if item.ReservationId == 0 || item.ShippedQuantity >= item.Quantity {
+			continue
+		}
+
+		movement, err := s.inventoryClient.CommitReservation(ctx, &pb.CommitReservationRequest{Id: item.ReservationId})
+		if err != nil {
+			return commits, inventoryLineError(err, i+1, item, "commit")
+		}
+		commits = append(commits, ItemCommitDto{ItemId: item.Id, Movement: movement})
+	}
+	return commits, nil
+}
+
+// releases what the reservations still hold, units already taken out stay
+// out. Releasing twice is harmless, so a failed attempt can simply be repeated.
+func (s *ordersService) releaseReservations(ctx context.Context, order *pb.Order) error {
+	reserved, err := s.store.ReservedItems(ctx, order.Id)
+	if err != nil {
+		return err
+	}
+
+	for i, item := range order.Items {
+		if !reserved[item.Id] {
+			continue
+		}
+
+		if _, err := s.inventoryClient.ReleaseReservation(ctx, &pb.ReservationIdRequest{Id: item.ReservationId}); err != nil {
+			return inventoryLineError(err, i+1, item, "release")
+		}
+	}
+	return nil
+}
+
+// undoes movements posted for a change that could not be recorded, failures
+// are logged since the original error is what the caller gets back
+func (s *ordersService) reverseMovements(ctx context.Context, posted []*pb.StockMovement, reason string) {
+	// the request context may already be done, the reversals must still go out
+	ctx = context.WithoutCancel(ctx)
+	for _, movement := range posted {
+		if _, err := s.inventoryClient.ReverseStockMovement(ctx, &pb.ReverseStockMovementRequest{
+			Id:   movement.Id,
+			Note: reason,
+		}); err != nil {
+			Logger.LogError("reverse movements", "failed to reverse movement %d of %s: %v", movement.Id, movement.Reference, err)
+		}
+	}
+}
+
+func (s *ordersService) restoreSerialUnits(ctx context.Context, scrapped []*pb.ScrapSerialUnitsRequest) {
+	ctx = context.WithoutCancel(ctx)
+	for _, scrap := range scrapped {
+		if _, err := s.inventoryClient.RestoreSerialUnits(ctx, scrap); err != nil {
+			Logger.LogError("restore serial units", "failed to restore units of product %d scrapped by %s: %v", scrap.ProductId, scrap.Reference, err)
+		}
+	}
 }
 
 func (s *ordersService) GetOrder(ctx context.Context, payload *pb.OrderIdRequest) (*pb.Order, error) {
@@ -41,6 +341,17 @@ func (s *ordersService) ListOrders(ctx context.Context, payload *pb.ListOrdersRe
 }
 
 func (s *ordersService) DeleteOrder(ctx context.Context, payload *pb.OrderIdRequest) (*pb.DeleteOrderResponse, error) {
+	order, err := s.store.GetOrder(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if order != nil && holdsReservations(order.Status) {
+		if err := s.releaseReservations(ctx, order); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.store.DeleteOrder(ctx, payload); err != nil {
 		return nil, err
 	}
@@ -48,9 +359,274 @@ func (s *ordersService) DeleteOrder(ctx context.Context, payload *pb.OrderIdRequ
 }
 
 func (s *ordersService) ChangeOrderStatus(ctx context.Context, payload *pb.ChangeOrderStatusRequest) (*pb.Order, error) {
-	order, err := s.store.ChangeOrderStatus(ctx, payload)
+	current, err := s.store.GetOrder(ctx, &pb.OrderIdRequest{Id: payload.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	if current == nil {
+		return nil, errOrderNotFound
+	}
+
+	// cancelling again only finishes the stock work a failed attempt left
+	if current.Status == "cancelled" && payload.Status == "cancelled" {
+		if err := s.settleCancelledOrder(ctx, current); err != nil {
+			return nil, err
+		}
+		return current, nil
+	}
+
+	if !slices.Contains(orderTransitions[current.Status], payload.Status) {
+		return nil, fmt.Errorf("order %d cannot go from %s to %s: %w", current.Id, current.Status, payload.Status, errOrderTransition)
+	}
+
+	// reserved stock is taken before the order ships and the commits are
+	// reversed if the transition then fails. A cancelled order only gives its
+	// stock back once the cancellation is recorded, so a failed transition
+	// never leaves an active order without its reservations.
+	var commits []ItemCommitDto
+	if payload.Status == "shipped" {
+		commits, err = s.commitReservations(ctx, current)
+		if err != nil {
+			s.reverseMovements(ctx, commitMovements(commits), "order shipment failed")
+			return nil, err
+		}
+	}
+
+	order, err := s.store.ChangeOrderStatus(ctx, payload, current.Status, commits)
 	if err != nil {
+		s.reverseMovements(ctx, commitMovements(commits), "order status change failed")
 		return nil, err
 	}
+
+	Logger.Log("change order status", "order %d went from %s to %s by %s", order.Id, current.Status, order.Status, payload.ChangedBy)
+
+	if payload.Status == "cancelled" {
+		if err := s.settleCancelledOrder(ctx, order); err != nil {
+			return nil, fmt.Errorf("order %d is cancelled but its stock was not settled, cancel it again to retry: %w", order.Id, err)
+		}
+	}
+
 	return order, nil
 }
+
+func commitMovements(commits []ItemCommitDto) []*pb.StockMovement {
+	movements := make([]*pb.StockMovement, len(commits))
+	for i, commit := range commits {
+		movements[i] = commit.Movement
+	}
+	return movements
+}
+
+// releases what a cancelled order still holds and restocks what it took out.
+// Both can be repeated, a cancellation whose stock work failed is finished by
+// cancelling again.
+func (s *ordersService) settleCancelledOrder(ctx context.Context, order *pb.Order) error {
+	if err := s.releaseReservations(ctx, order); err != nil {
+		return err
+	}
+	return s.restockCancelledOrder(ctx, order.Id)
+}
+
+// gives back the stock a cancelled order took out once the order is no longer
+// locked. Items stay pending until their restock movement is recorded, a
+// movement posted for an item a concurrent cancellation restocked first is
+// reversed.
+func (s *ordersService) restockCancelledOrder(ctx context.Context, orderId int64) error {
+	items, err := s.store.PendingRestocks(ctx, orderId)
+	if err != nil {
+		return err
+	}
+
+	reference := orderReference(orderId)
+	for _, item := range items {
+		movement, err := s.inventoryClient.RestockInventoryProduct(ctx, &pb.PurchaseInventoryRequest{
+			ProductId:  item.ProductId,
+			Quantity:   item.Quantity,
+			Reference:  reference,
+			LocationId: item.LocationId,
+		})
+		if err != nil {
+			return fmt.Errorf("item %d (product %d): %w", item.ItemId, item.ProductId, err)
+		}
+
+		if err := s.store.MarkItemRestocked(ctx, item.ItemId, movement.Id); err != nil {
+			s.reverseMovements(ctx, []*pb.StockMovement{movement}, "order item restock not recorded")
+			if errors.Is(err, errItemRestocked) {
+				continue
+			}
+			return err
+		}
+	}
+
+	if len(items) > 0 {
+		Logger.Log("change order status", "order %d restocked %d items on cancellation", orderId, len(items))
+	}
+
+	return nil
+}
+
+func (s *ordersService) GetOrderStatusHistory(ctx context.Context, orderId int64) (*pb.OrderStatusHistory, error) {
+	return s.store.GetOrderStatusHistory(ctx, orderId)
+}
+
+func (s *ordersService) CreateReturn(ctx context.Context, payload *pb.CreateReturnRequest) (*pb.Return, error) {
+	lines := make([]ReturnLineDto, len(payload.Lines))
+	for i, line := range payload.Lines {
+		lines[i] = ReturnLineDto{
+			OrderItemId: line.OrderItemId,
+			Quantity:    line.Quantity,
+		}
+	}
+
+	return s.store.CreateReturn(ctx, &CreateReturnDto{
+		OrderId: payload.OrderId,
+		Reason:  payload.Reason,
+		Note:    payload.Note,
+		Lines:   lines,
+	})
+}
+
+func (s *ordersService) GetReturn(ctx context.Context, id int64) (*pb.Return, error) {
+	return s.store.GetReturn(ctx, id)
+}
+
+func (s *ordersService) ListReturns(ctx context.Context, payload *pb.ListReturnsRequest) ([]*pb.Return, error) {
+	return s.store.ListReturns(ctx, payload.OrderId, payload.Status)
+}
+
+func (s *ordersService) ReceiveReturn(ctx context.Context, id int64) (*pb.Return, error) {
+	return s.store.ReceiveReturn(ctx, id)
+}
+
+func (s *ordersService) CancelReturn(ctx context.Context, id int64) (*pb.Return, error) {
+	return s.store.CancelReturn(ctx, id)
+}
+
+// restocked lines post a return movement with the return number as
+// reference, scrapped lines never go back into stock. If the dispositions
+// cannot be recorded the movements already posted are reversed.
+func (s *ordersService) DispositionReturn(ctx context.Context, payload *pb.DispositionReturnRequest) (*pb.Return, error) {
+	lines := make([]LineDispositionDto, len(payload.Lines))
+	for i, line := range payload.Lines {
+		lines[i] = LineDispositionDto{
+			LineId:        line.LineId,
+			Disposition:   line.Disposition,
+			LocationId:    line.LocationId,
+			SerialNumbers: line.SerialNumbers,
+		}
+	}
+
+	// scrapped lines of serialized products mark their units scrapped, which
+	// is undone along with the restocks if the disposition fails
+	var scrapped []*pb.ScrapSerialUnitsRequest
+	ret, posted, err := s.store.DispositionReturn(ctx, &DispositionReturnDto{
+		Id:    payload.Id,
+		Lines: lines,
+	}, func(ctx context.Context, productId, quantity int64, reference string, line *LineDispositionDto) (*pb.StockMovement, error) {
+		if line.Disposition == "scrap" {
+			scrap := &pb.ScrapSerialUnitsRequest{
+				ProductId:     productId,
+				Quantity:      quantity,
+				SerialNumbers: line.SerialNumbers,
+				Reference:     reference,
+			}
+			if _, err := s.inventoryClient.ScrapSerialUnits(ctx, scrap); err != nil {
+				return nil, err
+			}
+			if len(scrap.SerialNumbers) > 0 {
+				scrapped = append(scrapped, scrap)
+			}
+			return nil, nil
+		}
+
+		return s.inventoryClient.ReturnInventoryProduct(ctx, &pb.PurchaseInventoryRequest{
+			ProductId:     productId,
+			Quantity:      quantity,
+			Reference:     reference,
+			LocationId:    line.LocationId,
+			SerialNumbers: line.SerialNumbers,
+		})
+	})
+	if err != nil {
+		s.reverseMovements(ctx, posted, "return disposition failed")
+		s.restoreSerialUnits(ctx, scrapped)
+		return nil, err
+	}
+
+	Logger.Log("disposition return", "%s dispositioned %d lines, now %s", ret.Number, len(lines), ret.Status)
+
+	return ret, nil
+}
+
+// each line commits its part of the item's reservation with the shipment
+// number as reference, items placed without a reservation are taken out as a
+// plain purchase. If the shipment cannot be recorded the movements already
+// posted are reversed, which puts the units back on their reservations.
+func (s *ordersService) CreateShipment(ctx context.Context, payload *pb.CreateShipmentRequest) (*pb.Shipment, error) {
+	lines := make([]ShipmentLineDto, len(payload.Lines))
+	for i, line := range payload.Lines {
+		lines[i] = ShipmentLineDto{
+			OrderItemId: line.OrderItemId,
+			Quantity:    line.Quantity,
+		}
+	}
+
+	shipment, posted, err := s.store.CreateShipment(ctx, &CreateShipmentDto{
+		OrderId:        payload.OrderId,
+		Carrier:        payload.Carrier,
+		TrackingNumber: payload.TrackingNumber,
+		ShippedBy:      payload.ShippedBy,
+		Lines:          lines,
+	}, func(ctx context.Context, reservationId, productId, baseQuantity int64, reference string) (*pb.StockMovement, error) {
+		if reservationId == 0 {
+			return s.inventoryClient.PurchaseInventoryProduct(ctx, &pb.PurchaseInventoryRequest{
+				ProductId: productId,
+				Quantity:  baseQuantity,
+				Reference: reference,
+			})
+		}
+
+		return s.inventoryClient.CommitReservation(ctx, &pb.CommitReservationRequest{
+			Id:        reservationId,
+			Quantity:  baseQuantity,
+			Reference: reference,
+		})
+	})
+	if err != nil {
+		s.reverseMovements(ctx, posted, "shipment failed")
+		return nil, err
+	}
+
+	Logger.Log("create shipment", "%s sent %d lines of order %d with %s %s", shipment.Number, len(shipment.Lines), shipment.OrderId, shipment.Carrier, shipment.TrackingNumber)
+
+	return shipment, nil
+}
+
+func (s *ordersService) GetShipment(ctx context.Context, id int64) (*pb.Shipment, error) {
+	return s.store.GetShipment(ctx, id)
+}
+
+func (s *ordersService) ListShipments(ctx context.Context, orderId int64) ([]*pb.Shipment, error) {
+	return s.store.ListShipments(ctx, orderId)
+}
+
+func (s *ordersService) SavePromotion(ctx context.Context, payload *pb.Promotion) (*pb.Promotion, error) {
+	promotion, err := parsePromotion(payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.store.SavePromotion(ctx, promotion)
+}
+
+func (s *ordersService) GetPromotion(ctx context.Context, id int64) (*pb.Promotion, error) {
+	return s.store.GetPromotion(ctx, id)
+}
+
+func (s *ordersService) ListPromotions(ctx context.Context, activeOnly bool) ([]*pb.Promotion, error) {
+	return s.store.ListPromotions(ctx, activeOnly, time.Now())
+}
+
+func (s *ordersService) DeletePromotion(ctx context.Context, id int64) error {
+	return s.store.DeletePromotion(ctx, id)
+}
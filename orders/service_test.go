@@ -0,0 +1,45 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOrderTransitions(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+		want bool
+	}{
+		{from: "pending", to: "confirmed", want: true},
+		{from: "pending", to: "cancelled", want: true},
+		{from: "confirmed", to: "picking", want: true},
+		{from: "picking", to: "shipped", want: true},
+		{from: "picking", to: "cancelled", want: true},
+		{from: "partially_shipped", to: "cancelled", want: true},
+		{from: "shipped", to: "delivered", want: true},
+
+		// shipments move partially shipped orders on, shipped orders are returned
+		{from: "partially_shipped", to: "shipped"},
+		{from: "shipped", to: "cancelled"},
+		{from: "delivered", to: "cancelled"},
+		{from: "picking", to: "partially_shipped"},
+		{from: "delivered", to: "returned"},
+		{from: "cancelled", to: "pending"},
+		{from: "pending", to: "shipped"},
+	}
+
+	for _, tt := range tests {
+		if got := slices.Contains(orderTransitions[tt.from], tt.to); got != tt.want {
+			t.Errorf("%s to %s allowed = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	for from, targets := range orderTransitions {
+		for _, to := range targets {
+			if _, ok := orderTransitions[to]; !ok {
+				t.Errorf("%s goes to unknown status %s", from, to)
+			}
+		}
+	}
+}
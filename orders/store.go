@@ -4,10 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/logan2k02/ims/shared/schema"
 	"github.com/logan2k02/ims/shared/utils"
 
 	pb "github.com/logan2k02/ims/shared/protobuf"
@@ -55,6 +58,10 @@ func (s *ordersStore) Close() error {
 	return nil
 }
 
+// every order status, tables created before a status was added are migrated
+// to it
+const orderStatusDefinition = `ENUM('pending', 'confirmed', 'picking', 'partially_shipped', 'shipped', 'delivered', 'cancelled', 'partially_returned', 'returned') NOT NULL DEFAULT 'pending'`
+
 func (s *ordersStore) Init() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
 	defer cancel()
@@ -75,7 +82,11 @@ func (s *ordersStore) Init() error {
 		payment_reference VARCHAR(100),
 		customer_name VARCHAR(255) NOT NULL,
 		customer_contact VARCHAR(255) NOT NULL,
-		status ENUM('pending', 'completed', 'cancelled') NOT NULL DEFAULT 'pending',
+		status `+orderStatusDefinition+`,
+		subtotal DECIMAL(14, 2) NOT NULL DEFAULT 0,
+		discount_total DECIMAL(14, 2) NOT NULL DEFAULT 0,
+		tax_total DECIMAL(14, 2) NOT NULL DEFAULT 0,
+		grand_total DECIMAL(14, 2) NOT NULL DEFAULT 0,
 		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 	);
 	`)
@@ -83,12 +94,67 @@ func (s *ordersStore) Init() error {
 		return err
 	}
 
+	if err := schema.AddColumn(ctx, tx, "orders", "subtotal", "DECIMAL(14, 2) NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "orders", "discount_total", "DECIMAL(14, 2) NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "orders", "tax_total", "DECIMAL(14, 2) NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "orders", "grand_total", "DECIMAL(14, 2) NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	// completed was the last status before fulfilment was tracked, those
+	// orders are delivered
+	var hasCompleted bool
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'orders' AND COLUMN_NAME = 'status' AND COLUMN_TYPE LIKE '%''completed''%'
+	)
+	`
+	if err := tx.QueryRowContext(ctx, query).Scan(&hasCompleted); err != nil {
+		return err
+	}
+
+	if hasCompleted {
+		if _, err := tx.ExecContext(ctx, `ALTER TABLE orders MODIFY COLUMN status ENUM('pending', 'completed', 'delivered', 'cancelled', 'partially_returned', 'returned') NOT NULL DEFAULT 'pending'`); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE orders SET status = 'delivered' WHERE status = 'completed'`); err != nil {
+			return err
+		}
+	}
+
+	if err := schema.ModifyColumn(ctx, tx, "orders", "status", orderStatusDefinition); err != nil {
+		return err
+	}
+
 	_, err = tx.ExecContext(ctx, `
 	CREATE TABLE IF NOT EXISTS order_items (
 		id INT AUTO_INCREMENT PRIMARY KEY,
 		order_id INT NOT NULL,
 		product_id INT NOT NULL,
 		quantity INT NOT NULL,
+		unit VARCHAR(20) NOT NULL DEFAULT '',
+		base_quantity INT,
+		reservation_id INT,
+		committed_quantity INT NOT NULL DEFAULT 0,
+		committed_location_id INT,
+		restock_movement_id INT,
+		unit_price DECIMAL(12, 2),
+		subtotal DECIMAL(14, 2) NOT NULL DEFAULT 0,
+		discount DECIMAL(14, 2) NOT NULL DEFAULT 0,
+		tax_rate DECIMAL(7, 4) NOT NULL DEFAULT 0,
+		tax DECIMAL(14, 2) NOT NULL DEFAULT 0,
+		total DECIMAL(14, 2) NOT NULL DEFAULT 0,
 		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 		FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE ON UPDATE CASCADE,
 		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE
@@ -98,19 +164,242 @@ func (s *ordersStore) Init() error {
 		return err
 	}
 
+	if err := schema.AddColumn(ctx, tx, "order_items", "reservation_id", "INT"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "order_items", "unit", "VARCHAR(20) NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "order_items", "base_quantity", "INT"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "order_items", "restock_movement_id", "INT"); err != nil {
+		return err
+	}
+
+	// items of orders shipped before commits were recorded were taken whole
+	if err := schema.AddColumn(ctx, tx, "order_items", "committed_quantity", "INT NOT NULL DEFAULT 0", `
+	UPDATE order_items oi
+	JOIN orders o ON o.id = oi.order_id
+	SET oi.committed_quantity = COALESCE(oi.base_quantity, oi.quantity)
+	WHERE o.status IN ('shipped', 'delivered', 'partially_returned', 'returned')
+	`); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "order_items", "committed_location_id", "INT"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "order_items", "unit_price", "DECIMAL(12, 2)"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "order_items", "subtotal", "DECIMAL(14, 2) NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "order_items", "discount", "DECIMAL(14, 2) NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "order_items", "tax_rate", "DECIMAL(7, 4) NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "order_items", "tax", "DECIMAL(14, 2) NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "order_items", "total", "DECIMAL(14, 2) NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS order_status_history (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		order_id INT NOT NULL,
+		from_status VARCHAR(30),
+		to_status VARCHAR(30) NOT NULL,
+		changed_by VARCHAR(100),
+		note TEXT,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS shipments (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		order_id INT NOT NULL,
+		carrier VARCHAR(100) NOT NULL,
+		tracking_number VARCHAR(100) NOT NULL,
+		shipped_by VARCHAR(100),
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS shipment_lines (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		shipment_id INT NOT NULL,
+		order_item_id INT NOT NULL,
+		quantity INT NOT NULL,
+		base_quantity INT NOT NULL,
+		movement_id INT NOT NULL,
+		FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (order_item_id) REFERENCES order_items(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS promotions (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		code VARCHAR(50) UNIQUE,
+		name VARCHAR(255) NOT NULL,
+		type ENUM('percentage', 'fixed', 'buy_x_get_y') NOT NULL,
+		percentage DECIMAL(7, 4),
+		amount DECIMAL(14, 2),
+		product_id INT,
+		buy_quantity INT,
+		get_quantity INT,
+		min_quantity INT NOT NULL DEFAULT 0,
+		min_order_total DECIMAL(14, 2),
+		usage_limit INT,
+		starts_at DATETIME,
+		ends_at DATETIME,
+		active BOOLEAN NOT NULL DEFAULT TRUE,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	// code and name are copied so the discount still reads right once the
+	// promotion is changed or deleted
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS order_discounts (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		order_id INT NOT NULL,
+		order_item_id INT NOT NULL,
+		promotion_id INT,
+		code VARCHAR(50),
+		name VARCHAR(255) NOT NULL,
+		amount DECIMAL(14, 2) NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		INDEX (promotion_id),
+		FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (order_item_id) REFERENCES order_items(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (promotion_id) REFERENCES promotions(id) ON DELETE SET NULL ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS returns (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		order_id INT NOT NULL,
+		status ENUM('requested', 'received', 'closed', 'cancelled') NOT NULL DEFAULT 'requested',
+		reason VARCHAR(255) NOT NULL,
+		note TEXT,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		received_at TIMESTAMP NULL,
+		closed_at TIMESTAMP NULL,
+		INDEX (status),
+		FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS return_lines (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		return_id INT NOT NULL,
+		order_item_id INT NOT NULL,
+		quantity INT NOT NULL,
+		disposition ENUM('restock', 'scrap'),
+		location_id INT,
+		movement_id INT,
+		dispositioned_at TIMESTAMP NULL,
+		FOREIGN KEY (return_id) REFERENCES returns(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (order_item_id) REFERENCES order_items(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
 type jsonOrderItem struct {
-	ProductId int64 `json:"product_id"`
-	Quantity  int64 `json:"quantity"`
+	Id               int64  `json:"id"`
+	ReturnedQuantity int64  `json:"returned_quantity"`
+	ShippedQuantity  int64  `json:"shipped_quantity"`
+	ProductId        int64  `json:"product_id"`
+	Quantity         int64  `json:"quantity"`
+	Unit             string `json:"unit"`
+	BaseQuantity     int64  `json:"base_quantity"`
+	ReservationId    int64  `json:"reservation_id"`
+	UnitPrice        string `json:"unit_price"`
+	Subtotal         string `json:"subtotal"`
+	Discount         string `json:"discount"`
+	TaxRate          string `json:"tax_rate"`
+	Tax              string `json:"tax"`
+	Total            string `json:"total"`
+}
+
+type jsonOrderDiscount struct {
+	PromotionId int64  `json:"promotion_id"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	OrderItemId int64  `json:"order_item_id"`
+	Amount      string `json:"amount"`
+}
+
+func unmarshalOrderDiscounts(data []byte) ([]*pb.OrderDiscount, error) {
+	var discounts []jsonOrderDiscount
+	if err := json.Unmarshal(data, &discounts); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal order discounts: %w", err)
+	}
+
+	orderDiscounts := make([]*pb.OrderDiscount, len(discounts))
+	for i, d := range discounts {
+		orderDiscounts[i] = &pb.OrderDiscount{
+			PromotionId: d.PromotionId,
+			Code:        d.Code,
+			Name:        d.Name,
+			OrderItemId: d.OrderItemId,
+			Amount:      d.Amount,
+		}
+	}
+	return orderDiscounts, nil
 }
 
 func (s *ordersStore) rowToOrder(row *sql.Row) (*pb.Order, error) {
 	var order pb.Order
-	var itemsJSON []byte
+	var itemsJSON, discountsJSON []byte
 
-	if err := row.Scan(&order.Id, &order.PaymentReference, &order.CustomerName, &order.CustomerContact, &order.Status, &order.CreatedAt, &itemsJSON); err != nil {
+	if err := row.Scan(&order.Id, &order.PaymentReference, &order.CustomerName, &order.CustomerContact, &order.Status, &order.CreatedAt, &order.Subtotal, &order.DiscountTotal, &order.TaxTotal, &order.GrandTotal, &itemsJSON, &discountsJSON); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -125,15 +414,63 @@ func (s *ordersStore) rowToOrder(row *sql.Row) (*pb.Order, error) {
 	var orderItems []*pb.OrderItem
 	for _, item := range items {
 		orderItems = append(orderItems, &pb.OrderItem{
-			ProductId: item.ProductId,
-			Quantity:  item.Quantity,
+			Id:               item.Id,
+			ProductId:        item.ProductId,
+			Quantity:         item.Quantity,
+			Unit:             item.Unit,
+			BaseQuantity:     item.BaseQuantity,
+			ReservationId:    item.ReservationId,
+			ReturnedQuantity: item.ReturnedQuantity,
+			ShippedQuantity:  item.ShippedQuantity,
+			UnitPrice:        item.UnitPrice,
+			Subtotal:         item.Subtotal,
+			Discount:         item.Discount,
+			TaxRate:          item.TaxRate,
+			Tax:              item.Tax,
+			Total:            item.Total,
 		})
 	}
 
 	order.Items = orderItems
+
+	discounts, err := unmarshalOrderDiscounts(discountsJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	order.Discounts = discounts
+
 	return &order, nil
 }
 
+// promotions applied to the order
+const orderDiscountsQuery = `(
+		SELECT COALESCE(JSON_ARRAYAGG(JSON_OBJECT(
+			'promotion_id', COALESCE(od.promotion_id, 0),
+			'code', COALESCE(od.code, ''),
+			'name', od.name,
+			'order_item_id', od.order_item_id,
+			'amount', CAST(od.amount AS CHAR)
+		)), JSON_ARRAY())
+		FROM order_discounts od
+		WHERE od.order_id = o.id
+	)`
+
+// quantity of an order item sent on shipments
+const shippedQuantityQuery = `(
+				SELECT COALESCE(SUM(sl.quantity), 0)
+				FROM shipment_lines sl
+				WHERE sl.order_item_id = oi.id
+			)`
+
+// quantity of an order item brought back on returns that were received
+const returnedQuantityQuery = `(
+				SELECT COALESCE(SUM(rl.quantity), 0)
+				FROM return_lines rl
+				JOIN returns r ON r.id = rl.return_id
+				WHERE rl.order_item_id = oi.id AND r.status IN ('received', 'closed')
+			)`
+
 const SINGLE_ROW_ORDER_QUERY = `
 SELECT 
 	o.id,
@@ -142,14 +479,31 @@ SELECT
 	o.customer_contact,
 	o.status,
 	o.created_at,
+	o.subtotal,
+	o.discount_total,
+	o.tax_total,
+	o.grand_total,
 	COALESCE(
     JSON_ARRAYAGG(
 			JSON_OBJECT(
+				'id', oi.id,
 				'product_id', oi.product_id,
-				'quantity', oi.quantity
+				'quantity', oi.quantity,
+				'unit', oi.unit,
+				'base_quantity', oi.base_quantity,
+				'reservation_id', oi.reservation_id,
+				'unit_price', CAST(COALESCE(oi.unit_price, 0) AS CHAR),
+				'subtotal', CAST(oi.subtotal AS CHAR),
+				'discount', CAST(oi.discount AS CHAR),
+				'tax_rate', CAST(oi.tax_rate AS CHAR),
+				'tax', CAST(oi.tax AS CHAR),
+				'total', CAST(oi.total AS CHAR),
+				'returned_quantity', ` + returnedQuantityQuery + `,
+				'shipped_quantity', ` + shippedQuantityQuery + `
 			)
 		), JSON_ARRAY()
-	) AS items
+	) AS items,
+	` + orderDiscountsQuery + ` AS discounts
 	FROM orders o
 	LEFT JOIN order_items oi ON o.id = oi.order_id
 	WHERE o.id = ?
@@ -183,15 +537,21 @@ func (s *ordersStore) CreateOrder(ctx context.Context, payload *pb.CreateOrderRe
 		return nil, err
 	}
 
-	query = `INSERT INTO order_items (order_id, product_id, quantity) VALUES `
+	query = `INSERT INTO order_items (order_id, product_id, quantity, unit) VALUES `
+	var args []any
 	for i, item := range payload.Items {
 		if i > 0 {
 			query += ", "
 		}
-		query += fmt.Sprintf("(%d, %d, %d)", orderID, item.ProductId, item.Quantity)
+		query += "(?, ?, ?, ?)"
+		args = append(args, orderID, item.ProductId, item.Quantity, item.Unit)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, err
 	}
 
-	if _, err := tx.ExecContext(ctx, query); err != nil {
+	if err := recordStatusChange(ctx, tx, orderID, "", "pending", "", "order placed"); err != nil {
 		return nil, err
 	}
 
@@ -267,14 +627,31 @@ func (s *ordersStore) ListOrders(ctx context.Context, payload *pb.ListOrdersRequ
 	o.customer_contact,
 	o.status,
 	o.created_at,
+	o.subtotal,
+	o.discount_total,
+	o.tax_total,
+	o.grand_total,
 	COALESCE(
     JSON_ARRAYAGG(
 			JSON_OBJECT(
+				'id', oi.id,
 				'product_id', oi.product_id,
-				'quantity', oi.quantity
+				'quantity', oi.quantity,
+				'unit', oi.unit,
+				'base_quantity', oi.base_quantity,
+				'reservation_id', oi.reservation_id,
+				'unit_price', CAST(COALESCE(oi.unit_price, 0) AS CHAR),
+				'subtotal', CAST(oi.subtotal AS CHAR),
+				'discount', CAST(oi.discount AS CHAR),
+				'tax_rate', CAST(oi.tax_rate AS CHAR),
+				'tax', CAST(oi.tax AS CHAR),
+				'total', CAST(oi.total AS CHAR),
+				'returned_quantity', `+returnedQuantityQuery+`,
+				'shipped_quantity', `+shippedQuantityQuery+`
 			)
 		), JSON_ARRAY()
-	) AS items
+	) AS items,
+	`+orderDiscountsQuery+` AS discounts
 	FROM orders o
 	LEFT JOIN order_items oi ON o.id = oi.order_id
 	GROUP BY o.id
@@ -296,9 +673,9 @@ func (s *ordersStore) ListOrders(ctx context.Context, payload *pb.ListOrdersRequ
 	var orders []*pb.Order
 	for rows.Next() {
 		var order pb.Order
-		var itemsJson []byte
+		var itemsJson, discountsJson []byte
 
-		if err := rows.Scan(&order.Id, &order.PaymentReference, &order.CustomerName, &order.CustomerContact, &order.Status, &order.CreatedAt, &itemsJson); err != nil {
+		if err := rows.Scan(&order.Id, &order.PaymentReference, &order.CustomerName, &order.CustomerContact, &order.Status, &order.CreatedAt, &order.Subtotal, &order.DiscountTotal, &order.TaxTotal, &order.GrandTotal, &itemsJson, &discountsJson); err != nil {
 			return nil, err
 		}
 
@@ -310,12 +687,29 @@ func (s *ordersStore) ListOrders(ctx context.Context, payload *pb.ListOrdersRequ
 		var orderItems []*pb.OrderItem
 		for _, item := range items {
 			orderItems = append(orderItems, &pb.OrderItem{
-				ProductId: item.ProductId,
-				Quantity:  item.Quantity,
+				Id:               item.Id,
+				ProductId:        item.ProductId,
+				Quantity:         item.Quantity,
+				Unit:             item.Unit,
+				BaseQuantity:     item.BaseQuantity,
+				ReservationId:    item.ReservationId,
+				ReturnedQuantity: item.ReturnedQuantity,
+				ShippedQuantity:  item.ShippedQuantity,
+				UnitPrice:        item.UnitPrice,
+				Subtotal:         item.Subtotal,
+				Discount:         item.Discount,
+				TaxRate:          item.TaxRate,
+				Tax:              item.Tax,
+				Total:            item.Total,
 			})
 		}
 
 		order.Items = orderItems
+
+		if order.Discounts, err = unmarshalOrderDiscounts(discountsJson); err != nil {
+			return nil, err
+		}
+
 		orders = append(orders, &order)
 	}
 
@@ -363,7 +757,44 @@ func (s *ordersStore) DeleteOrder(ctx context.Context, payload *pb.OrderIdReques
 	return nil
 }
 
-func (s *ordersStore) ChangeOrderStatus(ctx context.Context, payload *pb.ChangeOrderStatusRequest) (*pb.Order, error) {
+func recordStatusChange(ctx context.Context, tx *sql.Tx, orderId int64, fromStatus, toStatus, changedBy, note string) error {
+	var from, by any
+	if fromStatus != "" {
+		from = fromStatus
+	}
+	if changedBy != "" {
+		by = changedBy
+	}
+
+	query := `
+	INSERT INTO order_status_history (order_id, from_status, to_status, changed_by, note)
+	VALUES (?, ?, ?, ?, ?)
+	`
+	_, err := tx.ExecContext(ctx, query, orderId, from, toStatus, by, note)
+	return err
+}
+
+// an item's stock taken out when its order shipped outside a shipment
+type ItemCommitDto struct {
+	ItemId   int64
+	Movement *pb.StockMovement
+}
+
+// items keep the base units they took out of stock and where from, so a
+// cancelled order knows what to give back without asking the inventory
+func recordItemCommit(ctx context.Context, tx *sql.Tx, itemId int64, movement *pb.StockMovement) error {
+	_, err := tx.ExecContext(ctx, `
+	UPDATE order_items
+	SET committed_quantity = committed_quantity + ?, committed_location_id = ?
+	WHERE id = ?
+	`, movement.Change, movement.LocationId, itemId)
+	return err
+}
+
+// the order must still be in the status the transition was checked against,
+// a concurrent change in between fails the transition. Commits made for the
+// transition are recorded on their items.
+func (s *ordersStore) ChangeOrderStatus(ctx context.Context, payload *pb.ChangeOrderStatusRequest, fromStatus string, commits []ItemCommitDto) (*pb.Order, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -375,11 +806,33 @@ func (s *ordersStore) ChangeOrderStatus(ctx context.Context, payload *pb.ChangeO
 		}
 	}()
 
+	var current string
+	if err := tx.QueryRowContext(ctx, `SELECT status FROM orders WHERE id = ? FOR UPDATE`, payload.Id).Scan(&current); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errOrderNotFound
+		}
+		return nil, err
+	}
+
+	if current != fromStatus {
+		return nil, fmt.Errorf("order %d changed to %s in the meantime: %w", payload.Id, current, errOrderTransition)
+	}
+
+	for _, commit := range commits {
+		if err := recordItemCommit(ctx, tx, commit.ItemId, commit.Movement); err != nil {
+			return nil, err
+		}
+	}
+
 	query := `UPDATE orders SET status = ? WHERE id = ?`
 	if _, err := tx.ExecContext(ctx, query, payload.Status, payload.Id); err != nil {
 		return nil, err
 	}
 
+	if err := recordStatusChange(ctx, tx, payload.Id, fromStatus, payload.Status, payload.ChangedBy, payload.Note); err != nil {
+		return nil, err
+	}
+
 	row := tx.QueryRowContext(ctx, SINGLE_ROW_ORDER_QUERY, payload.Id)
 	order, err := s.rowToOrder(row)
 	if err != nil {
@@ -392,3 +845,1415 @@ func (s *ordersStore) ChangeOrderStatus(ctx context.Context, payload *pb.ChangeO
 
 	return order, nil
 }
+
+// items whose reservation still holds units that were not taken out
+func (s *ordersStore) ReservedItems(ctx context.Context, orderId int64) (map[int64]bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("reserved items", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT id
+	FROM order_items
+	WHERE order_id = ? AND reservation_id IS NOT NULL AND committed_quantity < COALESCE(base_quantity, quantity)
+	`, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := make(map[int64]bool)
+	for rows.Next() {
+		var itemId int64
+		if err := rows.Scan(&itemId); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		reserved[itemId] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return reserved, nil
+}
+
+var errItemRestocked = errors.New("order item is already restocked")
+
+// stock an item of a cancelled order took out and has not given back yet
+type PendingRestockDto struct {
+	ItemId     int64
+	ProductId  int64
+	Quantity   int64
+	LocationId int64
+}
+
+// items stay pending until their restock movement is recorded, so a
+// cancellation that failed half way is finished by cancelling again
+func (s *ordersStore) PendingRestocks(ctx context.Context, orderId int64) ([]PendingRestockDto, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("pending restocks", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT oi.id, oi.product_id, oi.committed_quantity, COALESCE(oi.committed_location_id, 0)
+	FROM order_items oi
+	JOIN orders o ON o.id = oi.order_id
+	WHERE oi.order_id = ? AND o.status = 'cancelled' AND oi.committed_quantity > 0 AND oi.restock_movement_id IS NULL
+	ORDER BY oi.id
+	`, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []PendingRestockDto
+	for rows.Next() {
+		var item PendingRestockDto
+		if err := rows.Scan(&item.ItemId, &item.ProductId, &item.Quantity, &item.LocationId); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// fails with errItemRestocked when a concurrent cancellation got there first,
+// the caller then reverses its own movement
+func (s *ordersStore) MarkItemRestocked(ctx context.Context, itemId, movementId int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("mark item restocked", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	result, err := tx.ExecContext(ctx, `UPDATE order_items SET restock_movement_id = ? WHERE id = ? AND restock_movement_id IS NULL`, movementId, itemId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("item %d: %w", itemId, errItemRestocked)
+	}
+
+	return tx.Commit()
+}
+
+// links every item to its reservation and records the base units it holds
+func (s *ordersStore) SetOrderItemReservations(ctx context.Context, orderId int64, reservations []*pb.StockReservation) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("set order item reservations", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM order_items WHERE order_id = ? ORDER BY id`, orderId)
+	if err != nil {
+		return err
+	}
+
+	var itemIds []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		itemIds = append(itemIds, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(itemIds) != len(reservations) {
+		return fmt.Errorf("order %d has %d items but %d reservations were given", orderId, len(itemIds), len(reservations))
+	}
+
+	for i, itemId := range itemIds {
+		if _, err := tx.ExecContext(ctx, `UPDATE order_items SET reservation_id = ?, base_quantity = ? WHERE id = ?`, reservations[i].Id, reservations[i].Quantity, itemId); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// prices of one order item, amounts are for the whole line
+type LinePricingDto struct {
+	UnitPrice money
+	Subtotal  money
+	Discount  money
+	TaxRate   percentage
+	Tax       money
+	Total     money
+}
+
+// snapshots item prices in item order, rolls them up into the order totals and
+// itemizes the promotions applied. Each promotion is locked while its usage
+// is checked so two orders cannot both take the last use.
+func (s *ordersStore) SetOrderPricing(ctx context.Context, orderId int64, lines []LinePricingDto, discounts []AppliedDiscountDto) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("set order pricing", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM order_items WHERE order_id = ? ORDER BY id`, orderId)
+	if err != nil {
+		return err
+	}
+
+	var itemIds []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		itemIds = append(itemIds, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(itemIds) != len(lines) {
+		return fmt.Errorf("order %d has %d items but %d prices were given", orderId, len(itemIds), len(lines))
+	}
+
+	var subtotal, discount, tax, total money
+	for i, itemId := range itemIds {
+		line := lines[i]
+		_, err := tx.ExecContext(ctx, `
+		UPDATE order_items
+		SET unit_price = ?, subtotal = ?, discount = ?, tax_rate = ?, tax = ?, total = ?
+		WHERE id = ?
+		`, line.UnitPrice.String(), line.Subtotal.String(), line.Discount.String(), line.TaxRate.String(), line.Tax.String(), line.Total.String(), itemId)
+		if err != nil {
+			return err
+		}
+
+		subtotal += line.Subtotal
+		discount += line.Discount
+		tax += line.Tax
+		total += line.Total
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE orders
+	SET subtotal = ?, discount_total = ?, tax_total = ?, grand_total = ?
+	WHERE id = ?
+	`, subtotal.String(), discount.String(), tax.String(), total.String(), orderId)
+	if err != nil {
+		return err
+	}
+
+	checked := make(map[int64]bool)
+	for _, d := range discounts {
+		if !checked[d.PromotionId] {
+			if err := checkPromotionUsage(ctx, tx, d.PromotionId, orderId); err != nil {
+				return err
+			}
+			checked[d.PromotionId] = true
+		}
+
+		var code any
+		if d.Code != "" {
+			code = d.Code
+		}
+
+		_, err := tx.ExecContext(ctx, `
+		INSERT INTO order_discounts (order_id, order_item_id, promotion_id, code, name, amount)
+		VALUES (?, ?, ?, ?, ?, ?)
+		`, orderId, itemIds[d.Line], d.PromotionId, code, d.Name, d.Amount.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// orders using a promotion, cancelled orders give their use back
+const promotionUsageQuery = `(
+	SELECT COUNT(DISTINCT od.order_id)
+	FROM order_discounts od
+	JOIN orders uo ON uo.id = od.order_id
+	WHERE od.promotion_id = p.id AND uo.status <> 'cancelled'
+)`
+
+func checkPromotionUsage(ctx context.Context, tx *sql.Tx, promotionId, orderId int64) error {
+	var name string
+	var limit sql.NullInt64
+	if err := tx.QueryRowContext(ctx, `SELECT name, usage_limit FROM promotions WHERE id = ? FOR UPDATE`, promotionId).Scan(&name, &limit); err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("promotion %d no longer exists: %w", promotionId, errPromotionNotApplicable)
+		}
+		return err
+	}
+
+	if !limit.Valid || limit.Int64 == 0 {
+		return nil
+	}
+
+	var used int64
+	query := `SELECT ` + promotionUsageQuery + ` FROM promotions p WHERE p.id = ?`
+	if err := tx.QueryRowContext(ctx, query, promotionId).Scan(&used); err != nil {
+		return err
+	}
+
+	if used >= limit.Int64 {
+		return fmt.Errorf("%s has reached its usage limit: %w", name, errPromotionNotApplicable)
+	}
+	return nil
+}
+
+const promotionColumns = `p.id, COALESCE(p.code, ''), p.name, p.type,
+	COALESCE(CAST(p.percentage AS CHAR), ''), COALESCE(CAST(p.amount AS CHAR), ''),
+	COALESCE(p.product_id, 0), COALESCE(p.buy_quantity, 0), COALESCE(p.get_quantity, 0), p.min_quantity,
+	COALESCE(CAST(p.min_order_total AS CHAR), ''), COALESCE(p.usage_limit, 0), ` + promotionUsageQuery + `,
+	COALESCE(DATE_FORMAT(p.starts_at, '%Y-%m-%dT%H:%i:%sZ'), ''), COALESCE(DATE_FORMAT(p.ends_at, '%Y-%m-%dT%H:%i:%sZ'), ''),
+	p.active, p.created_at`
+
+func scanPromotion(row rowScanner) (*pb.Promotion, error) {
+	var promotion pb.Promotion
+	if err := row.Scan(&promotion.Id, &promotion.Code, &promotion.Name, &promotion.Type, &promotion.Percentage, &promotion.Amount, &promotion.ProductId, &promotion.BuyQuantity, &promotion.GetQuantity, &promotion.MinQuantity, &promotion.MinOrderTotal, &promotion.UsageLimit, &promotion.UsageCount, &promotion.StartsAt, &promotion.EndsAt, &promotion.Active, &promotion.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &promotion, nil
+}
+
+func getPromotion(ctx context.Context, tx *sql.Tx, id int64) (*pb.Promotion, error) {
+	promotion, err := scanPromotion(tx.QueryRowContext(ctx, `SELECT `+promotionColumns+` FROM promotions p WHERE p.id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, errPromotionNotFound
+	}
+	return promotion, err
+}
+
+func queryPromotions(ctx context.Context, tx *sql.Tx, where string, args ...any) ([]*pb.Promotion, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT `+promotionColumns+` FROM promotions p `+where+` ORDER BY p.id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var promotions []*pb.Promotion
+	for rows.Next() {
+		promotion, err := scanPromotion(rows)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, promotion)
+	}
+	return promotions, rows.Err()
+}
+
+const activePromotionFilter = `p.active AND (p.starts_at IS NULL OR p.starts_at <= ?) AND (p.ends_at IS NULL OR p.ends_at > ?)`
+
+// creates the promotion when it has no id yet and replaces it otherwise
+func (s *ordersStore) SavePromotion(ctx context.Context, payload *PromotionDto) (*pb.Promotion, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("save promotion", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if payload.Code != "" {
+		var taken bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM promotions WHERE code = ? AND id <> ?)`, payload.Code, payload.Id).Scan(&taken); err != nil {
+			return nil, err
+		}
+		if taken {
+			return nil, fmt.Errorf("code %s is already used by another promotion: %w", payload.Code, errInvalidPromotion)
+		}
+	}
+
+	if payload.ProductId != 0 {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM products WHERE id = ?)`, payload.ProductId).Scan(&exists); err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("product %d not found: %w", payload.ProductId, errInvalidPromotion)
+		}
+	}
+
+	// only the fields the promotion type uses are kept, the rest stay NULL
+	var code, percentage, amount, productId, buyQuantity, getQuantity, minOrderTotal, usageLimit, startsAt, endsAt any
+	if payload.Code != "" {
+		code = payload.Code
+	}
+	switch payload.Type {
+	case "percentage":
+		percentage = payload.Percentage.String()
+	case "fixed":
+		amount = payload.Amount.String()
+	case "buy_x_get_y":
+		buyQuantity, getQuantity = payload.BuyQuantity, payload.GetQuantity
+	}
+	if payload.ProductId != 0 {
+		productId = payload.ProductId
+	}
+	if payload.MinOrderTotal != 0 {
+		minOrderTotal = payload.MinOrderTotal.String()
+	}
+	if payload.UsageLimit != 0 {
+		usageLimit = payload.UsageLimit
+	}
+	if payload.StartsAt != nil {
+		startsAt = *payload.StartsAt
+	}
+	if payload.EndsAt != nil {
+		endsAt = *payload.EndsAt
+	}
+
+	args := []any{code, payload.Name, payload.Type, percentage, amount, productId, buyQuantity, getQuantity, payload.MinQuantity, minOrderTotal, usageLimit, startsAt, endsAt, payload.Active}
+
+	id := payload.Id
+	if id == 0 {
+		result, err := tx.ExecContext(ctx, `
+		INSERT INTO promotions (code, name, type, percentage, amount, product_id, buy_quantity, get_quantity, min_quantity, min_order_total, usage_limit, starts_at, ends_at, active)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		if id, err = result.LastInsertId(); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := getPromotion(ctx, tx, id); err != nil {
+			return nil, err
+		}
+
+		_, err := tx.ExecContext(ctx, `
+		UPDATE promotions
+		SET code = ?, name = ?, type = ?, percentage = ?, amount = ?, product_id = ?, buy_quantity = ?, get_quantity = ?,
+			min_quantity = ?, min_order_total = ?, usage_limit = ?, starts_at = ?, ends_at = ?, active = ?
+		WHERE id = ?
+		`, append(args, id)...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	promotion, err := getPromotion(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return promotion, nil
+}
+
+func (s *ordersStore) GetPromotion(ctx context.Context, id int64) (*pb.Promotion, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get promotion", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	promotion, err := getPromotion(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return promotion, nil
+}
+
+func (s *ordersStore) ListPromotions(ctx context.Context, activeOnly bool, now time.Time) ([]*pb.Promotion, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("list promotions", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var promotions []*pb.Promotion
+	if activeOnly {
+		promotions, err = queryPromotions(ctx, tx, `WHERE `+activePromotionFilter, now, now)
+	} else {
+		promotions, err = queryPromotions(ctx, tx, ``)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return promotions, nil
+}
+
+// active promotions in their validity window that apply on their own or
+// whose coupon code was entered
+func (s *ordersStore) ApplicablePromotions(ctx context.Context, coupons []string, now time.Time) ([]*pb.Promotion, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("applicable promotions", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	where := `WHERE ` + activePromotionFilter + ` AND (p.code IS NULL`
+	args := []any{now, now}
+	if len(coupons) > 0 {
+		where += ` OR p.code IN (?` + strings.Repeat(", ?", len(coupons)-1) + `)`
+		for _, code := range coupons {
+			args = append(args, code)
+		}
+	}
+	where += `)`
+
+	promotions, err := queryPromotions(ctx, tx, where, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return promotions, nil
+}
+
+// orders keep their itemized discounts, only the link to the promotion goes
+func (s *ordersStore) DeletePromotion(ctx context.Context, id int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("delete promotion", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM promotions WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errPromotionNotFound
+	}
+
+	return tx.Commit()
+}
+
+var (
+	errReturnNotFound = errors.New("return not found")
+	errReturnState    = errors.New("return cannot change in its current status")
+	errInvalidReturn  = errors.New("invalid return")
+)
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func returnNumber(id int64) string {
+	return fmt.Sprintf("RMA-%06d", id)
+}
+
+const returnColumns = `id, order_id, status, reason, COALESCE(note, ''), created_at, COALESCE(received_at, ''), COALESCE(closed_at, '')`
+
+func scanReturn(row rowScanner) (*pb.Return, error) {
+	var ret pb.Return
+	if err := row.Scan(&ret.Id, &ret.OrderId, &ret.Status, &ret.Reason, &ret.Note, &ret.CreatedAt, &ret.ReceivedAt, &ret.ClosedAt); err != nil {
+		return nil, err
+	}
+	ret.Number = returnNumber(ret.Id)
+	return &ret, nil
+}
+
+func getReturn(ctx context.Context, tx *sql.Tx, id int64) (*pb.Return, error) {
+	ret, err := scanReturn(tx.QueryRowContext(ctx, `SELECT `+returnColumns+` FROM returns WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, errReturnNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT rl.id, rl.order_item_id, oi.product_id, rl.quantity, oi.unit, COALESCE(rl.disposition, ''), COALESCE(rl.location_id, 0), COALESCE(rl.movement_id, 0), COALESCE(rl.dispositioned_at, '')
+	FROM return_lines rl
+	JOIN order_items oi ON oi.id = rl.order_item_id
+	WHERE rl.return_id = ?
+	ORDER BY rl.id
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line pb.ReturnLine
+		if err := rows.Scan(&line.Id, &line.OrderItemId, &line.ProductId, &line.Quantity, &line.Unit, &line.Disposition, &line.LocationId, &line.MovementId, &line.DispositionedAt); err != nil {
+			return nil, err
+		}
+		ret.Lines = append(ret.Lines, &line)
+	}
+
+	return ret, rows.Err()
+}
+
+func lockReturn(ctx context.Context, tx *sql.Tx, id int64, allowed ...string) (int64, error) {
+	var orderId int64
+	var returnStatus string
+	if err := tx.QueryRowContext(ctx, `SELECT order_id, status FROM returns WHERE id = ? FOR UPDATE`, id).Scan(&orderId, &returnStatus); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, errReturnNotFound
+		}
+		return 0, err
+	}
+
+	for _, status := range allowed {
+		if returnStatus == status {
+			return orderId, nil
+		}
+	}
+
+	return 0, fmt.Errorf("return %s is %s: %w", returnNumber(id), returnStatus, errReturnState)
+}
+
+type ReturnLineDto struct {
+	OrderItemId int64
+	Quantity    int64
+}
+
+type CreateReturnDto struct {
+	OrderId int64
+	Reason  string
+	Note    string
+	Lines   []ReturnLineDto
+}
+
+// only fulfilled orders can be returned and no line can be returned more
+// than was ordered across all returns that were not cancelled
+func (s *ordersStore) CreateReturn(ctx context.Context, payload *CreateReturnDto) (*pb.Return, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("create return", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var orderStatus string
+	if err := tx.QueryRowContext(ctx, `SELECT status FROM orders WHERE id = ? FOR UPDATE`, payload.OrderId).Scan(&orderStatus); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errOrderNotFound
+		}
+		return nil, err
+	}
+
+	if orderStatus != "delivered" && orderStatus != "partially_returned" {
+		return nil, fmt.Errorf("order %d is %s: %w", payload.OrderId, orderStatus, errReturnState)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT oi.id, oi.quantity - COALESCE((
+		SELECT SUM(rl.quantity)
+		FROM return_lines rl
+		JOIN returns r ON r.id = rl.return_id
+		WHERE rl.order_item_id = oi.id AND r.status <> 'cancelled'
+	), 0)
+	FROM order_items oi
+	WHERE oi.order_id = ?
+	`, payload.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	returnable := make(map[int64]int64)
+	for rows.Next() {
+		var itemId, quantity int64
+		if err := rows.Scan(&itemId, &quantity); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		returnable[itemId] = quantity
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, line := range payload.Lines {
+		remaining, ok := returnable[line.OrderItemId]
+		if !ok {
+			return nil, fmt.Errorf("item %d is not on order %d: %w", line.OrderItemId, payload.OrderId, errInvalidReturn)
+		}
+		if line.Quantity <= 0 {
+			return nil, fmt.Errorf("item %d: quantity must be positive: %w", line.OrderItemId, errInvalidReturn)
+		}
+		if line.Quantity > remaining {
+			return nil, fmt.Errorf("item %d: returning %d but only %d can be returned: %w", line.OrderItemId, line.Quantity, remaining, errInvalidReturn)
+		}
+		returnable[line.OrderItemId] -= line.Quantity
+	}
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO returns (order_id, reason, note) VALUES (?, ?, ?)`, payload.OrderId, payload.Reason, payload.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range payload.Lines {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO return_lines (return_id, order_item_id, quantity) VALUES (?, ?, ?)`, id, line.OrderItemId, line.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	ret, err := getReturn(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// receiving a return moves the order to returned once every item has come
+// back in full, otherwise to partially returned
+func (s *ordersStore) ReceiveReturn(ctx context.Context, id int64) (*pb.Return, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("receive return", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	orderId, err := lockReturn(ctx, tx, id, "requested")
+	if err != nil {
+		return nil, err
+	}
+
+	var fromStatus, toStatus string
+	if err := tx.QueryRowContext(ctx, `SELECT status FROM orders WHERE id = ? FOR UPDATE`, orderId).Scan(&fromStatus); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE returns SET status = 'received', received_at = CURRENT_TIMESTAMP WHERE id = ?`, id); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE orders o
+	SET o.status = IF(EXISTS (
+		SELECT 1 FROM order_items oi
+		WHERE oi.order_id = o.id AND oi.quantity > `+returnedQuantityQuery+`
+	), 'partially_returned', 'returned')
+	WHERE o.id = ?
+	`, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.QueryRowContext(ctx, `SELECT status FROM orders WHERE id = ?`, orderId).Scan(&toStatus); err != nil {
+		return nil, err
+	}
+
+	if toStatus != fromStatus {
+		if err := recordStatusChange(ctx, tx, orderId, fromStatus, toStatus, "", returnNumber(id)+" received"); err != nil {
+			return nil, err
+		}
+	}
+
+	ret, err := getReturn(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (s *ordersStore) CancelReturn(ctx context.Context, id int64) (*pb.Return, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("cancel return", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if _, err := lockReturn(ctx, tx, id, "requested"); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE returns SET status = 'cancelled', closed_at = CURRENT_TIMESTAMP WHERE id = ?`, id); err != nil {
+		return nil, err
+	}
+
+	ret, err := getReturn(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+type LineDispositionDto struct {
+	LineId        int64
+	Disposition   string
+	LocationId    int64
+	SerialNumbers []string
+}
+
+type DispositionReturnDto struct {
+	Id    int64
+	Lines []LineDispositionDto
+}
+
+// carries out one line's disposition in inventory, quantity is in base units
+// and reference is the return number. Restocked lines return the movement that
+// put them back in stock, scrapped lines move no stock and return nil.
+type dispositionFunc func(ctx context.Context, productId, quantity int64, reference string, line *LineDispositionDto) (*pb.StockMovement, error)
+
+// the return stays locked while lines are dispositioned so a line cannot be
+// dispositioned twice. Every line still open needs a disposition, after which
+// the return closes. Movements posted before a failure are returned with the
+// error for the caller to reverse.
+func (s *ordersStore) DispositionReturn(ctx context.Context, payload *DispositionReturnDto, disposition dispositionFunc) (*pb.Return, []*pb.StockMovement, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("disposition return", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if _, err := lockReturn(ctx, tx, payload.Id, "received"); err != nil {
+		return nil, nil, err
+	}
+
+	// lines sold in a larger unit go back in the base units they took out
+	rows, err := tx.QueryContext(ctx, `
+	SELECT rl.id, oi.product_id, rl.quantity * COALESCE(oi.base_quantity DIV oi.quantity, 1), rl.disposition IS NOT NULL
+	FROM return_lines rl
+	JOIN order_items oi ON oi.id = rl.order_item_id
+	WHERE rl.return_id = ?
+	ORDER BY rl.id
+	`, payload.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var lineIds []int64
+	products := make(map[int64]int64)
+	quantities := make(map[int64]int64)
+	dispositioned := make(map[int64]bool)
+	for rows.Next() {
+		var lineId, productId, quantity int64
+		var done bool
+		if err := rows.Scan(&lineId, &productId, &quantity, &done); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		lineIds = append(lineIds, lineId)
+		products[lineId] = productId
+		quantities[lineId] = quantity
+		dispositioned[lineId] = done
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	for _, line := range payload.Lines {
+		if _, ok := products[line.LineId]; !ok {
+			return nil, nil, fmt.Errorf("line %d is not on return %s: %w", line.LineId, returnNumber(payload.Id), errInvalidReturn)
+		}
+		if line.Disposition != "restock" && line.Disposition != "scrap" {
+			return nil, nil, fmt.Errorf("line %d: disposition must be restock or scrap: %w", line.LineId, errInvalidReturn)
+		}
+		if dispositioned[line.LineId] {
+			return nil, nil, fmt.Errorf("line %d already has a disposition: %w", line.LineId, errInvalidReturn)
+		}
+		dispositioned[line.LineId] = true
+	}
+
+	for _, lineId := range lineIds {
+		if !dispositioned[lineId] {
+			return nil, nil, fmt.Errorf("line %d needs a disposition: %w", lineId, errInvalidReturn)
+		}
+	}
+
+	reference := returnNumber(payload.Id)
+
+	var posted []*pb.StockMovement
+	for i := range payload.Lines {
+		line := &payload.Lines[i]
+
+		movement, err := disposition(ctx, products[line.LineId], quantities[line.LineId], reference, line)
+		if err != nil {
+			return nil, posted, fmt.Errorf("line %d: %w", line.LineId, err)
+		}
+
+		var locationId, movementId any
+		if movement != nil {
+			posted = append(posted, movement)
+			locationId, movementId = movement.LocationId, movement.Id
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		UPDATE return_lines
+		SET disposition = ?, location_id = ?, movement_id = ?, dispositioned_at = CURRENT_TIMESTAMP
+		WHERE id = ?
+		`, line.Disposition, locationId, movementId, line.LineId)
+		if err != nil {
+			return nil, posted, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE returns SET status = 'closed', closed_at = CURRENT_TIMESTAMP WHERE id = ?`, payload.Id)
+	if err != nil {
+		return nil, posted, err
+	}
+
+	ret, err := getReturn(ctx, tx, payload.Id)
+	if err != nil {
+		return nil, posted, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, posted, err
+	}
+
+	return ret, posted, nil
+}
+
+func (s *ordersStore) GetReturn(ctx context.Context, id int64) (*pb.Return, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get return", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	ret, err := getReturn(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (s *ordersStore) ListReturns(ctx context.Context, orderId int64, returnStatus string) ([]*pb.Return, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("list returns", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var conditions []string
+	var args []any
+	if orderId > 0 {
+		conditions = append(conditions, "order_id = ?")
+		args = append(args, orderId)
+	}
+	if returnStatus != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, returnStatus)
+	}
+
+	query := `SELECT id FROM returns`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id DESC"
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	returns := make([]*pb.Return, 0, len(ids))
+	for _, id := range ids {
+		ret, err := getReturn(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		returns = append(returns, ret)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return returns, nil
+}
+
+func (s *ordersStore) GetOrderStatusHistory(ctx context.Context, orderId int64) (*pb.OrderStatusHistory, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get order status history", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var exists bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM orders WHERE id = ?)`, orderId).Scan(&exists); err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errOrderNotFound
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT COALESCE(from_status, ''), to_status, COALESCE(changed_by, ''), COALESCE(note, ''), created_at
+	FROM order_status_history
+	WHERE order_id = ?
+	ORDER BY id
+	`, orderId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := &pb.OrderStatusHistory{OrderId: orderId}
+	for rows.Next() {
+		var change pb.OrderStatusChange
+		if err := rows.Scan(&change.FromStatus, &change.ToStatus, &change.ChangedBy, &change.Note, &change.ChangedAt); err != nil {
+			return nil, err
+		}
+		history.Changes = append(history.Changes, &change)
+	}
+
+	return history, rows.Err()
+}
+
+var (
+	errShipmentNotFound = errors.New("shipment not found")
+	errShipmentState    = errors.New("order cannot be shipped in its current status")
+	errInvalidShipment  = errors.New("invalid shipment")
+)
+
+func shipmentNumber(id int64) string {
+	return fmt.Sprintf("SHP-%06d", id)
+}
+
+const shipmentColumns = `id, order_id, carrier, tracking_number, COALESCE(shipped_by, ''), created_at`
+
+func scanShipment(row rowScanner) (*pb.Shipment, error) {
+	var shipment pb.Shipment
+	if err := row.Scan(&shipment.Id, &shipment.OrderId, &shipment.Carrier, &shipment.TrackingNumber, &shipment.ShippedBy, &shipment.CreatedAt); err != nil {
+		return nil, err
+	}
+	shipment.Number = shipmentNumber(shipment.Id)
+	return &shipment, nil
+}
+
+func getShipment(ctx context.Context, tx *sql.Tx, id int64) (*pb.Shipment, error) {
+	shipment, err := scanShipment(tx.QueryRowContext(ctx, `SELECT `+shipmentColumns+` FROM shipments WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, errShipmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT sl.id, sl.order_item_id, oi.product_id, sl.quantity, sl.base_quantity, sl.movement_id
+	FROM shipment_lines sl
+	JOIN order_items oi ON oi.id = sl.order_item_id
+	WHERE sl.shipment_id = ?
+	ORDER BY sl.id
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line pb.ShipmentLine
+		if err := rows.Scan(&line.Id, &line.OrderItemId, &line.ProductId, &line.Quantity, &line.BaseQuantity, &line.MovementId); err != nil {
+			return nil, err
+		}
+		shipment.Lines = append(shipment.Lines, &line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return shipment, nil
+}
+
+type ShipmentLineDto struct {
+	OrderItemId int64
+	Quantity    int64
+}
+
+type CreateShipmentDto struct {
+	OrderId        int64
+	Carrier        string
+	TrackingNumber string
+	ShippedBy      string
+	Lines          []ShipmentLineDto
+}
+
+// takes the base units of one shipment line out of stock, from the item's
+// reservation when it has one, with the shipment number as reference
+type shipmentCommitFunc func(ctx context.Context, reservationId, productId, baseQuantity int64, reference string) (*pb.StockMovement, error)
+
+// the order stays locked while the lines are committed so two shipments
+// cannot send the same units. Movements posted before a failure are returned
+// with the error for the caller to reverse. The order becomes shipped once
+// every item has gone out in full, otherwise partially shipped.
+func (s *ordersStore) CreateShipment(ctx context.Context, payload *CreateShipmentDto, commit shipmentCommitFunc) (*pb.Shipment, []*pb.StockMovement, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("create shipment", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var orderStatus string
+	if err := tx.QueryRowContext(ctx, `SELECT status FROM orders WHERE id = ? FOR UPDATE`, payload.OrderId).Scan(&orderStatus); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, errOrderNotFound
+		}
+		return nil, nil, err
+	}
+
+	if orderStatus != "confirmed" && orderStatus != "picking" && orderStatus != "partially_shipped" {
+		return nil, nil, fmt.Errorf("order %d is %s: %w", payload.OrderId, orderStatus, errShipmentState)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT oi.id, oi.product_id, COALESCE(oi.reservation_id, 0), COALESCE(oi.base_quantity DIV oi.quantity, 1),
+		oi.quantity - `+shippedQuantityQuery+`
+	FROM order_items oi
+	WHERE oi.order_id = ?
+	`, payload.OrderId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type shippableItem struct {
+		productId, reservationId, factor, remaining int64
+	}
+
+	items := make(map[int64]*shippableItem)
+	for rows.Next() {
+		var itemId int64
+		var item shippableItem
+		if err := rows.Scan(&itemId, &item.productId, &item.reservationId, &item.factor, &item.remaining); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		items[itemId] = &item
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	for _, line := range payload.Lines {
+		item, ok := items[line.OrderItemId]
+		if !ok {
+			return nil, nil, fmt.Errorf("item %d is not on order %d: %w", line.OrderItemId, payload.OrderId, errInvalidShipment)
+		}
+		if line.Quantity <= 0 {
+			return nil, nil, fmt.Errorf("item %d: quantity must be positive: %w", line.OrderItemId, errInvalidShipment)
+		}
+		if line.Quantity > item.remaining {
+			return nil, nil, fmt.Errorf("item %d: shipping %d but only %d are left to ship: %w", line.OrderItemId, line.Quantity, item.remaining, errInvalidShipment)
+		}
+		item.remaining -= line.Quantity
+	}
+
+	var shippedBy any
+	if payload.ShippedBy != "" {
+		shippedBy = payload.ShippedBy
+	}
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO shipments (order_id, carrier, tracking_number, shipped_by) VALUES (?, ?, ?, ?)`, payload.OrderId, payload.Carrier, payload.TrackingNumber, shippedBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reference := shipmentNumber(id)
+
+	var posted []*pb.StockMovement
+	for _, line := range payload.Lines {
+		item := items[line.OrderItemId]
+		baseQuantity := line.Quantity * item.factor
+
+		movement, err := commit(ctx, item.reservationId, item.productId, baseQuantity, reference)
+		if err != nil {
+			return nil, posted, fmt.Errorf("item %d: %w", line.OrderItemId, err)
+		}
+		posted = append(posted, movement)
+
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO shipment_lines (shipment_id, order_item_id, quantity, base_quantity, movement_id)
+		VALUES (?, ?, ?, ?, ?)
+		`, id, line.OrderItemId, line.Quantity, baseQuantity, movement.Id)
+		if err != nil {
+			return nil, posted, err
+		}
+
+		if err := recordItemCommit(ctx, tx, line.OrderItemId, movement); err != nil {
+			return nil, posted, err
+		}
+	}
+
+	newStatus := "shipped"
+	for _, item := range items {
+		if item.remaining > 0 {
+			newStatus = "partially_shipped"
+			break
+		}
+	}
+
+	if newStatus != orderStatus {
+		if _, err := tx.ExecContext(ctx, `UPDATE orders SET status = ? WHERE id = ?`, newStatus, payload.OrderId); err != nil {
+			return nil, posted, err
+		}
+
+		if err := recordStatusChange(ctx, tx, payload.OrderId, orderStatus, newStatus, payload.ShippedBy, reference+" sent"); err != nil {
+			return nil, posted, err
+		}
+	}
+
+	shipment, err := getShipment(ctx, tx, id)
+	if err != nil {
+		return nil, posted, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, posted, err
+	}
+
+	return shipment, posted, nil
+}
+
+func (s *ordersStore) GetShipment(ctx context.Context, id int64) (*pb.Shipment, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get shipment", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	shipment, err := getShipment(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return shipment, nil
+}
+
+func (s *ordersStore) ListShipments(ctx context.Context, orderId int64) ([]*pb.Shipment, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("list shipments", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var exists bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM orders WHERE id = ?)`, orderId).Scan(&exists); err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, errOrderNotFound
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM shipments WHERE order_id = ? ORDER BY id`, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	shipments := make([]*pb.Shipment, 0, len(ids))
+	for _, id := range ids {
+		shipment, err := getShipment(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		shipments = append(shipments, shipment)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return shipments, nil
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/logan2k02/ims/shared/protobuf"
 	"google.golang.org/grpc/codes"
@@ -69,3 +70,64 @@ func (h *productsGRPCHandler) UpdateProduct(ctx context.Context, payload *pb.Upd
 
 	return product, nil
 }
+
+func productsStatusError(err error) error {
+	switch {
+	case errors.Is(err, errProductNotFound), errors.Is(err, errKitNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errInvalidKit), errors.Is(err, errInvalidUnits):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (h *productsGRPCHandler) SetKitComponents(ctx context.Context, payload *pb.SetKitComponentsRequest) (*pb.Kit, error) {
+	for _, component := range payload.Components {
+		if component.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "component %d: quantity must be positive", component.ProductId)
+		}
+	}
+
+	kit, err := h.service.SetKitComponents(ctx, payload)
+	if err != nil {
+		return nil, productsStatusError(err)
+	}
+
+	return kit, nil
+}
+
+func (h *productsGRPCHandler) GetKit(ctx context.Context, payload *pb.ProductIdRequest) (*pb.Kit, error) {
+	kit, err := h.service.GetKit(ctx, payload)
+	if err != nil {
+		return nil, productsStatusError(err)
+	}
+
+	return kit, nil
+}
+
+func (h *productsGRPCHandler) SetProductUnits(ctx context.Context, payload *pb.SetProductUnitsRequest) (*pb.ProductUnits, error) {
+	for _, unit := range payload.Units {
+		if unit.Code == "" || unit.Name == "" {
+			return nil, status.Error(codes.InvalidArgument, "unit code and name are required")
+		}
+		if unit.Factor <= 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "unit %s: factor must be greater than one", unit.Code)
+		}
+	}
+
+	units, err := h.service.SetProductUnits(ctx, payload)
+	if err != nil {
+		return nil, productsStatusError(err)
+	}
+
+	return units, nil
+}
+
+func (h *productsGRPCHandler) ListProductUnits(ctx context.Context, payload *pb.ProductIdRequest) (*pb.ProductUnits, error) {
+	units, err := h.service.ListProductUnits(ctx, payload)
+	if err != nil {
+		return nil, productsStatusError(err)
+	}
+
+	return units, nil
+}
@@ -54,3 +54,36 @@ func (s *productsService) UpdateProduct(ctx context.Context, payload *pb.UpdateP
 
 	return product, err
 }
+
+func (s *productsService) SetKitComponents(ctx context.Context, payload *pb.SetKitComponentsRequest) (*pb.Kit, error) {
+	components := make([]KitComponentDto, len(payload.Components))
+	for i, component := range payload.Components {
+		components[i] = KitComponentDto{
+			ProductId: component.ProductId,
+			Quantity:  component.Quantity,
+		}
+	}
+
+	return s.store.SetKitComponents(ctx, payload.KitId, components)
+}
+
+func (s *productsService) GetKit(ctx context.Context, payload *pb.ProductIdRequest) (*pb.Kit, error) {
+	return s.store.GetKit(ctx, payload.Id)
+}
+
+func (s *productsService) SetProductUnits(ctx context.Context, payload *pb.SetProductUnitsRequest) (*pb.ProductUnits, error) {
+	units := make([]ProductUnitDto, len(payload.Units))
+	for i, unit := range payload.Units {
+		units[i] = ProductUnitDto{
+			Code:   unit.Code,
+			Name:   unit.Name,
+			Factor: unit.Factor,
+		}
+	}
+
+	return s.store.SetProductUnits(ctx, payload.ProductId, units)
+}
+
+func (s *productsService) ListProductUnits(ctx context.Context, payload *pb.ProductIdRequest) (*pb.ProductUnits, error) {
+	return s.store.ListProductUnits(ctx, payload.Id)
+}
@@ -3,12 +3,14 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
 	pb "github.com/logan2k02/ims/shared/protobuf"
+	"github.com/logan2k02/ims/shared/schema"
 	"github.com/logan2k02/ims/shared/utils"
 )
 
@@ -82,6 +84,11 @@ func (s *productsStore) Init() error {
 		reorder_level INT DEFAULT 0,
     	reorder_quantity INT DEFAULT 0,
 		stock_quantity INT NOT NULL DEFAULT 0,
+		reserved_quantity INT NOT NULL DEFAULT 0,
+		held_quantity INT NOT NULL DEFAULT 0,
+		allow_backorder BOOLEAN NOT NULL DEFAULT FALSE,
+		serialized BOOLEAN NOT NULL DEFAULT FALSE,
+		costing_method ENUM('fifo', 'average') NOT NULL DEFAULT 'fifo',
 		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 	);
 	`)
@@ -89,14 +96,87 @@ func (s *productsStore) Init() error {
 		return err
 	}
 
+	if err := schema.AddColumn(ctx, tx, "products", "reserved_quantity", "INT NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "products", "allow_backorder", "BOOLEAN NOT NULL DEFAULT FALSE"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "products", "serialized", "BOOLEAN NOT NULL DEFAULT FALSE"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "products", "costing_method", "ENUM('fifo', 'average') NOT NULL DEFAULT 'fifo'"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "products", "held_quantity", "INT NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS kit_components (
+		kit_id INT NOT NULL,
+		component_id INT NOT NULL,
+		quantity INT NOT NULL,
+		PRIMARY KEY (kit_id, component_id),
+		INDEX (component_id),
+		FOREIGN KEY (kit_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (component_id) REFERENCES products(id) ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS product_units (
+		product_id INT NOT NULL,
+		code VARCHAR(20) NOT NULL,
+		name VARCHAR(100) NOT NULL,
+		factor INT NOT NULL,
+		PRIMARY KEY (product_id, code),
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
-func rowToProduct(row *sql.Row) (*pb.Product, error) {
+// units of a kit the available stock of its scarcest component can still
+// make, NULL for products without components
+const buildableQuantityQuery = `
+(SELECT CAST(MIN(FLOOR(GREATEST(c.stock_quantity - c.reserved_quantity - c.held_quantity, 0) / k.quantity)) AS SIGNED)
+FROM kit_components k
+JOIN products c ON c.id = k.component_id
+WHERE k.kit_id = products.id)`
+
+const productColumns = `id, name, sku, description, price, reorder_level, reorder_quantity, stock_quantity, reserved_quantity, held_quantity, allow_backorder, serialized, costing_method, created_at, ` + buildableQuantityQuery
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func rowToProduct(row rowScanner) (*pb.Product, error) {
 	var product pb.Product
-	if err := row.Scan(&product.Id, &product.Name, &product.Sku, &product.Description, &product.Price, &product.ReorderLevel, &product.ReorderQuantity, &product.StockQuantity, &product.CreatedAt); err != nil {
+	var buildableQuantity sql.NullInt64
+	if err := row.Scan(&product.Id, &product.Name, &product.Sku, &product.Description, &product.Price, &product.ReorderLevel, &product.ReorderQuantity, &product.StockQuantity, &product.ReservedQuantity, &product.HeldQuantity, &product.AllowBackorder, &product.Serialized, &product.CostingMethod, &product.CreatedAt, &buildableQuantity); err != nil {
 		return nil, err
 	}
+	product.AvailableQuantity = product.StockQuantity - product.ReservedQuantity - product.HeldQuantity
+
+	// a kit is available as assembled stock plus whatever its components can
+	// still be assembled into
+	if buildableQuantity.Valid {
+		product.IsKit = true
+		product.BuildableQuantity = buildableQuantity.Int64
+		product.AvailableQuantity += buildableQuantity.Int64
+	}
 	return &product, nil
 }
 
@@ -111,12 +191,17 @@ func (s *productsStore) CreateProduct(ctx context.Context, payload *pb.CreatePro
 		}
 	}()
 
+	costingMethod := payload.CostingMethod
+	if costingMethod == "" {
+		costingMethod = "fifo"
+	}
+
 	query := `
-	INSERT INTO products (name, sku, description, price, reorder_level, reorder_quantity, stock_quantity)
-	VALUES (?,?,?,?,?,?,?)
+	INSERT INTO products (name, sku, description, price, reorder_level, reorder_quantity, stock_quantity, allow_backorder, serialized, costing_method)
+	VALUES (?,?,?,?,?,?,?,?,?,?)
 	`
 
-	result, err := tx.ExecContext(ctx, query, payload.Name, payload.Sku, payload.Description, payload.Price, payload.ReorderLevel, payload.ReorderQuantity, payload.InitialQuantity)
+	result, err := tx.ExecContext(ctx, query, payload.Name, payload.Sku, payload.Description, payload.Price, payload.ReorderLevel, payload.ReorderQuantity, payload.InitialQuantity, payload.AllowBackorder, payload.Serialized, costingMethod)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +211,7 @@ func (s *productsStore) CreateProduct(ctx context.Context, payload *pb.CreatePro
 		return nil, err
 	}
 
-	row := tx.QueryRowContext(ctx, `SELECT id, name, sku, description, price, reorder_level, reorder_quantity, stock_quantity, created_at FROM products WHERE id = ?`, insertedId)
+	row := tx.QueryRowContext(ctx, `SELECT `+productColumns+` FROM products WHERE id = ?`, insertedId)
 
 	insertedProduct, err := rowToProduct(row)
 	if err != nil {
@@ -151,7 +236,7 @@ func (s *productsStore) GetProducts(ctx context.Context, ids []int64) ([]*pb.Pro
 		}
 	}()
 
-	query := "SELECT id,name,sku,description,price, reorder_level, reorder_quantity, stock_quantity,created_at FROM products"
+	query := "SELECT " + productColumns + " FROM products"
 	args := make([]any, len(ids))
 	if len(ids) > 0 {
 		placeholders := make([]string, len(ids))
@@ -170,11 +255,11 @@ func (s *productsStore) GetProducts(ctx context.Context, ids []int64) ([]*pb.Pro
 
 	var products []*pb.Product
 	for rows.Next() {
-		var product pb.Product
-		if err := rows.Scan(&product.Id, &product.Name, &product.Sku, &product.Description, &product.Price, &product.ReorderLevel, &product.ReorderQuantity, &product.StockQuantity, &product.CreatedAt); err != nil {
+		product, err := rowToProduct(rows)
+		if err != nil {
 			return nil, err
 		}
-		products = append(products, &product)
+		products = append(products, product)
 	}
 	if err = rows.Err(); err != nil {
 		return nil, err
@@ -221,16 +306,16 @@ func (s *productsStore) UpdateProduct(ctx context.Context, payload *pb.UpdatePro
 
 	query := `
 	UPDATE products
-	SET name = ?,sku = ?, description = ?, price = ?, reorder_level=?, reorder_quantity=?
+	SET name = ?,sku = ?, description = ?, price = ?, reorder_level=?, reorder_quantity=?, allow_backorder=?, serialized=?, costing_method = COALESCE(NULLIF(?, ''), costing_method)
 	WHERE id = ?
 	`
 
-	_, err = tx.ExecContext(ctx, query, payload.Name, payload.Sku, payload.Description, payload.Price, payload.ReorderLevel, payload.ReorderQuantity, payload.Id)
+	_, err = tx.ExecContext(ctx, query, payload.Name, payload.Sku, payload.Description, payload.Price, payload.ReorderLevel, payload.ReorderQuantity, payload.AllowBackorder, payload.Serialized, payload.CostingMethod, payload.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	row := tx.QueryRowContext(ctx, `SELECT id, name, sku, description, price,reorder_level,reorder_quantity,stock_quantity, created_at FROM products WHERE id = ?`, payload.Id)
+	row := tx.QueryRowContext(ctx, `SELECT `+productColumns+` FROM products WHERE id = ?`, payload.Id)
 
 	updatedProduct, err := rowToProduct(row)
 	if err != nil {
@@ -243,3 +328,263 @@ func (s *productsStore) UpdateProduct(ctx context.Context, payload *pb.UpdatePro
 
 	return updatedProduct, nil
 }
+
+var (
+	errProductNotFound = errors.New("product not found")
+	errKitNotFound     = errors.New("kit not found")
+	errInvalidKit      = errors.New("invalid kit")
+	errInvalidUnits    = errors.New("invalid units of measure")
+)
+
+type KitComponentDto struct {
+	ProductId int64
+	Quantity  int64
+}
+
+func getKit(ctx context.Context, tx *sql.Tx, kitId int64) (*pb.Kit, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT component_id, quantity FROM kit_components WHERE kit_id = ? ORDER BY component_id`, kitId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	kit := &pb.Kit{KitId: kitId}
+	for rows.Next() {
+		var component pb.KitComponent
+		if err := rows.Scan(&component.ProductId, &component.Quantity); err != nil {
+			return nil, err
+		}
+		kit.Components = append(kit.Components, &component)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(kit.Components) == 0 {
+		return kit, nil
+	}
+
+	if err := tx.QueryRowContext(ctx, `SELECT `+buildableQuantityQuery+` FROM products WHERE id = ?`, kitId).Scan(&kit.BuildableQuantity); err != nil {
+		return nil, err
+	}
+
+	return kit, nil
+}
+
+// kits are a single level deep, a kit cannot be a component of another kit
+// and serialized products can be neither since assembly does not track units
+func (s *productsStore) SetKitComponents(ctx context.Context, kitId int64, components []KitComponentDto) (*pb.Kit, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("set kit components", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var serialized bool
+	if err := tx.QueryRowContext(ctx, `SELECT serialized FROM products WHERE id = ? FOR UPDATE`, kitId).Scan(&serialized); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("product %d: %w", kitId, errProductNotFound)
+		}
+		return nil, err
+	}
+
+	if len(components) > 0 {
+		if serialized {
+			return nil, fmt.Errorf("product %d is serialized: %w", kitId, errInvalidKit)
+		}
+
+		var usedIn int64
+		err := tx.QueryRowContext(ctx, `SELECT kit_id FROM kit_components WHERE component_id = ? LIMIT 1`, kitId).Scan(&usedIn)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		if err == nil {
+			return nil, fmt.Errorf("product %d is a component of kit %d: %w", kitId, usedIn, errInvalidKit)
+		}
+	}
+
+	seen := make(map[int64]bool, len(components))
+	for _, component := range components {
+		if component.ProductId == kitId {
+			return nil, fmt.Errorf("kit %d cannot contain itself: %w", kitId, errInvalidKit)
+		}
+		if seen[component.ProductId] {
+			return nil, fmt.Errorf("component %d is given more than once: %w", component.ProductId, errInvalidKit)
+		}
+		seen[component.ProductId] = true
+
+		var componentSerialized, isKit bool
+		err := tx.QueryRowContext(ctx, `
+		SELECT serialized, EXISTS (SELECT 1 FROM kit_components WHERE kit_id = p.id)
+		FROM products p
+		WHERE id = ?
+		FOR UPDATE
+		`, component.ProductId).Scan(&componentSerialized, &isKit)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("component %d: %w", component.ProductId, errProductNotFound)
+			}
+			return nil, err
+		}
+
+		if componentSerialized {
+			return nil, fmt.Errorf("component %d is serialized: %w", component.ProductId, errInvalidKit)
+		}
+		if isKit {
+			return nil, fmt.Errorf("component %d is itself a kit: %w", component.ProductId, errInvalidKit)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM kit_components WHERE kit_id = ?`, kitId); err != nil {
+		return nil, err
+	}
+
+	for _, component := range components {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO kit_components (kit_id, component_id, quantity) VALUES (?, ?, ?)`, kitId, component.ProductId, component.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	kit, err := getKit(ctx, tx, kitId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return kit, nil
+}
+
+func (s *productsStore) GetKit(ctx context.Context, kitId int64) (*pb.Kit, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get kit", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	kit, err := getKit(ctx, tx, kitId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(kit.Components) == 0 {
+		return nil, fmt.Errorf("product %d: %w", kitId, errKitNotFound)
+	}
+
+	return kit, nil
+}
+
+// every product is counted in its base unit, other units are multiples of it
+const baseUnit = "each"
+
+type ProductUnitDto struct {
+	Code   string
+	Name   string
+	Factor int64
+}
+
+func getProductUnits(ctx context.Context, tx *sql.Tx, productId int64) (*pb.ProductUnits, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT code, name, factor FROM product_units WHERE product_id = ? ORDER BY factor, code`, productId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	units := &pb.ProductUnits{
+		ProductId: productId,
+		Units:     []*pb.ProductUnit{{Code: baseUnit, Name: "Each", Factor: 1}},
+	}
+	for rows.Next() {
+		var unit pb.ProductUnit
+		if err := rows.Scan(&unit.Code, &unit.Name, &unit.Factor); err != nil {
+			return nil, err
+		}
+		units.Units = append(units.Units, &unit)
+	}
+
+	return units, rows.Err()
+}
+
+func (s *productsStore) SetProductUnits(ctx context.Context, productId int64, units []ProductUnitDto) (*pb.ProductUnits, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("set product units", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var id int64
+	if err := tx.QueryRowContext(ctx, `SELECT id FROM products WHERE id = ? FOR UPDATE`, productId).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("product %d: %w", productId, errProductNotFound)
+		}
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(units))
+	for _, unit := range units {
+		if unit.Code == baseUnit {
+			return nil, fmt.Errorf("%s is the base unit: %w", baseUnit, errInvalidUnits)
+		}
+		if seen[unit.Code] {
+			return nil, fmt.Errorf("unit %s is given more than once: %w", unit.Code, errInvalidUnits)
+		}
+		seen[unit.Code] = true
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_units WHERE product_id = ?`, productId); err != nil {
+		return nil, err
+	}
+
+	for _, unit := range units {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO product_units (product_id, code, name, factor) VALUES (?, ?, ?, ?)`, productId, unit.Code, unit.Name, unit.Factor); err != nil {
+			return nil, err
+		}
+	}
+
+	productUnits, err := getProductUnits(ctx, tx, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return productUnits, nil
+}
+
+func (s *productsStore) ListProductUnits(ctx context.Context, productId int64) (*pb.ProductUnits, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("list product units", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var id int64
+	if err := tx.QueryRowContext(ctx, `SELECT id FROM products WHERE id = ?`, productId).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("product %d: %w", productId, errProductNotFound)
+		}
+		return nil, err
+	}
+
+	return getProductUnits(ctx, tx, productId)
+}
@@ -26,6 +26,9 @@ type PurchaseInventoryRequest struct {
 	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
 	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
 	Reference     string                 `protobuf:"bytes,3,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	LocationId    int64                  `protobuf:"varint,4,opt,name=LocationId,proto3" json:"LocationId,omitempty"`      // Optional, defaults to the main location
+	SerialNumbers []string               `protobuf:"bytes,5,rep,name=SerialNumbers,proto3" json:"SerialNumbers,omitempty"` // Required for serialized products, one per unit
+	Unit          string                 `protobuf:"bytes,6,opt,name=Unit,proto3" json:"Unit,omitempty"`                   // Optional unit of measure code, Quantity is in base units when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +84,27 @@ func (x *PurchaseInventoryRequest) GetReference() string {
 	return ""
 }
 
+func (x *PurchaseInventoryRequest) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *PurchaseInventoryRequest) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+func (x *PurchaseInventoryRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 type StockMovement struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
@@ -90,6 +114,16 @@ type StockMovement struct {
 	Reference     string                 `protobuf:"bytes,5,opt,name=Reference,proto3" json:"Reference,omitempty"`
 	Note          string                 `protobuf:"bytes,6,opt,name=Note,proto3" json:"Note,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	LocationId    int64                  `protobuf:"varint,8,opt,name=LocationId,proto3" json:"LocationId,omitempty"`
+	ReversalOf    int64                  `protobuf:"varint,9,opt,name=ReversalOf,proto3" json:"ReversalOf,omitempty"`      // Movement undone by this reversal
+	ReversedBy    int64                  `protobuf:"varint,10,opt,name=ReversedBy,proto3" json:"ReversedBy,omitempty"`     // Reversal that undid this movement
+	UnitCost      float64                `protobuf:"fixed64,11,opt,name=UnitCost,proto3" json:"UnitCost,omitempty"`        // Cost per unit moved, 0 for transfers
+	TotalCost     float64                `protobuf:"fixed64,12,opt,name=TotalCost,proto3" json:"TotalCost,omitempty"`      // Cost of the units moved, cost of goods sold for purchases
+	Unit          string                 `protobuf:"bytes,13,opt,name=Unit,proto3" json:"Unit,omitempty"`                  // Unit the quantity was given in, empty for base units
+	UnitQuantity  int64                  `protobuf:"varint,14,opt,name=UnitQuantity,proto3" json:"UnitQuantity,omitempty"` // Quantity in that unit
+	UnitFactor    int64                  `protobuf:"varint,15,opt,name=UnitFactor,proto3" json:"UnitFactor,omitempty"`     // Base units per unit at the time of the movement
+	ReasonCode    string                 `protobuf:"bytes,16,opt,name=ReasonCode,proto3" json:"ReasonCode,omitempty"`      // Corrections only, why stock was corrected
+	State         string                 `protobuf:"bytes,17,opt,name=State,proto3" json:"State,omitempty"`                // State changes only, the stock state moved out of or into
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -173,18 +207,149 @@ func (x *StockMovement) GetCreatedAt() string {
 	return ""
 }
 
+func (x *StockMovement) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReversalOf() int64 {
+	if x != nil {
+		return x.ReversalOf
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReversedBy() int64 {
+	if x != nil {
+		return x.ReversedBy
+	}
+	return 0
+}
+
+func (x *StockMovement) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *StockMovement) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *StockMovement) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *StockMovement) GetUnitQuantity() int64 {
+	if x != nil {
+		return x.UnitQuantity
+	}
+	return 0
+}
+
+func (x *StockMovement) GetUnitFactor() int64 {
+	if x != nil {
+		return x.UnitFactor
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *StockMovement) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type ReverseStockMovementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=Note,proto3" json:"Note,omitempty"` // Optional, describes why the movement is undone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseStockMovementRequest) Reset() {
+	*x = ReverseStockMovementRequest{}
+	mi := &file_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseStockMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseStockMovementRequest) ProtoMessage() {}
+
+func (x *ReverseStockMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseStockMovementRequest.ProtoReflect.Descriptor instead.
+func (*ReverseStockMovementRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReverseStockMovementRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReverseStockMovementRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
 type ManageInventoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
 	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
 	Note          string                 `protobuf:"bytes,3,opt,name=Note,proto3" json:"Note,omitempty"`
+	LocationId    int64                  `protobuf:"varint,4,opt,name=LocationId,proto3" json:"LocationId,omitempty"`      // Optional, defaults to the main location
+	LotNumber     string                 `protobuf:"bytes,5,opt,name=LotNumber,proto3" json:"LotNumber,omitempty"`         // Supply only
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`         // Supply only, YYYY-MM-DD
+	SerialNumbers []string               `protobuf:"bytes,7,rep,name=SerialNumbers,proto3" json:"SerialNumbers,omitempty"` // Supply and corrections, required for serialized products, one per unit supplied, found or written off
+	Reference     string                 `protobuf:"bytes,8,opt,name=Reference,proto3" json:"Reference,omitempty"`         // Supply only, e.g. the purchase order number
+	UnitCost      float64                `protobuf:"fixed64,9,opt,name=UnitCost,proto3" json:"UnitCost,omitempty"`         // Supply only, cost per base unit, defaults to the current unit cost
+	Unit          string                 `protobuf:"bytes,10,opt,name=Unit,proto3" json:"Unit,omitempty"`                  // Optional unit of measure code, Quantity is in base units when empty
+	ReasonCode    string                 `protobuf:"bytes,11,opt,name=ReasonCode,proto3" json:"ReasonCode,omitempty"`      // Corrections only, required, one of the active correction reasons
+	RequestedBy   string                 `protobuf:"bytes,12,opt,name=RequestedBy,proto3" json:"RequestedBy,omitempty"`    // Corrections only, required, user asking for the correction
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ManageInventoryRequest) Reset() {
 	*x = ManageInventoryRequest{}
-	mi := &file_inventory_proto_msgTypes[2]
+	mi := &file_inventory_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -196,7 +361,7 @@ func (x *ManageInventoryRequest) String() string {
 func (*ManageInventoryRequest) ProtoMessage() {}
 
 func (x *ManageInventoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[2]
+	mi := &file_inventory_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -209,7 +374,7 @@ func (x *ManageInventoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManageInventoryRequest.ProtoReflect.Descriptor instead.
 func (*ManageInventoryRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{2}
+	return file_inventory_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ManageInventoryRequest) GetProductId() int64 {
@@ -233,28 +398,93 @@ func (x *ManageInventoryRequest) GetNote() string {
 	return ""
 }
 
-type ListStockMovementsRequest struct {
+func (x *ManageInventoryRequest) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *ManageInventoryRequest) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *ManageInventoryRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ManageInventoryRequest) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+func (x *ManageInventoryRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ManageInventoryRequest) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *ManageInventoryRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ManageInventoryRequest) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *ManageInventoryRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type CorrectionReason struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"` // e.g. damage, theft, count_variance, expiry
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Active        bool                   `protobuf:"varint,3,opt,name=Active,proto3" json:"Active,omitempty"` // Inactive reasons are kept for history but cannot be used
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListStockMovementsRequest) Reset() {
-	*x = ListStockMovementsRequest{}
-	mi := &file_inventory_proto_msgTypes[3]
+func (x *CorrectionReason) Reset() {
+	*x = CorrectionReason{}
+	mi := &file_inventory_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStockMovementsRequest) String() string {
+func (x *CorrectionReason) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStockMovementsRequest) ProtoMessage() {}
+func (*CorrectionReason) ProtoMessage() {}
 
-func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[3]
+func (x *CorrectionReason) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -265,40 +495,98 @@ func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
-func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CorrectionReason.ProtoReflect.Descriptor instead.
+func (*CorrectionReason) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListStockMovementsRequest) GetProductId() int64 {
+func (x *CorrectionReason) GetCode() string {
 	if x != nil {
-		return x.ProductId
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-type ListStockMovementsResponse struct {
+func (x *CorrectionReason) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CorrectionReason) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ListCorrectionReasonsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=IncludeInactive,proto3" json:"IncludeInactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCorrectionReasonsRequest) Reset() {
+	*x = ListCorrectionReasonsRequest{}
+	mi := &file_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCorrectionReasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCorrectionReasonsRequest) ProtoMessage() {}
+
+func (x *ListCorrectionReasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCorrectionReasonsRequest.ProtoReflect.Descriptor instead.
+func (*ListCorrectionReasonsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCorrectionReasonsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListCorrectionReasonsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Records       []*StockMovement       `protobuf:"bytes,1,rep,name=Records,proto3" json:"Records,omitempty"`
+	Reasons       []*CorrectionReason    `protobuf:"bytes,1,rep,name=Reasons,proto3" json:"Reasons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListStockMovementsResponse) Reset() {
-	*x = ListStockMovementsResponse{}
-	mi := &file_inventory_proto_msgTypes[4]
+func (x *ListCorrectionReasonsResponse) Reset() {
+	*x = ListCorrectionReasonsResponse{}
+	mi := &file_inventory_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStockMovementsResponse) String() string {
+func (x *ListCorrectionReasonsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStockMovementsResponse) ProtoMessage() {}
+func (*ListCorrectionReasonsResponse) ProtoMessage() {}
 
-func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[4]
+func (x *ListCorrectionReasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,48 +597,4393 @@ func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
-func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListCorrectionReasonsResponse.ProtoReflect.Descriptor instead.
+func (*ListCorrectionReasonsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListStockMovementsResponse) GetRecords() []*StockMovement {
+func (x *ListCorrectionReasonsResponse) GetReasons() []*CorrectionReason {
 	if x != nil {
-		return x.Records
+		return x.Reasons
 	}
 	return nil
 }
 
-var File_inventory_proto protoreflect.FileDescriptor
+type StockCorrection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	LocationId    int64                  `protobuf:"varint,3,opt,name=LocationId,proto3" json:"LocationId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,4,opt,name=Quantity,proto3" json:"Quantity,omitempty"` // Level the correction sets, in base units
+	Unit          string                 `protobuf:"bytes,5,opt,name=Unit,proto3" json:"Unit,omitempty"`          // Unit the level was given in, empty for base units
+	UnitQuantity  int64                  `protobuf:"varint,6,opt,name=UnitQuantity,proto3" json:"UnitQuantity,omitempty"`
+	ReasonCode    string                 `protobuf:"bytes,7,opt,name=ReasonCode,proto3" json:"ReasonCode,omitempty"`
+	Note          string                 `protobuf:"bytes,8,opt,name=Note,proto3" json:"Note,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=Status,proto3" json:"Status,omitempty"` // pending, posted or rejected
+	RequestedBy   string                 `protobuf:"bytes,10,opt,name=RequestedBy,proto3" json:"RequestedBy,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,11,opt,name=ReviewedBy,proto3" json:"ReviewedBy,omitempty"` // User who approved or rejected a pending correction
+	Change        int64                  `protobuf:"varint,12,opt,name=Change,proto3" json:"Change,omitempty"`        // Difference to the level on hand when requested
+	Value         float64                `protobuf:"fixed64,13,opt,name=Value,proto3" json:"Value,omitempty"`         // Cost of that difference when requested
+	CreatedAt     string                 `protobuf:"bytes,14,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	ReviewedAt    string                 `protobuf:"bytes,15,opt,name=ReviewedAt,proto3" json:"ReviewedAt,omitempty"`
+	Movement      *StockMovement         `protobuf:"bytes,16,opt,name=Movement,proto3" json:"Movement,omitempty"`           // Set once posted
+	SerialNumbers []string               `protobuf:"bytes,17,rep,name=SerialNumbers,proto3" json:"SerialNumbers,omitempty"` // Serialized products only, units the correction finds or writes off
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_inventory_proto_rawDesc = "" +
-	"\n" +
-	"\x0finventory.proto\"r\n" +
-	"\x18PurchaseInventoryRequest\x12\x1c\n" +
-	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1a\n" +
-	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1c\n" +
-	"\tReference\x18\x03 \x01(\tR\tReference\"\xb9\x01\n" +
-	"\rStockMovement\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
-	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12\x16\n" +
-	"\x06Change\x18\x03 \x01(\x03R\x06Change\x12\x12\n" +
-	"\x04Type\x18\x04 \x01(\tR\x04Type\x12\x1c\n" +
-	"\tReference\x18\x05 \x01(\tR\tReference\x12\x12\n" +
-	"\x04Note\x18\x06 \x01(\tR\x04Note\x12\x1c\n" +
-	"\tCreatedAt\x18\a \x01(\tR\tCreatedAt\"f\n" +
-	"\x16ManageInventoryRequest\x12\x1c\n" +
-	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1a\n" +
-	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x12\n" +
-	"\x04Note\x18\x03 \x01(\tR\x04Note\"9\n" +
-	"\x19ListStockMovementsRequest\x12\x1c\n" +
-	"\tProductId\x18\x01 \x01(\x03R\tProductId\"F\n" +
-	"\x1aListStockMovementsResponse\x12(\n" +
-	"\aRecords\x18\x01 \x03(\v2\x0e.StockMovementR\aRecords2\xad\x02\n" +
+func (x *StockCorrection) Reset() {
+	*x = StockCorrection{}
+	mi := &file_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockCorrection) ProtoMessage() {}
+
+func (x *StockCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockCorrection.ProtoReflect.Descriptor instead.
+func (*StockCorrection) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StockCorrection) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockCorrection) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockCorrection) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *StockCorrection) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockCorrection) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *StockCorrection) GetUnitQuantity() int64 {
+	if x != nil {
+		return x.UnitQuantity
+	}
+	return 0
+}
+
+func (x *StockCorrection) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *StockCorrection) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *StockCorrection) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StockCorrection) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *StockCorrection) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *StockCorrection) GetChange() int64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *StockCorrection) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *StockCorrection) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *StockCorrection) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+func (x *StockCorrection) GetMovement() *StockMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+func (x *StockCorrection) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+type ReviewStockCorrectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,2,opt,name=ReviewedBy,proto3" json:"ReviewedBy,omitempty"` // Must differ from the user who requested the correction
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewStockCorrectionRequest) Reset() {
+	*x = ReviewStockCorrectionRequest{}
+	mi := &file_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewStockCorrectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewStockCorrectionRequest) ProtoMessage() {}
+
+func (x *ReviewStockCorrectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewStockCorrectionRequest.ProtoReflect.Descriptor instead.
+func (*ReviewStockCorrectionRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReviewStockCorrectionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewStockCorrectionRequest) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+type ListStockCorrectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`        // Optional filter by status
+	ProductId     int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"` // Optional filter by product
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockCorrectionsRequest) Reset() {
+	*x = ListStockCorrectionsRequest{}
+	mi := &file_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockCorrectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockCorrectionsRequest) ProtoMessage() {}
+
+func (x *ListStockCorrectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockCorrectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockCorrectionsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListStockCorrectionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListStockCorrectionsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type ListStockCorrectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Corrections   []*StockCorrection     `protobuf:"bytes,1,rep,name=Corrections,proto3" json:"Corrections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockCorrectionsResponse) Reset() {
+	*x = ListStockCorrectionsResponse{}
+	mi := &file_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockCorrectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockCorrectionsResponse) ProtoMessage() {}
+
+func (x *ListStockCorrectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockCorrectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockCorrectionsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListStockCorrectionsResponse) GetCorrections() []*StockCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+type ListStockMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`   // Optional filter by product
+	Type          string                 `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`              // Optional filter by movement type
+	Reference     string                 `protobuf:"bytes,3,opt,name=Reference,proto3" json:"Reference,omitempty"`    // Optional filter by reference
+	From          string                 `protobuf:"bytes,4,opt,name=From,proto3" json:"From,omitempty"`              // Optional, RFC 3339 timestamp or YYYY-MM-DD, inclusive
+	To            string                 `protobuf:"bytes,5,opt,name=To,proto3" json:"To,omitempty"`                  // Optional, RFC 3339 timestamp or YYYY-MM-DD, exclusive
+	LocationId    int64                  `protobuf:"varint,6,opt,name=LocationId,proto3" json:"LocationId,omitempty"` // Optional filter by location
+	Cursor        string                 `protobuf:"bytes,7,opt,name=Cursor,proto3" json:"Cursor,omitempty"`          // NextCursor of the previous page
+	Limit         int64                  `protobuf:"varint,8,opt,name=Limit,proto3" json:"Limit,omitempty"`           // Optional, defaults to 50
+	SortOrder     string                 `protobuf:"bytes,9,opt,name=SortOrder,proto3" json:"SortOrder,omitempty"`    // asc or desc, defaults to desc
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListStockMovementsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+type ListStockMovementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*StockMovement       `protobuf:"bytes,1,rep,name=Records,proto3" json:"Records,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`  // Empty on the last page
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=TotalCount,proto3" json:"TotalCount,omitempty"` // Number of movements matching the filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListStockMovementsResponse) GetRecords() []*StockMovement {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListStockMovementsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type StockAsOfRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []int64                `protobuf:"varint,1,rep,packed,name=ProductIds,proto3" json:"ProductIds,omitempty"` // Optional, every product with stock history when empty
+	At            string                 `protobuf:"bytes,2,opt,name=At,proto3" json:"At,omitempty"`                         // RFC 3339 timestamp, or YYYY-MM-DD for the end of that day
+	LocationId    int64                  `protobuf:"varint,3,opt,name=LocationId,proto3" json:"LocationId,omitempty"`        // Optional, sums all locations when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockAsOfRequest) Reset() {
+	*x = StockAsOfRequest{}
+	mi := &file_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAsOfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAsOfRequest) ProtoMessage() {}
+
+func (x *StockAsOfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockAsOfRequest.ProtoReflect.Descriptor instead.
+func (*StockAsOfRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StockAsOfRequest) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *StockAsOfRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *StockAsOfRequest) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+type StockAsOfResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	At            string                 `protobuf:"bytes,1,opt,name=At,proto3" json:"At,omitempty"`
+	SnapshotId    int64                  `protobuf:"varint,2,opt,name=SnapshotId,proto3" json:"SnapshotId,omitempty"` // Snapshot the ledger was replayed from, 0 when the current levels were rewound
+	Levels        []*LocationStockLevel  `protobuf:"bytes,3,rep,name=Levels,proto3" json:"Levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockAsOfResponse) Reset() {
+	*x = StockAsOfResponse{}
+	mi := &file_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAsOfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAsOfResponse) ProtoMessage() {}
+
+func (x *StockAsOfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockAsOfResponse.ProtoReflect.Descriptor instead.
+func (*StockAsOfResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StockAsOfResponse) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *StockAsOfResponse) GetSnapshotId() int64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+func (x *StockAsOfResponse) GetLevels() []*LocationStockLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type InventoryValuationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []int64                `protobuf:"varint,1,rep,packed,name=ProductIds,proto3" json:"ProductIds,omitempty"` // Optional, every product when empty
+	From          string                 `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`                     // Optional start of the COGS period, RFC 3339 or YYYY-MM-DD
+	To            string                 `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`                         // Optional end of the COGS period (exclusive), defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryValuationRequest) Reset() {
+	*x = InventoryValuationRequest{}
+	mi := &file_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryValuationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryValuationRequest) ProtoMessage() {}
+
+func (x *InventoryValuationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryValuationRequest.ProtoReflect.Descriptor instead.
+func (*InventoryValuationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InventoryValuationRequest) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *InventoryValuationRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *InventoryValuationRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type ProductValuation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	CostingMethod string                 `protobuf:"bytes,2,opt,name=CostingMethod,proto3" json:"CostingMethod,omitempty"` // fifo or average
+	Quantity      int64                  `protobuf:"varint,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	UnitCost      float64                `protobuf:"fixed64,4,opt,name=UnitCost,proto3" json:"UnitCost,omitempty"` // Value divided by quantity
+	Value         float64                `protobuf:"fixed64,5,opt,name=Value,proto3" json:"Value,omitempty"`
+	SoldQuantity  int64                  `protobuf:"varint,6,opt,name=SoldQuantity,proto3" json:"SoldQuantity,omitempty"` // Net of returns within the period
+	Cogs          float64                `protobuf:"fixed64,7,opt,name=Cogs,proto3" json:"Cogs,omitempty"`                // Net of returns within the period
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductValuation) Reset() {
+	*x = ProductValuation{}
+	mi := &file_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductValuation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductValuation) ProtoMessage() {}
+
+func (x *ProductValuation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductValuation.ProtoReflect.Descriptor instead.
+func (*ProductValuation) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProductValuation) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductValuation) GetCostingMethod() string {
+	if x != nil {
+		return x.CostingMethod
+	}
+	return ""
+}
+
+func (x *ProductValuation) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ProductValuation) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *ProductValuation) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ProductValuation) GetSoldQuantity() int64 {
+	if x != nil {
+		return x.SoldQuantity
+	}
+	return 0
+}
+
+func (x *ProductValuation) GetCogs() float64 {
+	if x != nil {
+		return x.Cogs
+	}
+	return 0
+}
+
+type InventoryValuationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=From,proto3" json:"From,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=To,proto3" json:"To,omitempty"`
+	TotalValue    float64                `protobuf:"fixed64,3,opt,name=TotalValue,proto3" json:"TotalValue,omitempty"`
+	TotalCogs     float64                `protobuf:"fixed64,4,opt,name=TotalCogs,proto3" json:"TotalCogs,omitempty"`
+	Products      []*ProductValuation    `protobuf:"bytes,5,rep,name=Products,proto3" json:"Products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryValuationResponse) Reset() {
+	*x = InventoryValuationResponse{}
+	mi := &file_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryValuationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryValuationResponse) ProtoMessage() {}
+
+func (x *InventoryValuationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryValuationResponse.ProtoReflect.Descriptor instead.
+func (*InventoryValuationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InventoryValuationResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *InventoryValuationResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *InventoryValuationResponse) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *InventoryValuationResponse) GetTotalCogs() float64 {
+	if x != nil {
+		return x.TotalCogs
+	}
+	return 0
+}
+
+func (x *InventoryValuationResponse) GetProducts() []*ProductValuation {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type ReconcileStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []int64                `protobuf:"varint,1,rep,packed,name=ProductIds,proto3" json:"ProductIds,omitempty"` // Optional, every product when empty
+	Repair        bool                   `protobuf:"varint,2,opt,name=Repair,proto3" json:"Repair,omitempty"`                // Record the stock on hand as correction movements where the ledger disagrees
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileStockRequest) Reset() {
+	*x = ReconcileStockRequest{}
+	mi := &file_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileStockRequest) ProtoMessage() {}
+
+func (x *ReconcileStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileStockRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReconcileStockRequest) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *ReconcileStockRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+type StockDiscrepancy struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	LocationId       int64                  `protobuf:"varint,2,opt,name=LocationId,proto3" json:"LocationId,omitempty"`             // 0 compares products.stock_quantity with the sum of its locations
+	ExpectedQuantity int64                  `protobuf:"varint,3,opt,name=ExpectedQuantity,proto3" json:"ExpectedQuantity,omitempty"` // Replayed from the ledger, or summed over locations
+	RecordedQuantity int64                  `protobuf:"varint,4,opt,name=RecordedQuantity,proto3" json:"RecordedQuantity,omitempty"`
+	CorrectionId     int64                  `protobuf:"varint,5,opt,name=CorrectionId,proto3" json:"CorrectionId,omitempty"` // Movement written by the repair, 0 when not repaired
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StockDiscrepancy) Reset() {
+	*x = StockDiscrepancy{}
+	mi := &file_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockDiscrepancy) ProtoMessage() {}
+
+func (x *StockDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockDiscrepancy.ProtoReflect.Descriptor instead.
+func (*StockDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StockDiscrepancy) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockDiscrepancy) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *StockDiscrepancy) GetExpectedQuantity() int64 {
+	if x != nil {
+		return x.ExpectedQuantity
+	}
+	return 0
+}
+
+func (x *StockDiscrepancy) GetRecordedQuantity() int64 {
+	if x != nil {
+		return x.RecordedQuantity
+	}
+	return 0
+}
+
+func (x *StockDiscrepancy) GetCorrectionId() int64 {
+	if x != nil {
+		return x.CorrectionId
+	}
+	return 0
+}
+
+type ReconcileStockResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CheckedProducts int64                  `protobuf:"varint,1,opt,name=CheckedProducts,proto3" json:"CheckedProducts,omitempty"`
+	Discrepancies   []*StockDiscrepancy    `protobuf:"bytes,2,rep,name=Discrepancies,proto3" json:"Discrepancies,omitempty"`
+	Repaired        bool                   `protobuf:"varint,3,opt,name=Repaired,proto3" json:"Repaired,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReconcileStockResponse) Reset() {
+	*x = ReconcileStockResponse{}
+	mi := &file_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileStockResponse) ProtoMessage() {}
+
+func (x *ReconcileStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileStockResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReconcileStockResponse) GetCheckedProducts() int64 {
+	if x != nil {
+		return x.CheckedProducts
+	}
+	return 0
+}
+
+func (x *ReconcileStockResponse) GetDiscrepancies() []*StockDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+func (x *ReconcileStockResponse) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type ReserveStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Reference     string                 `protobuf:"bytes,3,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	TtlSeconds    int64                  `protobuf:"varint,4,opt,name=TtlSeconds,proto3" json:"TtlSeconds,omitempty"` // Optional, defaults to the service reservation ttl
+	LocationId    int64                  `protobuf:"varint,5,opt,name=LocationId,proto3" json:"LocationId,omitempty"` // Optional, location the stock is taken from on commit
+	Unit          string                 `protobuf:"bytes,6,opt,name=Unit,proto3" json:"Unit,omitempty"`              // Optional unit of measure code, Quantity is in base units when empty
+	NoExpiry      bool                   `protobuf:"varint,7,opt,name=NoExpiry,proto3" json:"NoExpiry,omitempty"`     // Holds the stock until the reservation is committed or released, TtlSeconds is ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReserveStockRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetNoExpiry() bool {
+	if x != nil {
+		return x.NoExpiry
+	}
+	return false
+}
+
+type StockReservation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId         int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity          int64                  `protobuf:"varint,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"` // Base units
+	Reference         string                 `protobuf:"bytes,4,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Status            string                 `protobuf:"bytes,5,opt,name=Status,proto3" json:"Status,omitempty"`
+	ExpiresAt         string                 `protobuf:"bytes,6,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"` // Empty for reservations held until committed or released
+	CreatedAt         string                 `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	LocationId        int64                  `protobuf:"varint,8,opt,name=LocationId,proto3" json:"LocationId,omitempty"`
+	Unit              string                 `protobuf:"bytes,9,opt,name=Unit,proto3" json:"Unit,omitempty"`                             // Unit the reservation was requested in, empty for base units
+	UnitQuantity      int64                  `protobuf:"varint,10,opt,name=UnitQuantity,proto3" json:"UnitQuantity,omitempty"`           // Quantity in that unit
+	CommittedQuantity int64                  `protobuf:"varint,11,opt,name=CommittedQuantity,proto3" json:"CommittedQuantity,omitempty"` // Base units already taken out of stock by commits
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StockReservation) Reset() {
+	*x = StockReservation{}
+	mi := &file_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReservation) ProtoMessage() {}
+
+func (x *StockReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReservation.ProtoReflect.Descriptor instead.
+func (*StockReservation) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StockReservation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockReservation) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockReservation) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockReservation) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *StockReservation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StockReservation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *StockReservation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *StockReservation) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *StockReservation) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *StockReservation) GetUnitQuantity() int64 {
+	if x != nil {
+		return x.UnitQuantity
+	}
+	return 0
+}
+
+func (x *StockReservation) GetCommittedQuantity() int64 {
+	if x != nil {
+		return x.CommittedQuantity
+	}
+	return 0
+}
+
+type ReservationIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationIdRequest) Reset() {
+	*x = ReservationIdRequest{}
+	mi := &file_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationIdRequest) ProtoMessage() {}
+
+func (x *ReservationIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationIdRequest.ProtoReflect.Descriptor instead.
+func (*ReservationIdRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReservationIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CommitReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	SerialNumbers []string               `protobuf:"bytes,2,rep,name=SerialNumbers,proto3" json:"SerialNumbers,omitempty"` // Required for serialized products, one per unit
+	Quantity      int64                  `protobuf:"varint,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"`          // Optional, base units to commit. Defaults to everything still reserved
+	Reference     string                 `protobuf:"bytes,4,opt,name=Reference,proto3" json:"Reference,omitempty"`         // Optional movement reference, defaults to the reservation reference
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationRequest) Reset() {
+	*x = CommitReservationRequest{}
+	mi := &file_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationRequest) ProtoMessage() {}
+
+func (x *CommitReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationRequest.ProtoReflect.Descriptor instead.
+func (*CommitReservationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CommitReservationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CommitReservationRequest) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+func (x *CommitReservationRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CommitReservationRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	TotalQuantity int64                  `protobuf:"varint,4,opt,name=TotalQuantity,proto3" json:"TotalQuantity,omitempty"` // Sum of stock held at the location
+	ProductCount  int64                  `protobuf:"varint,5,opt,name=ProductCount,proto3" json:"ProductCount,omitempty"`   // Number of products with stock at the location
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Location) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Location) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Location) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Location) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *Location) GetProductCount() int64 {
+	if x != nil {
+		return x.ProductCount
+	}
+	return 0
+}
+
+func (x *Location) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLocationRequest) Reset() {
+	*x = CreateLocationRequest{}
+	mi := &file_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLocationRequest) ProtoMessage() {}
+
+func (x *CreateLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLocationRequest.ProtoReflect.Descriptor instead.
+func (*CreateLocationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateLocationRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateLocationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListLocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLocationsRequest) Reset() {
+	*x = ListLocationsRequest{}
+	mi := &file_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocationsRequest) ProtoMessage() {}
+
+func (x *ListLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+type ListLocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locations     []*Location            `protobuf:"bytes,1,rep,name=Locations,proto3" json:"Locations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLocationsResponse) Reset() {
+	*x = ListLocationsResponse{}
+	mi := &file_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocationsResponse) ProtoMessage() {}
+
+func (x *ListLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListLocationsResponse) GetLocations() []*Location {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type LocationIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationIdRequest) Reset() {
+	*x = LocationIdRequest{}
+	mi := &file_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationIdRequest) ProtoMessage() {}
+
+func (x *LocationIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationIdRequest.ProtoReflect.Descriptor instead.
+func (*LocationIdRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LocationIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type LocationStockLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"` // On hand in every state
+	Available     int64                  `protobuf:"varint,3,opt,name=Available,proto3" json:"Available,omitempty"`
+	Quarantined   int64                  `protobuf:"varint,4,opt,name=Quarantined,proto3" json:"Quarantined,omitempty"`
+	Damaged       int64                  `protobuf:"varint,5,opt,name=Damaged,proto3" json:"Damaged,omitempty"`
+	InTransit     int64                  `protobuf:"varint,6,opt,name=InTransit,proto3" json:"InTransit,omitempty"`
+	LocationId    int64                  `protobuf:"varint,7,opt,name=LocationId,proto3" json:"LocationId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationStockLevel) Reset() {
+	*x = LocationStockLevel{}
+	mi := &file_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationStockLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationStockLevel) ProtoMessage() {}
+
+func (x *LocationStockLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationStockLevel.ProtoReflect.Descriptor instead.
+func (*LocationStockLevel) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LocationStockLevel) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *LocationStockLevel) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *LocationStockLevel) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *LocationStockLevel) GetQuarantined() int64 {
+	if x != nil {
+		return x.Quarantined
+	}
+	return 0
+}
+
+func (x *LocationStockLevel) GetDamaged() int64 {
+	if x != nil {
+		return x.Damaged
+	}
+	return 0
+}
+
+func (x *LocationStockLevel) GetInTransit() int64 {
+	if x != nil {
+		return x.InTransit
+	}
+	return 0
+}
+
+func (x *LocationStockLevel) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+type LocationStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Location              `protobuf:"bytes,1,opt,name=Location,proto3" json:"Location,omitempty"`
+	Levels        []*LocationStockLevel  `protobuf:"bytes,2,rep,name=Levels,proto3" json:"Levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationStockResponse) Reset() {
+	*x = LocationStockResponse{}
+	mi := &file_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationStockResponse) ProtoMessage() {}
+
+func (x *LocationStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationStockResponse.ProtoReflect.Descriptor instead.
+func (*LocationStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LocationStockResponse) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *LocationStockResponse) GetLevels() []*LocationStockLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type TransferStockRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	FromLocationId int64                  `protobuf:"varint,2,opt,name=FromLocationId,proto3" json:"FromLocationId,omitempty"`
+	ToLocationId   int64                  `protobuf:"varint,3,opt,name=ToLocationId,proto3" json:"ToLocationId,omitempty"`
+	Quantity       int64                  `protobuf:"varint,4,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Note           string                 `protobuf:"bytes,5,opt,name=Note,proto3" json:"Note,omitempty"`
+	SerialNumbers  []string               `protobuf:"bytes,6,rep,name=SerialNumbers,proto3" json:"SerialNumbers,omitempty"` // Required for serialized products, one per unit
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransferStockRequest) Reset() {
+	*x = TransferStockRequest{}
+	mi := &file_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockRequest) ProtoMessage() {}
+
+func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockRequest.ProtoReflect.Descriptor instead.
+func (*TransferStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TransferStockRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetFromLocationId() int64 {
+	if x != nil {
+		return x.FromLocationId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetToLocationId() int64 {
+	if x != nil {
+		return x.ToLocationId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+type TransferStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Out           *StockMovement         `protobuf:"bytes,1,opt,name=Out,proto3" json:"Out,omitempty"`
+	In            *StockMovement         `protobuf:"bytes,2,opt,name=In,proto3" json:"In,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferStockResponse) Reset() {
+	*x = TransferStockResponse{}
+	mi := &file_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockResponse) ProtoMessage() {}
+
+func (x *TransferStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockResponse.ProtoReflect.Descriptor instead.
+func (*TransferStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TransferStockResponse) GetOut() *StockMovement {
+	if x != nil {
+		return x.Out
+	}
+	return nil
+}
+
+func (x *TransferStockResponse) GetIn() *StockMovement {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+type MoveStockStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	LocationId    int64                  `protobuf:"varint,2,opt,name=LocationId,proto3" json:"LocationId,omitempty"` // Optional, defaults to the main location
+	Quantity      int64                  `protobuf:"varint,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	FromState     string                 `protobuf:"bytes,4,opt,name=FromState,proto3" json:"FromState,omitempty"` // available, quarantined, damaged or in_transit
+	ToState       string                 `protobuf:"bytes,5,opt,name=ToState,proto3" json:"ToState,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=Note,proto3" json:"Note,omitempty"`
+	SerialNumbers []string               `protobuf:"bytes,7,rep,name=SerialNumbers,proto3" json:"SerialNumbers,omitempty"` // Required for serialized products, one per unit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveStockStateRequest) Reset() {
+	*x = MoveStockStateRequest{}
+	mi := &file_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveStockStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveStockStateRequest) ProtoMessage() {}
+
+func (x *MoveStockStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveStockStateRequest.ProtoReflect.Descriptor instead.
+func (*MoveStockStateRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MoveStockStateRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *MoveStockStateRequest) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *MoveStockStateRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MoveStockStateRequest) GetFromState() string {
+	if x != nil {
+		return x.FromState
+	}
+	return ""
+}
+
+func (x *MoveStockStateRequest) GetToState() string {
+	if x != nil {
+		return x.ToState
+	}
+	return ""
+}
+
+func (x *MoveStockStateRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *MoveStockStateRequest) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+type MoveStockStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Out           *StockMovement         `protobuf:"bytes,1,opt,name=Out,proto3" json:"Out,omitempty"`
+	In            *StockMovement         `protobuf:"bytes,2,opt,name=In,proto3" json:"In,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveStockStateResponse) Reset() {
+	*x = MoveStockStateResponse{}
+	mi := &file_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveStockStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveStockStateResponse) ProtoMessage() {}
+
+func (x *MoveStockStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveStockStateResponse.ProtoReflect.Descriptor instead.
+func (*MoveStockStateResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MoveStockStateResponse) GetOut() *StockMovement {
+	if x != nil {
+		return x.Out
+	}
+	return nil
+}
+
+func (x *MoveStockStateResponse) GetIn() *StockMovement {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+type ProductStockStatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductStockStatesRequest) Reset() {
+	*x = ProductStockStatesRequest{}
+	mi := &file_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductStockStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockStatesRequest) ProtoMessage() {}
+
+func (x *ProductStockStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockStatesRequest.ProtoReflect.Descriptor instead.
+func (*ProductStockStatesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ProductStockStatesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type ProductStockStatesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	OnHand          int64                  `protobuf:"varint,2,opt,name=OnHand,proto3" json:"OnHand,omitempty"`
+	Reserved        int64                  `protobuf:"varint,3,opt,name=Reserved,proto3" json:"Reserved,omitempty"`
+	Held            int64                  `protobuf:"varint,4,opt,name=Held,proto3" json:"Held,omitempty"`                       // Quarantined, damaged and in transit
+	AvailableToSell int64                  `protobuf:"varint,5,opt,name=AvailableToSell,proto3" json:"AvailableToSell,omitempty"` // On hand less reserved and held
+	Levels          []*LocationStockLevel  `protobuf:"bytes,6,rep,name=Levels,proto3" json:"Levels,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProductStockStatesResponse) Reset() {
+	*x = ProductStockStatesResponse{}
+	mi := &file_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductStockStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockStatesResponse) ProtoMessage() {}
+
+func (x *ProductStockStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockStatesResponse.ProtoReflect.Descriptor instead.
+func (*ProductStockStatesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ProductStockStatesResponse) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductStockStatesResponse) GetOnHand() int64 {
+	if x != nil {
+		return x.OnHand
+	}
+	return 0
+}
+
+func (x *ProductStockStatesResponse) GetReserved() int64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *ProductStockStatesResponse) GetHeld() int64 {
+	if x != nil {
+		return x.Held
+	}
+	return 0
+}
+
+func (x *ProductStockStatesResponse) GetAvailableToSell() int64 {
+	if x != nil {
+		return x.AvailableToSell
+	}
+	return 0
+}
+
+func (x *ProductStockStatesResponse) GetLevels() []*LocationStockLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type AssembleKitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KitId         int64                  `protobuf:"varint,1,opt,name=KitId,proto3" json:"KitId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	LocationId    int64                  `protobuf:"varint,3,opt,name=LocationId,proto3" json:"LocationId,omitempty"` // Optional, defaults to the main location
+	Note          string                 `protobuf:"bytes,4,opt,name=Note,proto3" json:"Note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssembleKitRequest) Reset() {
+	*x = AssembleKitRequest{}
+	mi := &file_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssembleKitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssembleKitRequest) ProtoMessage() {}
+
+func (x *AssembleKitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssembleKitRequest.ProtoReflect.Descriptor instead.
+func (*AssembleKitRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AssembleKitRequest) GetKitId() int64 {
+	if x != nil {
+		return x.KitId
+	}
+	return 0
+}
+
+func (x *AssembleKitRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AssembleKitRequest) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *AssembleKitRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type KitAssembly struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=Reference,proto3" json:"Reference,omitempty"` // ASM-000001, shared by every movement of the assembly
+	KitId         int64                  `protobuf:"varint,3,opt,name=KitId,proto3" json:"KitId,omitempty"`
+	LocationId    int64                  `protobuf:"varint,4,opt,name=LocationId,proto3" json:"LocationId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,5,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=Note,proto3" json:"Note,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Output        *StockMovement         `protobuf:"bytes,8,opt,name=Output,proto3" json:"Output,omitempty"`         // Kit units put into stock
+	Components    []*StockMovement       `protobuf:"bytes,9,rep,name=Components,proto3" json:"Components,omitempty"` // Component units taken out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitAssembly) Reset() {
+	*x = KitAssembly{}
+	mi := &file_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitAssembly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitAssembly) ProtoMessage() {}
+
+func (x *KitAssembly) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitAssembly.ProtoReflect.Descriptor instead.
+func (*KitAssembly) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *KitAssembly) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KitAssembly) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *KitAssembly) GetKitId() int64 {
+	if x != nil {
+		return x.KitId
+	}
+	return 0
+}
+
+func (x *KitAssembly) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *KitAssembly) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *KitAssembly) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *KitAssembly) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *KitAssembly) GetOutput() *StockMovement {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *KitAssembly) GetComponents() []*StockMovement {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type StockBatch struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId         int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	LocationId        int64                  `protobuf:"varint,3,opt,name=LocationId,proto3" json:"LocationId,omitempty"`
+	LotNumber         string                 `protobuf:"bytes,4,opt,name=LotNumber,proto3" json:"LotNumber,omitempty"`
+	ExpiresAt         string                 `protobuf:"bytes,5,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"` // YYYY-MM-DD, empty when the batch does not expire
+	ReceivedQuantity  int64                  `protobuf:"varint,6,opt,name=ReceivedQuantity,proto3" json:"ReceivedQuantity,omitempty"`
+	RemainingQuantity int64                  `protobuf:"varint,7,opt,name=RemainingQuantity,proto3" json:"RemainingQuantity,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StockBatch) Reset() {
+	*x = StockBatch{}
+	mi := &file_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockBatch) ProtoMessage() {}
+
+func (x *StockBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockBatch.ProtoReflect.Descriptor instead.
+func (*StockBatch) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StockBatch) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockBatch) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockBatch) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *StockBatch) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *StockBatch) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *StockBatch) GetReceivedQuantity() int64 {
+	if x != nil {
+		return x.ReceivedQuantity
+	}
+	return 0
+}
+
+func (x *StockBatch) GetRemainingQuantity() int64 {
+	if x != nil {
+		return x.RemainingQuantity
+	}
+	return 0
+}
+
+func (x *StockBatch) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListStockBatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	LocationId    int64                  `protobuf:"varint,2,opt,name=LocationId,proto3" json:"LocationId,omitempty"`     // Optional filter by location
+	IncludeEmpty  bool                   `protobuf:"varint,3,opt,name=IncludeEmpty,proto3" json:"IncludeEmpty,omitempty"` // Include fully consumed batches
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockBatchesRequest) Reset() {
+	*x = ListStockBatchesRequest{}
+	mi := &file_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockBatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockBatchesRequest) ProtoMessage() {}
+
+func (x *ListStockBatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockBatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListStockBatchesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListStockBatchesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListStockBatchesRequest) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *ListStockBatchesRequest) GetIncludeEmpty() bool {
+	if x != nil {
+		return x.IncludeEmpty
+	}
+	return false
+}
+
+type ListExpiringBatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int64                  `protobuf:"varint,1,opt,name=Days,proto3" json:"Days,omitempty"`             // Batches expiring within this many days, already expired included
+	LocationId    int64                  `protobuf:"varint,2,opt,name=LocationId,proto3" json:"LocationId,omitempty"` // Optional filter by location
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringBatchesRequest) Reset() {
+	*x = ListExpiringBatchesRequest{}
+	mi := &file_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringBatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringBatchesRequest) ProtoMessage() {}
+
+func (x *ListExpiringBatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringBatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListExpiringBatchesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListExpiringBatchesRequest) GetDays() int64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *ListExpiringBatchesRequest) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+type ListStockBatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batches       []*StockBatch          `protobuf:"bytes,1,rep,name=Batches,proto3" json:"Batches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockBatchesResponse) Reset() {
+	*x = ListStockBatchesResponse{}
+	mi := &file_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockBatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockBatchesResponse) ProtoMessage() {}
+
+func (x *ListStockBatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockBatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListStockBatchesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListStockBatchesResponse) GetBatches() []*StockBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type SerialUnitEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovementId    int64                  `protobuf:"varint,1,opt,name=MovementId,proto3" json:"MovementId,omitempty"` // 0 for scraps of returned units, which move no stock
+	Type          string                 `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`              // Type of the movement that moved the unit, scrap or scrap_restore without one
+	Status        string                 `protobuf:"bytes,3,opt,name=Status,proto3" json:"Status,omitempty"`          // Status of the unit after the movement
+	LocationId    int64                  `protobuf:"varint,4,opt,name=LocationId,proto3" json:"LocationId,omitempty"`
+	Reference     string                 `protobuf:"bytes,5,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SerialUnitEvent) Reset() {
+	*x = SerialUnitEvent{}
+	mi := &file_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SerialUnitEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerialUnitEvent) ProtoMessage() {}
+
+func (x *SerialUnitEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerialUnitEvent.ProtoReflect.Descriptor instead.
+func (*SerialUnitEvent) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SerialUnitEvent) GetMovementId() int64 {
+	if x != nil {
+		return x.MovementId
+	}
+	return 0
+}
+
+func (x *SerialUnitEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SerialUnitEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SerialUnitEvent) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *SerialUnitEvent) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *SerialUnitEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SerialUnit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	SerialNumber  string                 `protobuf:"bytes,3,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	LocationId    int64                  `protobuf:"varint,4,opt,name=LocationId,proto3" json:"LocationId,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=Status,proto3" json:"Status,omitempty"` // in_stock, sold, returned, quarantined, damaged, in_transit or scrapped
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	Events        []*SerialUnitEvent     `protobuf:"bytes,8,rep,name=Events,proto3" json:"Events,omitempty"` // Only filled in by GetSerialHistory
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SerialUnit) Reset() {
+	*x = SerialUnit{}
+	mi := &file_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SerialUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerialUnit) ProtoMessage() {}
+
+func (x *SerialUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerialUnit.ProtoReflect.Descriptor instead.
+func (*SerialUnit) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SerialUnit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SerialUnit) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SerialUnit) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *SerialUnit) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *SerialUnit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SerialUnit) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SerialUnit) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *SerialUnit) GetEvents() []*SerialUnitEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ListSerialUnitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	LocationId    int64                  `protobuf:"varint,2,opt,name=LocationId,proto3" json:"LocationId,omitempty"` // Optional filter by location
+	Status        string                 `protobuf:"bytes,3,opt,name=Status,proto3" json:"Status,omitempty"`          // Optional filter by status
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSerialUnitsRequest) Reset() {
+	*x = ListSerialUnitsRequest{}
+	mi := &file_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSerialUnitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSerialUnitsRequest) ProtoMessage() {}
+
+func (x *ListSerialUnitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSerialUnitsRequest.ProtoReflect.Descriptor instead.
+func (*ListSerialUnitsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListSerialUnitsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListSerialUnitsRequest) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *ListSerialUnitsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SerialHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SerialNumber  string                 `protobuf:"bytes,1,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"` // Optional, the same serial number may exist for several products
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SerialHistoryRequest) Reset() {
+	*x = SerialHistoryRequest{}
+	mi := &file_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SerialHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerialHistoryRequest) ProtoMessage() {}
+
+func (x *SerialHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerialHistoryRequest.ProtoReflect.Descriptor instead.
+func (*SerialHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SerialHistoryRequest) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *SerialHistoryRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type ListSerialUnitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Units         []*SerialUnit          `protobuf:"bytes,1,rep,name=Units,proto3" json:"Units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSerialUnitsResponse) Reset() {
+	*x = ListSerialUnitsResponse{}
+	mi := &file_inventory_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSerialUnitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSerialUnitsResponse) ProtoMessage() {}
+
+func (x *ListSerialUnitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSerialUnitsResponse.ProtoReflect.Descriptor instead.
+func (*ListSerialUnitsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListSerialUnitsResponse) GetUnits() []*SerialUnit {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+type ScrapSerialUnitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`          // Units scrapped, in base units
+	SerialNumbers []string               `protobuf:"bytes,3,rep,name=SerialNumbers,proto3" json:"SerialNumbers,omitempty"` // Required for serialized products, one per unit, none for the rest
+	Reference     string                 `protobuf:"bytes,4,opt,name=Reference,proto3" json:"Reference,omitempty"`         // e.g. the return number
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScrapSerialUnitsRequest) Reset() {
+	*x = ScrapSerialUnitsRequest{}
+	mi := &file_inventory_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScrapSerialUnitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrapSerialUnitsRequest) ProtoMessage() {}
+
+func (x *ScrapSerialUnitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrapSerialUnitsRequest.ProtoReflect.Descriptor instead.
+func (*ScrapSerialUnitsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ScrapSerialUnitsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ScrapSerialUnitsRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ScrapSerialUnitsRequest) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+func (x *ScrapSerialUnitsRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type CycleCountEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counter       string                 `protobuf:"bytes,1,opt,name=Counter,proto3" json:"Counter,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleCountEntry) Reset() {
+	*x = CycleCountEntry{}
+	mi := &file_inventory_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleCountEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleCountEntry) ProtoMessage() {}
+
+func (x *CycleCountEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleCountEntry.ProtoReflect.Descriptor instead.
+func (*CycleCountEntry) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CycleCountEntry) GetCounter() string {
+	if x != nil {
+		return x.Counter
+	}
+	return ""
+}
+
+func (x *CycleCountEntry) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CycleCountEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CycleCountLine struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId       int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	LocationId      int64                  `protobuf:"varint,3,opt,name=LocationId,proto3" json:"LocationId,omitempty"`
+	SystemQuantity  int64                  `protobuf:"varint,4,opt,name=SystemQuantity,proto3" json:"SystemQuantity,omitempty"` // Stock at the location when the count was opened
+	Counted         bool                   `protobuf:"varint,5,opt,name=Counted,proto3" json:"Counted,omitempty"`
+	CountedQuantity int64                  `protobuf:"varint,6,opt,name=CountedQuantity,proto3" json:"CountedQuantity,omitempty"` // Sum of the entries of all counters
+	Variance        int64                  `protobuf:"varint,7,opt,name=Variance,proto3" json:"Variance,omitempty"`               // CountedQuantity minus SystemQuantity
+	Approved        bool                   `protobuf:"varint,8,opt,name=Approved,proto3" json:"Approved,omitempty"`
+	MovementId      int64                  `protobuf:"varint,9,opt,name=MovementId,proto3" json:"MovementId,omitempty"` // Correction posted for the line
+	Entries         []*CycleCountEntry     `protobuf:"bytes,10,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CycleCountLine) Reset() {
+	*x = CycleCountLine{}
+	mi := &file_inventory_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleCountLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleCountLine) ProtoMessage() {}
+
+func (x *CycleCountLine) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleCountLine.ProtoReflect.Descriptor instead.
+func (*CycleCountLine) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CycleCountLine) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetSystemQuantity() int64 {
+	if x != nil {
+		return x.SystemQuantity
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetCounted() bool {
+	if x != nil {
+		return x.Counted
+	}
+	return false
+}
+
+func (x *CycleCountLine) GetCountedQuantity() int64 {
+	if x != nil {
+		return x.CountedQuantity
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetVariance() int64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *CycleCountLine) GetMovementId() int64 {
+	if x != nil {
+		return x.MovementId
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetEntries() []*CycleCountEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type CycleCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"` // open, review, posted or cancelled
+	Note          string                 `protobuf:"bytes,3,opt,name=Note,proto3" json:"Note,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	PostedAt      string                 `protobuf:"bytes,5,opt,name=PostedAt,proto3" json:"PostedAt,omitempty"`
+	Lines         []*CycleCountLine      `protobuf:"bytes,6,rep,name=Lines,proto3" json:"Lines,omitempty"` // Not filled in by ListCycleCounts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleCount) Reset() {
+	*x = CycleCount{}
+	mi := &file_inventory_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleCount) ProtoMessage() {}
+
+func (x *CycleCount) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleCount.ProtoReflect.Descriptor instead.
+func (*CycleCount) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CycleCount) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CycleCount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CycleCount) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CycleCount) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CycleCount) GetPostedAt() string {
+	if x != nil {
+		return x.PostedAt
+	}
+	return ""
+}
+
+func (x *CycleCount) GetLines() []*CycleCountLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type OpenCycleCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []int64                `protobuf:"varint,1,rep,packed,name=ProductIds,proto3" json:"ProductIds,omitempty"`
+	LocationIds   []int64                `protobuf:"varint,2,rep,packed,name=LocationIds,proto3" json:"LocationIds,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=Note,proto3" json:"Note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenCycleCountRequest) Reset() {
+	*x = OpenCycleCountRequest{}
+	mi := &file_inventory_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenCycleCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenCycleCountRequest) ProtoMessage() {}
+
+func (x *OpenCycleCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenCycleCountRequest.ProtoReflect.Descriptor instead.
+func (*OpenCycleCountRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *OpenCycleCountRequest) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *OpenCycleCountRequest) GetLocationIds() []int64 {
+	if x != nil {
+		return x.LocationIds
+	}
+	return nil
+}
+
+func (x *OpenCycleCountRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ListCycleCountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"` // Optional filter by status
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCycleCountsRequest) Reset() {
+	*x = ListCycleCountsRequest{}
+	mi := &file_inventory_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCycleCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCycleCountsRequest) ProtoMessage() {}
+
+func (x *ListCycleCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCycleCountsRequest.ProtoReflect.Descriptor instead.
+func (*ListCycleCountsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListCycleCountsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListCycleCountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        []*CycleCount          `protobuf:"bytes,1,rep,name=Counts,proto3" json:"Counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCycleCountsResponse) Reset() {
+	*x = ListCycleCountsResponse{}
+	mi := &file_inventory_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCycleCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCycleCountsResponse) ProtoMessage() {}
+
+func (x *ListCycleCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCycleCountsResponse.ProtoReflect.Descriptor instead.
+func (*ListCycleCountsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListCycleCountsResponse) GetCounts() []*CycleCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type CycleCountIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleCountIdRequest) Reset() {
+	*x = CycleCountIdRequest{}
+	mi := &file_inventory_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleCountIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleCountIdRequest) ProtoMessage() {}
+
+func (x *CycleCountIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleCountIdRequest.ProtoReflect.Descriptor instead.
+func (*CycleCountIdRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CycleCountIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CountedQuantity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	LocationId    int64                  `protobuf:"varint,2,opt,name=LocationId,proto3" json:"LocationId,omitempty"` // Optional, defaults to the main location
+	Quantity      int64                  `protobuf:"varint,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountedQuantity) Reset() {
+	*x = CountedQuantity{}
+	mi := &file_inventory_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountedQuantity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountedQuantity) ProtoMessage() {}
+
+func (x *CountedQuantity) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountedQuantity.ProtoReflect.Descriptor instead.
+func (*CountedQuantity) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CountedQuantity) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CountedQuantity) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *CountedQuantity) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RecordCycleCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountId       int64                  `protobuf:"varint,1,opt,name=CountId,proto3" json:"CountId,omitempty"`
+	Counter       string                 `protobuf:"bytes,2,opt,name=Counter,proto3" json:"Counter,omitempty"` // Who counted, a later entry of the same counter replaces theirs
+	Entries       []*CountedQuantity     `protobuf:"bytes,3,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordCycleCountRequest) Reset() {
+	*x = RecordCycleCountRequest{}
+	mi := &file_inventory_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordCycleCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordCycleCountRequest) ProtoMessage() {}
+
+func (x *RecordCycleCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordCycleCountRequest.ProtoReflect.Descriptor instead.
+func (*RecordCycleCountRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RecordCycleCountRequest) GetCountId() int64 {
+	if x != nil {
+		return x.CountId
+	}
+	return 0
+}
+
+func (x *RecordCycleCountRequest) GetCounter() string {
+	if x != nil {
+		return x.Counter
+	}
+	return ""
+}
+
+func (x *RecordCycleCountRequest) GetEntries() []*CountedQuantity {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ApproveCycleCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountId       int64                  `protobuf:"varint,1,opt,name=CountId,proto3" json:"CountId,omitempty"`
+	LineIds       []int64                `protobuf:"varint,2,rep,packed,name=LineIds,proto3" json:"LineIds,omitempty"`
+	All           bool                   `protobuf:"varint,3,opt,name=All,proto3" json:"All,omitempty"` // Approve every line of the count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveCycleCountRequest) Reset() {
+	*x = ApproveCycleCountRequest{}
+	mi := &file_inventory_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCycleCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCycleCountRequest) ProtoMessage() {}
+
+func (x *ApproveCycleCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCycleCountRequest.ProtoReflect.Descriptor instead.
+func (*ApproveCycleCountRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ApproveCycleCountRequest) GetCountId() int64 {
+	if x != nil {
+		return x.CountId
+	}
+	return 0
+}
+
+func (x *ApproveCycleCountRequest) GetLineIds() []int64 {
+	if x != nil {
+		return x.LineIds
+	}
+	return nil
+}
+
+func (x *ApproveCycleCountRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type StockAlert struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId       int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Type            string                 `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`                    // low_stock
+	Status          string                 `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"`                // open until stock is back at the reorder level, then resolved
+	StockQuantity   int64                  `protobuf:"varint,5,opt,name=StockQuantity,proto3" json:"StockQuantity,omitempty"` // Stock when the alert was raised
+	ReorderLevel    int64                  `protobuf:"varint,6,opt,name=ReorderLevel,proto3" json:"ReorderLevel,omitempty"`
+	ReorderQuantity int64                  `protobuf:"varint,7,opt,name=ReorderQuantity,proto3" json:"ReorderQuantity,omitempty"`
+	MovementId      int64                  `protobuf:"varint,8,opt,name=MovementId,proto3" json:"MovementId,omitempty"` // Movement that took stock below the reorder level
+	CreatedAt       string                 `protobuf:"bytes,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	ResolvedAt      string                 `protobuf:"bytes,10,opt,name=ResolvedAt,proto3" json:"ResolvedAt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StockAlert) Reset() {
+	*x = StockAlert{}
+	mi := &file_inventory_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAlert) ProtoMessage() {}
+
+func (x *StockAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockAlert.ProtoReflect.Descriptor instead.
+func (*StockAlert) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *StockAlert) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockAlert) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockAlert) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StockAlert) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StockAlert) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *StockAlert) GetReorderLevel() int64 {
+	if x != nil {
+		return x.ReorderLevel
+	}
+	return 0
+}
+
+func (x *StockAlert) GetReorderQuantity() int64 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *StockAlert) GetMovementId() int64 {
+	if x != nil {
+		return x.MovementId
+	}
+	return 0
+}
+
+func (x *StockAlert) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *StockAlert) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+type ListStockAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"` // Optional filter by product
+	Status        string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`        // Optional filter by status
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockAlertsRequest) Reset() {
+	*x = ListStockAlertsRequest{}
+	mi := &file_inventory_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockAlertsRequest) ProtoMessage() {}
+
+func (x *ListStockAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListStockAlertsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListStockAlertsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListStockAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*StockAlert          `protobuf:"bytes,1,rep,name=Alerts,proto3" json:"Alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockAlertsResponse) Reset() {
+	*x = ListStockAlertsResponse{}
+	mi := &file_inventory_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockAlertsResponse) ProtoMessage() {}
+
+func (x *ListStockAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListStockAlertsResponse) GetAlerts() []*StockAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type SubscribeStockAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []int64                `protobuf:"varint,1,rep,packed,name=ProductIds,proto3" json:"ProductIds,omitempty"` // Optional, alerts of every product when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeStockAlertsRequest) Reset() {
+	*x = SubscribeStockAlertsRequest{}
+	mi := &file_inventory_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeStockAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeStockAlertsRequest) ProtoMessage() {}
+
+func (x *SubscribeStockAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeStockAlertsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeStockAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SubscribeStockAlertsRequest) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+var File_inventory_proto protoreflect.FileDescriptor
+
+const file_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x0finventory.proto\"\xcc\x01\n" +
+	"\x18PurchaseInventoryRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1c\n" +
+	"\tReference\x18\x03 \x01(\tR\tReference\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x04 \x01(\x03R\n" +
+	"LocationId\x12$\n" +
+	"\rSerialNumbers\x18\x05 \x03(\tR\rSerialNumbers\x12\x12\n" +
+	"\x04Unit\x18\x06 \x01(\tR\x04Unit\"\xe1\x03\n" +
+	"\rStockMovement\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12\x16\n" +
+	"\x06Change\x18\x03 \x01(\x03R\x06Change\x12\x12\n" +
+	"\x04Type\x18\x04 \x01(\tR\x04Type\x12\x1c\n" +
+	"\tReference\x18\x05 \x01(\tR\tReference\x12\x12\n" +
+	"\x04Note\x18\x06 \x01(\tR\x04Note\x12\x1c\n" +
+	"\tCreatedAt\x18\a \x01(\tR\tCreatedAt\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\b \x01(\x03R\n" +
+	"LocationId\x12\x1e\n" +
+	"\n" +
+	"ReversalOf\x18\t \x01(\x03R\n" +
+	"ReversalOf\x12\x1e\n" +
+	"\n" +
+	"ReversedBy\x18\n" +
+	" \x01(\x03R\n" +
+	"ReversedBy\x12\x1a\n" +
+	"\bUnitCost\x18\v \x01(\x01R\bUnitCost\x12\x1c\n" +
+	"\tTotalCost\x18\f \x01(\x01R\tTotalCost\x12\x12\n" +
+	"\x04Unit\x18\r \x01(\tR\x04Unit\x12\"\n" +
+	"\fUnitQuantity\x18\x0e \x01(\x03R\fUnitQuantity\x12\x1e\n" +
+	"\n" +
+	"UnitFactor\x18\x0f \x01(\x03R\n" +
+	"UnitFactor\x12\x1e\n" +
+	"\n" +
+	"ReasonCode\x18\x10 \x01(\tR\n" +
+	"ReasonCode\x12\x14\n" +
+	"\x05State\x18\x11 \x01(\tR\x05State\"A\n" +
+	"\x1bReverseStockMovementRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x12\n" +
+	"\x04Note\x18\x02 \x01(\tR\x04Note\"\xf8\x02\n" +
+	"\x16ManageInventoryRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x12\n" +
+	"\x04Note\x18\x03 \x01(\tR\x04Note\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x04 \x01(\x03R\n" +
+	"LocationId\x12\x1c\n" +
+	"\tLotNumber\x18\x05 \x01(\tR\tLotNumber\x12\x1c\n" +
+	"\tExpiresAt\x18\x06 \x01(\tR\tExpiresAt\x12$\n" +
+	"\rSerialNumbers\x18\a \x03(\tR\rSerialNumbers\x12\x1c\n" +
+	"\tReference\x18\b \x01(\tR\tReference\x12\x1a\n" +
+	"\bUnitCost\x18\t \x01(\x01R\bUnitCost\x12\x12\n" +
+	"\x04Unit\x18\n" +
+	" \x01(\tR\x04Unit\x12\x1e\n" +
+	"\n" +
+	"ReasonCode\x18\v \x01(\tR\n" +
+	"ReasonCode\x12 \n" +
+	"\vRequestedBy\x18\f \x01(\tR\vRequestedBy\"R\n" +
+	"\x10CorrectionReason\x12\x12\n" +
+	"\x04Code\x18\x01 \x01(\tR\x04Code\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x16\n" +
+	"\x06Active\x18\x03 \x01(\bR\x06Active\"H\n" +
+	"\x1cListCorrectionReasonsRequest\x12(\n" +
+	"\x0fIncludeInactive\x18\x01 \x01(\bR\x0fIncludeInactive\"L\n" +
+	"\x1dListCorrectionReasonsResponse\x12+\n" +
+	"\aReasons\x18\x01 \x03(\v2\x11.CorrectionReasonR\aReasons\"\xff\x03\n" +
+	"\x0fStockCorrection\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x03 \x01(\x03R\n" +
+	"LocationId\x12\x1a\n" +
+	"\bQuantity\x18\x04 \x01(\x03R\bQuantity\x12\x12\n" +
+	"\x04Unit\x18\x05 \x01(\tR\x04Unit\x12\"\n" +
+	"\fUnitQuantity\x18\x06 \x01(\x03R\fUnitQuantity\x12\x1e\n" +
+	"\n" +
+	"ReasonCode\x18\a \x01(\tR\n" +
+	"ReasonCode\x12\x12\n" +
+	"\x04Note\x18\b \x01(\tR\x04Note\x12\x16\n" +
+	"\x06Status\x18\t \x01(\tR\x06Status\x12 \n" +
+	"\vRequestedBy\x18\n" +
+	" \x01(\tR\vRequestedBy\x12\x1e\n" +
+	"\n" +
+	"ReviewedBy\x18\v \x01(\tR\n" +
+	"ReviewedBy\x12\x16\n" +
+	"\x06Change\x18\f \x01(\x03R\x06Change\x12\x14\n" +
+	"\x05Value\x18\r \x01(\x01R\x05Value\x12\x1c\n" +
+	"\tCreatedAt\x18\x0e \x01(\tR\tCreatedAt\x12\x1e\n" +
+	"\n" +
+	"ReviewedAt\x18\x0f \x01(\tR\n" +
+	"ReviewedAt\x12*\n" +
+	"\bMovement\x18\x10 \x01(\v2\x0e.StockMovementR\bMovement\x12$\n" +
+	"\rSerialNumbers\x18\x11 \x03(\tR\rSerialNumbers\"N\n" +
+	"\x1cReviewStockCorrectionRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1e\n" +
+	"\n" +
+	"ReviewedBy\x18\x02 \x01(\tR\n" +
+	"ReviewedBy\"S\n" +
+	"\x1bListStockCorrectionsRequest\x12\x16\n" +
+	"\x06Status\x18\x01 \x01(\tR\x06Status\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\"R\n" +
+	"\x1cListStockCorrectionsResponse\x122\n" +
+	"\vCorrections\x18\x01 \x03(\v2\x10.StockCorrectionR\vCorrections\"\xfb\x01\n" +
+	"\x19ListStockMovementsRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12\x1c\n" +
+	"\tReference\x18\x03 \x01(\tR\tReference\x12\x12\n" +
+	"\x04From\x18\x04 \x01(\tR\x04From\x12\x0e\n" +
+	"\x02To\x18\x05 \x01(\tR\x02To\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x06 \x01(\x03R\n" +
+	"LocationId\x12\x16\n" +
+	"\x06Cursor\x18\a \x01(\tR\x06Cursor\x12\x14\n" +
+	"\x05Limit\x18\b \x01(\x03R\x05Limit\x12\x1c\n" +
+	"\tSortOrder\x18\t \x01(\tR\tSortOrder\"\x86\x01\n" +
+	"\x1aListStockMovementsResponse\x12(\n" +
+	"\aRecords\x18\x01 \x03(\v2\x0e.StockMovementR\aRecords\x12\x1e\n" +
+	"\n" +
+	"NextCursor\x18\x02 \x01(\tR\n" +
+	"NextCursor\x12\x1e\n" +
+	"\n" +
+	"TotalCount\x18\x03 \x01(\x03R\n" +
+	"TotalCount\"b\n" +
+	"\x10StockAsOfRequest\x12\x1e\n" +
+	"\n" +
+	"ProductIds\x18\x01 \x03(\x03R\n" +
+	"ProductIds\x12\x0e\n" +
+	"\x02At\x18\x02 \x01(\tR\x02At\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x03 \x01(\x03R\n" +
+	"LocationId\"p\n" +
+	"\x11StockAsOfResponse\x12\x0e\n" +
+	"\x02At\x18\x01 \x01(\tR\x02At\x12\x1e\n" +
+	"\n" +
+	"SnapshotId\x18\x02 \x01(\x03R\n" +
+	"SnapshotId\x12+\n" +
+	"\x06Levels\x18\x03 \x03(\v2\x13.LocationStockLevelR\x06Levels\"_\n" +
+	"\x19InventoryValuationRequest\x12\x1e\n" +
+	"\n" +
+	"ProductIds\x18\x01 \x03(\x03R\n" +
+	"ProductIds\x12\x12\n" +
+	"\x04From\x18\x02 \x01(\tR\x04From\x12\x0e\n" +
+	"\x02To\x18\x03 \x01(\tR\x02To\"\xdc\x01\n" +
+	"\x10ProductValuation\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12$\n" +
+	"\rCostingMethod\x18\x02 \x01(\tR\rCostingMethod\x12\x1a\n" +
+	"\bQuantity\x18\x03 \x01(\x03R\bQuantity\x12\x1a\n" +
+	"\bUnitCost\x18\x04 \x01(\x01R\bUnitCost\x12\x14\n" +
+	"\x05Value\x18\x05 \x01(\x01R\x05Value\x12\"\n" +
+	"\fSoldQuantity\x18\x06 \x01(\x03R\fSoldQuantity\x12\x12\n" +
+	"\x04Cogs\x18\a \x01(\x01R\x04Cogs\"\xad\x01\n" +
+	"\x1aInventoryValuationResponse\x12\x12\n" +
+	"\x04From\x18\x01 \x01(\tR\x04From\x12\x0e\n" +
+	"\x02To\x18\x02 \x01(\tR\x02To\x12\x1e\n" +
+	"\n" +
+	"TotalValue\x18\x03 \x01(\x01R\n" +
+	"TotalValue\x12\x1c\n" +
+	"\tTotalCogs\x18\x04 \x01(\x01R\tTotalCogs\x12-\n" +
+	"\bProducts\x18\x05 \x03(\v2\x11.ProductValuationR\bProducts\"O\n" +
+	"\x15ReconcileStockRequest\x12\x1e\n" +
+	"\n" +
+	"ProductIds\x18\x01 \x03(\x03R\n" +
+	"ProductIds\x12\x16\n" +
+	"\x06Repair\x18\x02 \x01(\bR\x06Repair\"\xcc\x01\n" +
+	"\x10StockDiscrepancy\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x02 \x01(\x03R\n" +
+	"LocationId\x12*\n" +
+	"\x10ExpectedQuantity\x18\x03 \x01(\x03R\x10ExpectedQuantity\x12*\n" +
+	"\x10RecordedQuantity\x18\x04 \x01(\x03R\x10RecordedQuantity\x12\"\n" +
+	"\fCorrectionId\x18\x05 \x01(\x03R\fCorrectionId\"\x97\x01\n" +
+	"\x16ReconcileStockResponse\x12(\n" +
+	"\x0fCheckedProducts\x18\x01 \x01(\x03R\x0fCheckedProducts\x127\n" +
+	"\rDiscrepancies\x18\x02 \x03(\v2\x11.StockDiscrepancyR\rDiscrepancies\x12\x1a\n" +
+	"\bRepaired\x18\x03 \x01(\bR\bRepaired\"\xdd\x01\n" +
+	"\x13ReserveStockRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1c\n" +
+	"\tReference\x18\x03 \x01(\tR\tReference\x12\x1e\n" +
+	"\n" +
+	"TtlSeconds\x18\x04 \x01(\x03R\n" +
+	"TtlSeconds\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x05 \x01(\x03R\n" +
+	"LocationId\x12\x12\n" +
+	"\x04Unit\x18\x06 \x01(\tR\x04Unit\x12\x1a\n" +
+	"\bNoExpiry\x18\a \x01(\bR\bNoExpiry\"\xd4\x02\n" +
+	"\x10StockReservation\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x03 \x01(\x03R\bQuantity\x12\x1c\n" +
+	"\tReference\x18\x04 \x01(\tR\tReference\x12\x16\n" +
+	"\x06Status\x18\x05 \x01(\tR\x06Status\x12\x1c\n" +
+	"\tExpiresAt\x18\x06 \x01(\tR\tExpiresAt\x12\x1c\n" +
+	"\tCreatedAt\x18\a \x01(\tR\tCreatedAt\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\b \x01(\x03R\n" +
+	"LocationId\x12\x12\n" +
+	"\x04Unit\x18\t \x01(\tR\x04Unit\x12\"\n" +
+	"\fUnitQuantity\x18\n" +
+	" \x01(\x03R\fUnitQuantity\x12,\n" +
+	"\x11CommittedQuantity\x18\v \x01(\x03R\x11CommittedQuantity\"&\n" +
+	"\x14ReservationIdRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"\x8a\x01\n" +
+	"\x18CommitReservationRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12$\n" +
+	"\rSerialNumbers\x18\x02 \x03(\tR\rSerialNumbers\x12\x1a\n" +
+	"\bQuantity\x18\x03 \x01(\x03R\bQuantity\x12\x1c\n" +
+	"\tReference\x18\x04 \x01(\tR\tReference\"\xaa\x01\n" +
+	"\bLocation\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x12\n" +
+	"\x04Code\x18\x02 \x01(\tR\x04Code\x12\x12\n" +
+	"\x04Name\x18\x03 \x01(\tR\x04Name\x12$\n" +
+	"\rTotalQuantity\x18\x04 \x01(\x03R\rTotalQuantity\x12\"\n" +
+	"\fProductCount\x18\x05 \x01(\x03R\fProductCount\x12\x1c\n" +
+	"\tCreatedAt\x18\x06 \x01(\tR\tCreatedAt\"?\n" +
+	"\x15CreateLocationRequest\x12\x12\n" +
+	"\x04Code\x18\x01 \x01(\tR\x04Code\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\"\x16\n" +
+	"\x14ListLocationsRequest\"@\n" +
+	"\x15ListLocationsResponse\x12'\n" +
+	"\tLocations\x18\x01 \x03(\v2\t.LocationR\tLocations\"#\n" +
+	"\x11LocationIdRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"\xe6\x01\n" +
+	"\x12LocationStockLevel\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1c\n" +
+	"\tAvailable\x18\x03 \x01(\x03R\tAvailable\x12 \n" +
+	"\vQuarantined\x18\x04 \x01(\x03R\vQuarantined\x12\x18\n" +
+	"\aDamaged\x18\x05 \x01(\x03R\aDamaged\x12\x1c\n" +
+	"\tInTransit\x18\x06 \x01(\x03R\tInTransit\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\a \x01(\x03R\n" +
+	"LocationId\"k\n" +
+	"\x15LocationStockResponse\x12%\n" +
+	"\bLocation\x18\x01 \x01(\v2\t.LocationR\bLocation\x12+\n" +
+	"\x06Levels\x18\x02 \x03(\v2\x13.LocationStockLevelR\x06Levels\"\xd6\x01\n" +
+	"\x14TransferStockRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12&\n" +
+	"\x0eFromLocationId\x18\x02 \x01(\x03R\x0eFromLocationId\x12\"\n" +
+	"\fToLocationId\x18\x03 \x01(\x03R\fToLocationId\x12\x1a\n" +
+	"\bQuantity\x18\x04 \x01(\x03R\bQuantity\x12\x12\n" +
+	"\x04Note\x18\x05 \x01(\tR\x04Note\x12$\n" +
+	"\rSerialNumbers\x18\x06 \x03(\tR\rSerialNumbers\"Y\n" +
+	"\x15TransferStockResponse\x12 \n" +
+	"\x03Out\x18\x01 \x01(\v2\x0e.StockMovementR\x03Out\x12\x1e\n" +
+	"\x02In\x18\x02 \x01(\v2\x0e.StockMovementR\x02In\"\xe3\x01\n" +
+	"\x15MoveStockStateRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x02 \x01(\x03R\n" +
+	"LocationId\x12\x1a\n" +
+	"\bQuantity\x18\x03 \x01(\x03R\bQuantity\x12\x1c\n" +
+	"\tFromState\x18\x04 \x01(\tR\tFromState\x12\x18\n" +
+	"\aToState\x18\x05 \x01(\tR\aToState\x12\x12\n" +
+	"\x04Note\x18\x06 \x01(\tR\x04Note\x12$\n" +
+	"\rSerialNumbers\x18\a \x03(\tR\rSerialNumbers\"Z\n" +
+	"\x16MoveStockStateResponse\x12 \n" +
+	"\x03Out\x18\x01 \x01(\v2\x0e.StockMovementR\x03Out\x12\x1e\n" +
+	"\x02In\x18\x02 \x01(\v2\x0e.StockMovementR\x02In\"9\n" +
+	"\x19ProductStockStatesRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\"\xd9\x01\n" +
+	"\x1aProductStockStatesResponse\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x16\n" +
+	"\x06OnHand\x18\x02 \x01(\x03R\x06OnHand\x12\x1a\n" +
+	"\bReserved\x18\x03 \x01(\x03R\bReserved\x12\x12\n" +
+	"\x04Held\x18\x04 \x01(\x03R\x04Held\x12(\n" +
+	"\x0fAvailableToSell\x18\x05 \x01(\x03R\x0fAvailableToSell\x12+\n" +
+	"\x06Levels\x18\x06 \x03(\v2\x13.LocationStockLevelR\x06Levels\"z\n" +
+	"\x12AssembleKitRequest\x12\x14\n" +
+	"\x05KitId\x18\x01 \x01(\x03R\x05KitId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x03 \x01(\x03R\n" +
+	"LocationId\x12\x12\n" +
+	"\x04Note\x18\x04 \x01(\tR\x04Note\"\x97\x02\n" +
+	"\vKitAssembly\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
+	"\tReference\x18\x02 \x01(\tR\tReference\x12\x14\n" +
+	"\x05KitId\x18\x03 \x01(\x03R\x05KitId\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x04 \x01(\x03R\n" +
+	"LocationId\x12\x1a\n" +
+	"\bQuantity\x18\x05 \x01(\x03R\bQuantity\x12\x12\n" +
+	"\x04Note\x18\x06 \x01(\tR\x04Note\x12\x1c\n" +
+	"\tCreatedAt\x18\a \x01(\tR\tCreatedAt\x12&\n" +
+	"\x06Output\x18\b \x01(\v2\x0e.StockMovementR\x06Output\x12.\n" +
+	"\n" +
+	"Components\x18\t \x03(\v2\x0e.StockMovementR\n" +
+	"Components\"\x8e\x02\n" +
+	"\n" +
+	"StockBatch\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x03 \x01(\x03R\n" +
+	"LocationId\x12\x1c\n" +
+	"\tLotNumber\x18\x04 \x01(\tR\tLotNumber\x12\x1c\n" +
+	"\tExpiresAt\x18\x05 \x01(\tR\tExpiresAt\x12*\n" +
+	"\x10ReceivedQuantity\x18\x06 \x01(\x03R\x10ReceivedQuantity\x12,\n" +
+	"\x11RemainingQuantity\x18\a \x01(\x03R\x11RemainingQuantity\x12\x1c\n" +
+	"\tCreatedAt\x18\b \x01(\tR\tCreatedAt\"{\n" +
+	"\x17ListStockBatchesRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x02 \x01(\x03R\n" +
+	"LocationId\x12\"\n" +
+	"\fIncludeEmpty\x18\x03 \x01(\bR\fIncludeEmpty\"P\n" +
+	"\x1aListExpiringBatchesRequest\x12\x12\n" +
+	"\x04Days\x18\x01 \x01(\x03R\x04Days\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x02 \x01(\x03R\n" +
+	"LocationId\"A\n" +
+	"\x18ListStockBatchesResponse\x12%\n" +
+	"\aBatches\x18\x01 \x03(\v2\v.StockBatchR\aBatches\"\xb9\x01\n" +
+	"\x0fSerialUnitEvent\x12\x1e\n" +
+	"\n" +
+	"MovementId\x18\x01 \x01(\x03R\n" +
+	"MovementId\x12\x12\n" +
+	"\x04Type\x18\x02 \x01(\tR\x04Type\x12\x16\n" +
+	"\x06Status\x18\x03 \x01(\tR\x06Status\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x04 \x01(\x03R\n" +
+	"LocationId\x12\x1c\n" +
+	"\tReference\x18\x05 \x01(\tR\tReference\x12\x1c\n" +
+	"\tCreatedAt\x18\x06 \x01(\tR\tCreatedAt\"\xfc\x01\n" +
+	"\n" +
+	"SerialUnit\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12\"\n" +
+	"\fSerialNumber\x18\x03 \x01(\tR\fSerialNumber\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x04 \x01(\x03R\n" +
+	"LocationId\x12\x16\n" +
+	"\x06Status\x18\x05 \x01(\tR\x06Status\x12\x1c\n" +
+	"\tCreatedAt\x18\x06 \x01(\tR\tCreatedAt\x12\x1c\n" +
+	"\tUpdatedAt\x18\a \x01(\tR\tUpdatedAt\x12(\n" +
+	"\x06Events\x18\b \x03(\v2\x10.SerialUnitEventR\x06Events\"n\n" +
+	"\x16ListSerialUnitsRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x02 \x01(\x03R\n" +
+	"LocationId\x12\x16\n" +
+	"\x06Status\x18\x03 \x01(\tR\x06Status\"X\n" +
+	"\x14SerialHistoryRequest\x12\"\n" +
+	"\fSerialNumber\x18\x01 \x01(\tR\fSerialNumber\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\"<\n" +
+	"\x17ListSerialUnitsResponse\x12!\n" +
+	"\x05Units\x18\x01 \x03(\v2\v.SerialUnitR\x05Units\"\x97\x01\n" +
+	"\x17ScrapSerialUnitsRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12$\n" +
+	"\rSerialNumbers\x18\x03 \x03(\tR\rSerialNumbers\x12\x1c\n" +
+	"\tReference\x18\x04 \x01(\tR\tReference\"e\n" +
+	"\x0fCycleCountEntry\x12\x18\n" +
+	"\aCounter\x18\x01 \x01(\tR\aCounter\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1c\n" +
+	"\tCreatedAt\x18\x03 \x01(\tR\tCreatedAt\"\xce\x02\n" +
+	"\x0eCycleCountLine\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x03 \x01(\x03R\n" +
+	"LocationId\x12&\n" +
+	"\x0eSystemQuantity\x18\x04 \x01(\x03R\x0eSystemQuantity\x12\x18\n" +
+	"\aCounted\x18\x05 \x01(\bR\aCounted\x12(\n" +
+	"\x0fCountedQuantity\x18\x06 \x01(\x03R\x0fCountedQuantity\x12\x1a\n" +
+	"\bVariance\x18\a \x01(\x03R\bVariance\x12\x1a\n" +
+	"\bApproved\x18\b \x01(\bR\bApproved\x12\x1e\n" +
+	"\n" +
+	"MovementId\x18\t \x01(\x03R\n" +
+	"MovementId\x12*\n" +
+	"\aEntries\x18\n" +
+	" \x03(\v2\x10.CycleCountEntryR\aEntries\"\xa9\x01\n" +
+	"\n" +
+	"CycleCount\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x16\n" +
+	"\x06Status\x18\x02 \x01(\tR\x06Status\x12\x12\n" +
+	"\x04Note\x18\x03 \x01(\tR\x04Note\x12\x1c\n" +
+	"\tCreatedAt\x18\x04 \x01(\tR\tCreatedAt\x12\x1a\n" +
+	"\bPostedAt\x18\x05 \x01(\tR\bPostedAt\x12%\n" +
+	"\x05Lines\x18\x06 \x03(\v2\x0f.CycleCountLineR\x05Lines\"m\n" +
+	"\x15OpenCycleCountRequest\x12\x1e\n" +
+	"\n" +
+	"ProductIds\x18\x01 \x03(\x03R\n" +
+	"ProductIds\x12 \n" +
+	"\vLocationIds\x18\x02 \x03(\x03R\vLocationIds\x12\x12\n" +
+	"\x04Note\x18\x03 \x01(\tR\x04Note\"0\n" +
+	"\x16ListCycleCountsRequest\x12\x16\n" +
+	"\x06Status\x18\x01 \x01(\tR\x06Status\">\n" +
+	"\x17ListCycleCountsResponse\x12#\n" +
+	"\x06Counts\x18\x01 \x03(\v2\v.CycleCountR\x06Counts\"%\n" +
+	"\x13CycleCountIdRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"k\n" +
+	"\x0fCountedQuantity\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x02 \x01(\x03R\n" +
+	"LocationId\x12\x1a\n" +
+	"\bQuantity\x18\x03 \x01(\x03R\bQuantity\"y\n" +
+	"\x17RecordCycleCountRequest\x12\x18\n" +
+	"\aCountId\x18\x01 \x01(\x03R\aCountId\x12\x18\n" +
+	"\aCounter\x18\x02 \x01(\tR\aCounter\x12*\n" +
+	"\aEntries\x18\x03 \x03(\v2\x10.CountedQuantityR\aEntries\"`\n" +
+	"\x18ApproveCycleCountRequest\x12\x18\n" +
+	"\aCountId\x18\x01 \x01(\x03R\aCountId\x12\x18\n" +
+	"\aLineIds\x18\x02 \x03(\x03R\aLineIds\x12\x10\n" +
+	"\x03All\x18\x03 \x01(\bR\x03All\"\xb8\x02\n" +
+	"\n" +
+	"StockAlert\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12\x12\n" +
+	"\x04Type\x18\x03 \x01(\tR\x04Type\x12\x16\n" +
+	"\x06Status\x18\x04 \x01(\tR\x06Status\x12$\n" +
+	"\rStockQuantity\x18\x05 \x01(\x03R\rStockQuantity\x12\"\n" +
+	"\fReorderLevel\x18\x06 \x01(\x03R\fReorderLevel\x12(\n" +
+	"\x0fReorderQuantity\x18\a \x01(\x03R\x0fReorderQuantity\x12\x1e\n" +
+	"\n" +
+	"MovementId\x18\b \x01(\x03R\n" +
+	"MovementId\x12\x1c\n" +
+	"\tCreatedAt\x18\t \x01(\tR\tCreatedAt\x12\x1e\n" +
+	"\n" +
+	"ResolvedAt\x18\n" +
+	" \x01(\tR\n" +
+	"ResolvedAt\"N\n" +
+	"\x16ListStockAlertsRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x16\n" +
+	"\x06Status\x18\x02 \x01(\tR\x06Status\">\n" +
+	"\x17ListStockAlertsResponse\x12#\n" +
+	"\x06Alerts\x18\x01 \x03(\v2\v.StockAlertR\x06Alerts\"=\n" +
+	"\x1bSubscribeStockAlertsRequest\x12\x1e\n" +
+	"\n" +
+	"ProductIds\x18\x01 \x03(\x03R\n" +
+	"ProductIds2\xcd\x15\n" +
 	"\x10InventoryService\x12E\n" +
 	"\x18PurchaseInventoryProduct\x12\x19.PurchaseInventoryRequest\x1a\x0e.StockMovement\x12A\n" +
-	"\x16SupplyInventoryProduct\x12\x17.ManageInventoryRequest\x1a\x0e.StockMovement\x12@\n" +
-	"\x15CorrectInventoryStock\x12\x17.ManageInventoryRequest\x1a\x0e.StockMovement\x12M\n" +
-	"\x12ListStockMovements\x12\x1a.ListStockMovementsRequest\x1a\x1b.ListStockMovementsResponseB3Z1github.com/logan2k02/ims/shared/protobuf;protobufb\x06proto3"
+	"\x16SupplyInventoryProduct\x12\x17.ManageInventoryRequest\x1a\x0e.StockMovement\x12D\n" +
+	"\x17RestockInventoryProduct\x12\x19.PurchaseInventoryRequest\x1a\x0e.StockMovement\x12C\n" +
+	"\x16ReturnInventoryProduct\x12\x19.PurchaseInventoryRequest\x1a\x0e.StockMovement\x12B\n" +
+	"\x15CorrectInventoryStock\x12\x17.ManageInventoryRequest\x1a\x10.StockCorrection\x12I\n" +
+	"\x16ApproveStockCorrection\x12\x1d.ReviewStockCorrectionRequest\x1a\x10.StockCorrection\x12H\n" +
+	"\x15RejectStockCorrection\x12\x1d.ReviewStockCorrectionRequest\x1a\x10.StockCorrection\x12S\n" +
+	"\x14ListStockCorrections\x12\x1c.ListStockCorrectionsRequest\x1a\x1d.ListStockCorrectionsResponse\x12V\n" +
+	"\x15ListCorrectionReasons\x12\x1d.ListCorrectionReasonsRequest\x1a\x1e.ListCorrectionReasonsResponse\x12<\n" +
+	"\x14SaveCorrectionReason\x12\x11.CorrectionReason\x1a\x11.CorrectionReason\x12M\n" +
+	"\x12ListStockMovements\x12\x1a.ListStockMovementsRequest\x1a\x1b.ListStockMovementsResponse\x12D\n" +
+	"\x14ReverseStockMovement\x12\x1c.ReverseStockMovementRequest\x1a\x0e.StockMovement\x125\n" +
+	"\fGetStockAsOf\x12\x11.StockAsOfRequest\x1a\x12.StockAsOfResponse\x12A\n" +
+	"\x0eReconcileStock\x12\x16.ReconcileStockRequest\x1a\x17.ReconcileStockResponse\x12P\n" +
+	"\x15GetInventoryValuation\x12\x1a.InventoryValuationRequest\x1a\x1b.InventoryValuationResponse\x127\n" +
+	"\fReserveStock\x12\x14.ReserveStockRequest\x1a\x11.StockReservation\x12>\n" +
+	"\x11CommitReservation\x12\x19.CommitReservationRequest\x1a\x0e.StockMovement\x12>\n" +
+	"\x12ReleaseReservation\x12\x15.ReservationIdRequest\x1a\x11.StockReservation\x123\n" +
+	"\x0eCreateLocation\x12\x16.CreateLocationRequest\x1a\t.Location\x12>\n" +
+	"\rListLocations\x12\x15.ListLocationsRequest\x1a\x16.ListLocationsResponse\x12>\n" +
+	"\x10GetLocationStock\x12\x12.LocationIdRequest\x1a\x16.LocationStockResponse\x12>\n" +
+	"\rTransferStock\x12\x15.TransferStockRequest\x1a\x16.TransferStockResponse\x12A\n" +
+	"\x0eMoveStockState\x12\x16.MoveStockStateRequest\x1a\x17.MoveStockStateResponse\x12P\n" +
+	"\x15GetProductStockStates\x12\x1a.ProductStockStatesRequest\x1a\x1b.ProductStockStatesResponse\x120\n" +
+	"\vAssembleKit\x12\x13.AssembleKitRequest\x1a\f.KitAssembly\x12G\n" +
+	"\x10ListStockBatches\x12\x18.ListStockBatchesRequest\x1a\x19.ListStockBatchesResponse\x12M\n" +
+	"\x13ListExpiringBatches\x12\x1b.ListExpiringBatchesRequest\x1a\x19.ListStockBatchesResponse\x12D\n" +
+	"\x0fListSerialUnits\x12\x17.ListSerialUnitsRequest\x1a\x18.ListSerialUnitsResponse\x12C\n" +
+	"\x10GetSerialHistory\x12\x15.SerialHistoryRequest\x1a\x18.ListSerialUnitsResponse\x12F\n" +
+	"\x10ScrapSerialUnits\x12\x18.ScrapSerialUnitsRequest\x1a\x18.ListSerialUnitsResponse\x12H\n" +
+	"\x12RestoreSerialUnits\x12\x18.ScrapSerialUnitsRequest\x1a\x18.ListSerialUnitsResponse\x125\n" +
+	"\x0eOpenCycleCount\x12\x16.OpenCycleCountRequest\x1a\v.CycleCount\x12D\n" +
+	"\x0fListCycleCounts\x12\x17.ListCycleCountsRequest\x1a\x18.ListCycleCountsResponse\x122\n" +
+	"\rGetCycleCount\x12\x14.CycleCountIdRequest\x1a\v.CycleCount\x129\n" +
+	"\x10RecordCycleCount\x12\x18.RecordCycleCountRequest\x1a\v.CycleCount\x125\n" +
+	"\x10SubmitCycleCount\x12\x14.CycleCountIdRequest\x1a\v.CycleCount\x12;\n" +
+	"\x11ApproveCycleCount\x12\x19.ApproveCycleCountRequest\x1a\v.CycleCount\x123\n" +
+	"\x0ePostCycleCount\x12\x14.CycleCountIdRequest\x1a\v.CycleCount\x125\n" +
+	"\x10CancelCycleCount\x12\x14.CycleCountIdRequest\x1a\v.CycleCount\x12D\n" +
+	"\x0fListStockAlerts\x12\x17.ListStockAlertsRequest\x1a\x18.ListStockAlertsResponse\x12C\n" +
+	"\x14SubscribeStockAlerts\x12\x1c.SubscribeStockAlertsRequest\x1a\v.StockAlert0\x01B3Z1github.com/logan2k02/ims/shared/protobuf;protobufb\x06proto3"
 
 var (
 	file_inventory_proto_rawDescOnce sync.Once
@@ -364,29 +4997,186 @@ func file_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_proto_rawDescData
 }
 
-var file_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_inventory_proto_goTypes = []any{
-	(*PurchaseInventoryRequest)(nil),   // 0: PurchaseInventoryRequest
-	(*StockMovement)(nil),              // 1: StockMovement
-	(*ManageInventoryRequest)(nil),     // 2: ManageInventoryRequest
-	(*ListStockMovementsRequest)(nil),  // 3: ListStockMovementsRequest
-	(*ListStockMovementsResponse)(nil), // 4: ListStockMovementsResponse
+	(*PurchaseInventoryRequest)(nil),      // 0: PurchaseInventoryRequest
+	(*StockMovement)(nil),                 // 1: StockMovement
+	(*ReverseStockMovementRequest)(nil),   // 2: ReverseStockMovementRequest
+	(*ManageInventoryRequest)(nil),        // 3: ManageInventoryRequest
+	(*CorrectionReason)(nil),              // 4: CorrectionReason
+	(*ListCorrectionReasonsRequest)(nil),  // 5: ListCorrectionReasonsRequest
+	(*ListCorrectionReasonsResponse)(nil), // 6: ListCorrectionReasonsResponse
+	(*StockCorrection)(nil),               // 7: StockCorrection
+	(*ReviewStockCorrectionRequest)(nil),  // 8: ReviewStockCorrectionRequest
+	(*ListStockCorrectionsRequest)(nil),   // 9: ListStockCorrectionsRequest
+	(*ListStockCorrectionsResponse)(nil),  // 10: ListStockCorrectionsResponse
+	(*ListStockMovementsRequest)(nil),     // 11: ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil),    // 12: ListStockMovementsResponse
+	(*StockAsOfRequest)(nil),              // 13: StockAsOfRequest
+	(*StockAsOfResponse)(nil),             // 14: StockAsOfResponse
+	(*InventoryValuationRequest)(nil),     // 15: InventoryValuationRequest
+	(*ProductValuation)(nil),              // 16: ProductValuation
+	(*InventoryValuationResponse)(nil),    // 17: InventoryValuationResponse
+	(*ReconcileStockRequest)(nil),         // 18: ReconcileStockRequest
+	(*StockDiscrepancy)(nil),              // 19: StockDiscrepancy
+	(*ReconcileStockResponse)(nil),        // 20: ReconcileStockResponse
+	(*ReserveStockRequest)(nil),           // 21: ReserveStockRequest
+	(*StockReservation)(nil),              // 22: StockReservation
+	(*ReservationIdRequest)(nil),          // 23: ReservationIdRequest
+	(*CommitReservationRequest)(nil),      // 24: CommitReservationRequest
+	(*Location)(nil),                      // 25: Location
+	(*CreateLocationRequest)(nil),         // 26: CreateLocationRequest
+	(*ListLocationsRequest)(nil),          // 27: ListLocationsRequest
+	(*ListLocationsResponse)(nil),         // 28: ListLocationsResponse
+	(*LocationIdRequest)(nil),             // 29: LocationIdRequest
+	(*LocationStockLevel)(nil),            // 30: LocationStockLevel
+	(*LocationStockResponse)(nil),         // 31: LocationStockResponse
+	(*TransferStockRequest)(nil),          // 32: TransferStockRequest
+	(*TransferStockResponse)(nil),         // 33: TransferStockResponse
+	(*MoveStockStateRequest)(nil),         // 34: MoveStockStateRequest
+	(*MoveStockStateResponse)(nil),        // 35: MoveStockStateResponse
+	(*ProductStockStatesRequest)(nil),     // 36: ProductStockStatesRequest
+	(*ProductStockStatesResponse)(nil),    // 37: ProductStockStatesResponse
+	(*AssembleKitRequest)(nil),            // 38: AssembleKitRequest
+	(*KitAssembly)(nil),                   // 39: KitAssembly
+	(*StockBatch)(nil),                    // 40: StockBatch
+	(*ListStockBatchesRequest)(nil),       // 41: ListStockBatchesRequest
+	(*ListExpiringBatchesRequest)(nil),    // 42: ListExpiringBatchesRequest
+	(*ListStockBatchesResponse)(nil),      // 43: ListStockBatchesResponse
+	(*SerialUnitEvent)(nil),               // 44: SerialUnitEvent
+	(*SerialUnit)(nil),                    // 45: SerialUnit
+	(*ListSerialUnitsRequest)(nil),        // 46: ListSerialUnitsRequest
+	(*SerialHistoryRequest)(nil),          // 47: SerialHistoryRequest
+	(*ListSerialUnitsResponse)(nil),       // 48: ListSerialUnitsResponse
+	(*ScrapSerialUnitsRequest)(nil),       // 49: ScrapSerialUnitsRequest
+	(*CycleCountEntry)(nil),               // 50: CycleCountEntry
+	(*CycleCountLine)(nil),                // 51: CycleCountLine
+	(*CycleCount)(nil),                    // 52: CycleCount
+	(*OpenCycleCountRequest)(nil),         // 53: OpenCycleCountRequest
+	(*ListCycleCountsRequest)(nil),        // 54: ListCycleCountsRequest
+	(*ListCycleCountsResponse)(nil),       // 55: ListCycleCountsResponse
+	(*CycleCountIdRequest)(nil),           // 56: CycleCountIdRequest
+	(*CountedQuantity)(nil),               // 57: CountedQuantity
+	(*RecordCycleCountRequest)(nil),       // 58: RecordCycleCountRequest
+	(*ApproveCycleCountRequest)(nil),      // 59: ApproveCycleCountRequest
+	(*StockAlert)(nil),                    // 60: StockAlert
+	(*ListStockAlertsRequest)(nil),        // 61: ListStockAlertsRequest
+	(*ListStockAlertsResponse)(nil),       // 62: ListStockAlertsResponse
+	(*SubscribeStockAlertsRequest)(nil),   // 63: SubscribeStockAlertsRequest
 }
 var file_inventory_proto_depIdxs = []int32{
-	1, // 0: ListStockMovementsResponse.Records:type_name -> StockMovement
-	0, // 1: InventoryService.PurchaseInventoryProduct:input_type -> PurchaseInventoryRequest
-	2, // 2: InventoryService.SupplyInventoryProduct:input_type -> ManageInventoryRequest
-	2, // 3: InventoryService.CorrectInventoryStock:input_type -> ManageInventoryRequest
-	3, // 4: InventoryService.ListStockMovements:input_type -> ListStockMovementsRequest
-	1, // 5: InventoryService.PurchaseInventoryProduct:output_type -> StockMovement
-	1, // 6: InventoryService.SupplyInventoryProduct:output_type -> StockMovement
-	1, // 7: InventoryService.CorrectInventoryStock:output_type -> StockMovement
-	4, // 8: InventoryService.ListStockMovements:output_type -> ListStockMovementsResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4,  // 0: ListCorrectionReasonsResponse.Reasons:type_name -> CorrectionReason
+	1,  // 1: StockCorrection.Movement:type_name -> StockMovement
+	7,  // 2: ListStockCorrectionsResponse.Corrections:type_name -> StockCorrection
+	1,  // 3: ListStockMovementsResponse.Records:type_name -> StockMovement
+	30, // 4: StockAsOfResponse.Levels:type_name -> LocationStockLevel
+	16, // 5: InventoryValuationResponse.Products:type_name -> ProductValuation
+	19, // 6: ReconcileStockResponse.Discrepancies:type_name -> StockDiscrepancy
+	25, // 7: ListLocationsResponse.Locations:type_name -> Location
+	25, // 8: LocationStockResponse.Location:type_name -> Location
+	30, // 9: LocationStockResponse.Levels:type_name -> LocationStockLevel
+	1,  // 10: TransferStockResponse.Out:type_name -> StockMovement
+	1,  // 11: TransferStockResponse.In:type_name -> StockMovement
+	1,  // 12: MoveStockStateResponse.Out:type_name -> StockMovement
+	1,  // 13: MoveStockStateResponse.In:type_name -> StockMovement
+	30, // 14: ProductStockStatesResponse.Levels:type_name -> LocationStockLevel
+	1,  // 15: KitAssembly.Output:type_name -> StockMovement
+	1,  // 16: KitAssembly.Components:type_name -> StockMovement
+	40, // 17: ListStockBatchesResponse.Batches:type_name -> StockBatch
+	44, // 18: SerialUnit.Events:type_name -> SerialUnitEvent
+	45, // 19: ListSerialUnitsResponse.Units:type_name -> SerialUnit
+	50, // 20: CycleCountLine.Entries:type_name -> CycleCountEntry
+	51, // 21: CycleCount.Lines:type_name -> CycleCountLine
+	52, // 22: ListCycleCountsResponse.Counts:type_name -> CycleCount
+	57, // 23: RecordCycleCountRequest.Entries:type_name -> CountedQuantity
+	60, // 24: ListStockAlertsResponse.Alerts:type_name -> StockAlert
+	0,  // 25: InventoryService.PurchaseInventoryProduct:input_type -> PurchaseInventoryRequest
+	3,  // 26: InventoryService.SupplyInventoryProduct:input_type -> ManageInventoryRequest
+	0,  // 27: InventoryService.RestockInventoryProduct:input_type -> PurchaseInventoryRequest
+	0,  // 28: InventoryService.ReturnInventoryProduct:input_type -> PurchaseInventoryRequest
+	3,  // 29: InventoryService.CorrectInventoryStock:input_type -> ManageInventoryRequest
+	8,  // 30: InventoryService.ApproveStockCorrection:input_type -> ReviewStockCorrectionRequest
+	8,  // 31: InventoryService.RejectStockCorrection:input_type -> ReviewStockCorrectionRequest
+	9,  // 32: InventoryService.ListStockCorrections:input_type -> ListStockCorrectionsRequest
+	5,  // 33: InventoryService.ListCorrectionReasons:input_type -> ListCorrectionReasonsRequest
+	4,  // 34: InventoryService.SaveCorrectionReason:input_type -> CorrectionReason
+	11, // 35: InventoryService.ListStockMovements:input_type -> ListStockMovementsRequest
+	2,  // 36: InventoryService.ReverseStockMovement:input_type -> ReverseStockMovementRequest
+	13, // 37: InventoryService.GetStockAsOf:input_type -> StockAsOfRequest
+	18, // 38: InventoryService.ReconcileStock:input_type -> ReconcileStockRequest
+	15, // 39: InventoryService.GetInventoryValuation:input_type -> InventoryValuationRequest
+	21, // 40: InventoryService.ReserveStock:input_type -> ReserveStockRequest
+	24, // 41: InventoryService.CommitReservation:input_type -> CommitReservationRequest
+	23, // 42: InventoryService.ReleaseReservation:input_type -> ReservationIdRequest
+	26, // 43: InventoryService.CreateLocation:input_type -> CreateLocationRequest
+	27, // 44: InventoryService.ListLocations:input_type -> ListLocationsRequest
+	29, // 45: InventoryService.GetLocationStock:input_type -> LocationIdRequest
+	32, // 46: InventoryService.TransferStock:input_type -> TransferStockRequest
+	34, // 47: InventoryService.MoveStockState:input_type -> MoveStockStateRequest
+	36, // 48: InventoryService.GetProductStockStates:input_type -> ProductStockStatesRequest
+	38, // 49: InventoryService.AssembleKit:input_type -> AssembleKitRequest
+	41, // 50: InventoryService.ListStockBatches:input_type -> ListStockBatchesRequest
+	42, // 51: InventoryService.ListExpiringBatches:input_type -> ListExpiringBatchesRequest
+	46, // 52: InventoryService.ListSerialUnits:input_type -> ListSerialUnitsRequest
+	47, // 53: InventoryService.GetSerialHistory:input_type -> SerialHistoryRequest
+	49, // 54: InventoryService.ScrapSerialUnits:input_type -> ScrapSerialUnitsRequest
+	49, // 55: InventoryService.RestoreSerialUnits:input_type -> ScrapSerialUnitsRequest
+	53, // 56: InventoryService.OpenCycleCount:input_type -> OpenCycleCountRequest
+	54, // 57: InventoryService.ListCycleCounts:input_type -> ListCycleCountsRequest
+	56, // 58: InventoryService.GetCycleCount:input_type -> CycleCountIdRequest
+	58, // 59: InventoryService.RecordCycleCount:input_type -> RecordCycleCountRequest
+	56, // 60: InventoryService.SubmitCycleCount:input_type -> CycleCountIdRequest
+	59, // 61: InventoryService.ApproveCycleCount:input_type -> ApproveCycleCountRequest
+	56, // 62: InventoryService.PostCycleCount:input_type -> CycleCountIdRequest
+	56, // 63: InventoryService.CancelCycleCount:input_type -> CycleCountIdRequest
+	61, // 64: InventoryService.ListStockAlerts:input_type -> ListStockAlertsRequest
+	63, // 65: InventoryService.SubscribeStockAlerts:input_type -> SubscribeStockAlertsRequest
+	1,  // 66: InventoryService.PurchaseInventoryProduct:output_type -> StockMovement
+	1,  // 67: InventoryService.SupplyInventoryProduct:output_type -> StockMovement
+	1,  // 68: InventoryService.RestockInventoryProduct:output_type -> StockMovement
+	1,  // 69: InventoryService.ReturnInventoryProduct:output_type -> StockMovement
+	7,  // 70: InventoryService.CorrectInventoryStock:output_type -> StockCorrection
+	7,  // 71: InventoryService.ApproveStockCorrection:output_type -> StockCorrection
+	7,  // 72: InventoryService.RejectStockCorrection:output_type -> StockCorrection
+	10, // 73: InventoryService.ListStockCorrections:output_type -> ListStockCorrectionsResponse
+	6,  // 74: InventoryService.ListCorrectionReasons:output_type -> ListCorrectionReasonsResponse
+	4,  // 75: InventoryService.SaveCorrectionReason:output_type -> CorrectionReason
+	12, // 76: InventoryService.ListStockMovements:output_type -> ListStockMovementsResponse
+	1,  // 77: InventoryService.ReverseStockMovement:output_type -> StockMovement
+	14, // 78: InventoryService.GetStockAsOf:output_type -> StockAsOfResponse
+	20, // 79: InventoryService.ReconcileStock:output_type -> ReconcileStockResponse
+	17, // 80: InventoryService.GetInventoryValuation:output_type -> InventoryValuationResponse
+	22, // 81: InventoryService.ReserveStock:output_type -> StockReservation
+	1,  // 82: InventoryService.CommitReservation:output_type -> StockMovement
+	22, // 83: InventoryService.ReleaseReservation:output_type -> StockReservation
+	25, // 84: InventoryService.CreateLocation:output_type -> Location
+	28, // 85: InventoryService.ListLocations:output_type -> ListLocationsResponse
+	31, // 86: InventoryService.GetLocationStock:output_type -> LocationStockResponse
+	33, // 87: InventoryService.TransferStock:output_type -> TransferStockResponse
+	35, // 88: InventoryService.MoveStockState:output_type -> MoveStockStateResponse
+	37, // 89: InventoryService.GetProductStockStates:output_type -> ProductStockStatesResponse
+	39, // 90: InventoryService.AssembleKit:output_type -> KitAssembly
+	43, // 91: InventoryService.ListStockBatches:output_type -> ListStockBatchesResponse
+	43, // 92: InventoryService.ListExpiringBatches:output_type -> ListStockBatchesResponse
+	48, // 93: InventoryService.ListSerialUnits:output_type -> ListSerialUnitsResponse
+	48, // 94: InventoryService.GetSerialHistory:output_type -> ListSerialUnitsResponse
+	48, // 95: InventoryService.ScrapSerialUnits:output_type -> ListSerialUnitsResponse
+	48, // 96: InventoryService.RestoreSerialUnits:output_type -> ListSerialUnitsResponse
+	52, // 97: InventoryService.OpenCycleCount:output_type -> CycleCount
+	55, // 98: InventoryService.ListCycleCounts:output_type -> ListCycleCountsResponse
+	52, // 99: InventoryService.GetCycleCount:output_type -> CycleCount
+	52, // 100: InventoryService.RecordCycleCount:output_type -> CycleCount
+	52, // 101: InventoryService.SubmitCycleCount:output_type -> CycleCount
+	52, // 102: InventoryService.ApproveCycleCount:output_type -> CycleCount
+	52, // 103: InventoryService.PostCycleCount:output_type -> CycleCount
+	52, // 104: InventoryService.CancelCycleCount:output_type -> CycleCount
+	62, // 105: InventoryService.ListStockAlerts:output_type -> ListStockAlertsResponse
+	60, // 106: InventoryService.SubscribeStockAlerts:output_type -> StockAlert
+	66, // [66:107] is the sub-list for method output_type
+	25, // [25:66] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_inventory_proto_init() }
@@ -400,7 +5190,7 @@ func file_inventory_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_proto_rawDesc), len(file_inventory_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,8 +21,45 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	InventoryService_PurchaseInventoryProduct_FullMethodName = "/InventoryService/PurchaseInventoryProduct"
 	InventoryService_SupplyInventoryProduct_FullMethodName   = "/InventoryService/SupplyInventoryProduct"
+	InventoryService_RestockInventoryProduct_FullMethodName  = "/InventoryService/RestockInventoryProduct"
+	InventoryService_ReturnInventoryProduct_FullMethodName   = "/InventoryService/ReturnInventoryProduct"
 	InventoryService_CorrectInventoryStock_FullMethodName    = "/InventoryService/CorrectInventoryStock"
+	InventoryService_ApproveStockCorrection_FullMethodName   = "/InventoryService/ApproveStockCorrection"
+	InventoryService_RejectStockCorrection_FullMethodName    = "/InventoryService/RejectStockCorrection"
+	InventoryService_ListStockCorrections_FullMethodName     = "/InventoryService/ListStockCorrections"
+	InventoryService_ListCorrectionReasons_FullMethodName    = "/InventoryService/ListCorrectionReasons"
+	InventoryService_SaveCorrectionReason_FullMethodName     = "/InventoryService/SaveCorrectionReason"
 	InventoryService_ListStockMovements_FullMethodName       = "/InventoryService/ListStockMovements"
+	InventoryService_ReverseStockMovement_FullMethodName     = "/InventoryService/ReverseStockMovement"
+	InventoryService_GetStockAsOf_FullMethodName             = "/InventoryService/GetStockAsOf"
+	InventoryService_ReconcileStock_FullMethodName           = "/InventoryService/ReconcileStock"
+	InventoryService_GetInventoryValuation_FullMethodName    = "/InventoryService/GetInventoryValuation"
+	InventoryService_ReserveStock_FullMethodName             = "/InventoryService/ReserveStock"
+	InventoryService_CommitReservation_FullMethodName        = "/InventoryService/CommitReservation"
+	InventoryService_ReleaseReservation_FullMethodName       = "/InventoryService/ReleaseReservation"
+	InventoryService_CreateLocation_FullMethodName           = "/InventoryService/CreateLocation"
+	InventoryService_ListLocations_FullMethodName            = "/InventoryService/ListLocations"
+	InventoryService_GetLocationStock_FullMethodName         = "/InventoryService/GetLocationStock"
+	InventoryService_TransferStock_FullMethodName            = "/InventoryService/TransferStock"
+	InventoryService_MoveStockState_FullMethodName           = "/InventoryService/MoveStockState"
+	InventoryService_GetProductStockStates_FullMethodName    = "/InventoryService/GetProductStockStates"
+	InventoryService_AssembleKit_FullMethodName              = "/InventoryService/AssembleKit"
+	InventoryService_ListStockBatches_FullMethodName         = "/InventoryService/ListStockBatches"
+	InventoryService_ListExpiringBatches_FullMethodName      = "/InventoryService/ListExpiringBatches"
+	InventoryService_ListSerialUnits_FullMethodName          = "/InventoryService/ListSerialUnits"
+	InventoryService_GetSerialHistory_FullMethodName         = "/InventoryService/GetSerialHistory"
+	InventoryService_ScrapSerialUnits_FullMethodName         = "/InventoryService/ScrapSerialUnits"
+	InventoryService_RestoreSerialUnits_FullMethodName       = "/InventoryService/RestoreSerialUnits"
+	InventoryService_OpenCycleCount_FullMethodName           = "/InventoryService/OpenCycleCount"
+	InventoryService_ListCycleCounts_FullMethodName          = "/InventoryService/ListCycleCounts"
+	InventoryService_GetCycleCount_FullMethodName            = "/InventoryService/GetCycleCount"
+	InventoryService_RecordCycleCount_FullMethodName         = "/InventoryService/RecordCycleCount"
+	InventoryService_SubmitCycleCount_FullMethodName         = "/InventoryService/SubmitCycleCount"
+	InventoryService_ApproveCycleCount_FullMethodName        = "/InventoryService/ApproveCycleCount"
+	InventoryService_PostCycleCount_FullMethodName           = "/InventoryService/PostCycleCount"
+	InventoryService_CancelCycleCount_FullMethodName         = "/InventoryService/CancelCycleCount"
+	InventoryService_ListStockAlerts_FullMethodName          = "/InventoryService/ListStockAlerts"
+	InventoryService_SubscribeStockAlerts_FullMethodName     = "/InventoryService/SubscribeStockAlerts"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -31,8 +68,45 @@ const (
 type InventoryServiceClient interface {
 	PurchaseInventoryProduct(ctx context.Context, in *PurchaseInventoryRequest, opts ...grpc.CallOption) (*StockMovement, error)
 	SupplyInventoryProduct(ctx context.Context, in *ManageInventoryRequest, opts ...grpc.CallOption) (*StockMovement, error)
-	CorrectInventoryStock(ctx context.Context, in *ManageInventoryRequest, opts ...grpc.CallOption) (*StockMovement, error)
+	RestockInventoryProduct(ctx context.Context, in *PurchaseInventoryRequest, opts ...grpc.CallOption) (*StockMovement, error)
+	ReturnInventoryProduct(ctx context.Context, in *PurchaseInventoryRequest, opts ...grpc.CallOption) (*StockMovement, error)
+	CorrectInventoryStock(ctx context.Context, in *ManageInventoryRequest, opts ...grpc.CallOption) (*StockCorrection, error)
+	ApproveStockCorrection(ctx context.Context, in *ReviewStockCorrectionRequest, opts ...grpc.CallOption) (*StockCorrection, error)
+	RejectStockCorrection(ctx context.Context, in *ReviewStockCorrectionRequest, opts ...grpc.CallOption) (*StockCorrection, error)
+	ListStockCorrections(ctx context.Context, in *ListStockCorrectionsRequest, opts ...grpc.CallOption) (*ListStockCorrectionsResponse, error)
+	ListCorrectionReasons(ctx context.Context, in *ListCorrectionReasonsRequest, opts ...grpc.CallOption) (*ListCorrectionReasonsResponse, error)
+	SaveCorrectionReason(ctx context.Context, in *CorrectionReason, opts ...grpc.CallOption) (*CorrectionReason, error)
 	ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error)
+	ReverseStockMovement(ctx context.Context, in *ReverseStockMovementRequest, opts ...grpc.CallOption) (*StockMovement, error)
+	GetStockAsOf(ctx context.Context, in *StockAsOfRequest, opts ...grpc.CallOption) (*StockAsOfResponse, error)
+	ReconcileStock(ctx context.Context, in *ReconcileStockRequest, opts ...grpc.CallOption) (*ReconcileStockResponse, error)
+	GetInventoryValuation(ctx context.Context, in *InventoryValuationRequest, opts ...grpc.CallOption) (*InventoryValuationResponse, error)
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*StockReservation, error)
+	CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*StockMovement, error)
+	ReleaseReservation(ctx context.Context, in *ReservationIdRequest, opts ...grpc.CallOption) (*StockReservation, error)
+	CreateLocation(ctx context.Context, in *CreateLocationRequest, opts ...grpc.CallOption) (*Location, error)
+	ListLocations(ctx context.Context, in *ListLocationsRequest, opts ...grpc.CallOption) (*ListLocationsResponse, error)
+	GetLocationStock(ctx context.Context, in *LocationIdRequest, opts ...grpc.CallOption) (*LocationStockResponse, error)
+	TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error)
+	MoveStockState(ctx context.Context, in *MoveStockStateRequest, opts ...grpc.CallOption) (*MoveStockStateResponse, error)
+	GetProductStockStates(ctx context.Context, in *ProductStockStatesRequest, opts ...grpc.CallOption) (*ProductStockStatesResponse, error)
+	AssembleKit(ctx context.Context, in *AssembleKitRequest, opts ...grpc.CallOption) (*KitAssembly, error)
+	ListStockBatches(ctx context.Context, in *ListStockBatchesRequest, opts ...grpc.CallOption) (*ListStockBatchesResponse, error)
+	ListExpiringBatches(ctx context.Context, in *ListExpiringBatchesRequest, opts ...grpc.CallOption) (*ListStockBatchesResponse, error)
+	ListSerialUnits(ctx context.Context, in *ListSerialUnitsRequest, opts ...grpc.CallOption) (*ListSerialUnitsResponse, error)
+	GetSerialHistory(ctx context.Context, in *SerialHistoryRequest, opts ...grpc.CallOption) (*ListSerialUnitsResponse, error)
+	ScrapSerialUnits(ctx context.Context, in *ScrapSerialUnitsRequest, opts ...grpc.CallOption) (*ListSerialUnitsResponse, error)
+	RestoreSerialUnits(ctx context.Context, in *ScrapSerialUnitsRequest, opts ...grpc.CallOption) (*ListSerialUnitsResponse, error)
+	OpenCycleCount(ctx context.Context, in *OpenCycleCountRequest, opts ...grpc.CallOption) (*CycleCount, error)
+	ListCycleCounts(ctx context.Context, in *ListCycleCountsRequest, opts ...grpc.CallOption) (*ListCycleCountsResponse, error)
+	GetCycleCount(ctx context.Context, in *CycleCountIdRequest, opts ...grpc.CallOption) (*CycleCount, error)
+	RecordCycleCount(ctx context.Context, in *RecordCycleCountRequest, opts ...grpc.CallOption) (*CycleCount, error)
+	SubmitCycleCount(ctx context.Context, in *CycleCountIdRequest, opts ...grpc.CallOption) (*CycleCount, error)
+	ApproveCycleCount(ctx context.Context, in *ApproveCycleCountRequest, opts ...grpc.CallOption) (*CycleCount, error)
+	PostCycleCount(ctx context.Context, in *CycleCountIdRequest, opts ...grpc.CallOption) (*CycleCount, error)
+	CancelCycleCount(ctx context.Context, in *CycleCountIdRequest, opts ...grpc.CallOption) (*CycleCount, error)
+	ListStockAlerts(ctx context.Context, in *ListStockAlertsRequest, opts ...grpc.CallOption) (*ListStockAlertsResponse, error)
+	SubscribeStockAlerts(ctx context.Context, in *SubscribeStockAlertsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StockAlert], error)
 }
 
 type inventoryServiceClient struct {
@@ -63,149 +137,1335 @@ func (c *inventoryServiceClient) SupplyInventoryProduct(ctx context.Context, in
 	return out, nil
 }
 
-func (c *inventoryServiceClient) CorrectInventoryStock(ctx context.Context, in *ManageInventoryRequest, opts ...grpc.CallOption) (*StockMovement, error) {
+func (c *inventoryServiceClient) RestockInventoryProduct(ctx context.Context, in *PurchaseInventoryRequest, opts ...grpc.CallOption) (*StockMovement, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StockMovement)
+	err := c.cc.Invoke(ctx, InventoryService_RestockInventoryProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReturnInventoryProduct(ctx context.Context, in *PurchaseInventoryRequest, opts ...grpc.CallOption) (*StockMovement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMovement)
+	err := c.cc.Invoke(ctx, InventoryService_ReturnInventoryProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CorrectInventoryStock(ctx context.Context, in *ManageInventoryRequest, opts ...grpc.CallOption) (*StockCorrection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockCorrection)
 	err := c.cc.Invoke(ctx, InventoryService_CorrectInventoryStock_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ApproveStockCorrection(ctx context.Context, in *ReviewStockCorrectionRequest, opts ...grpc.CallOption) (*StockCorrection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockCorrection)
+	err := c.cc.Invoke(ctx, InventoryService_ApproveStockCorrection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RejectStockCorrection(ctx context.Context, in *ReviewStockCorrectionRequest, opts ...grpc.CallOption) (*StockCorrection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockCorrection)
+	err := c.cc.Invoke(ctx, InventoryService_RejectStockCorrection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListStockCorrections(ctx context.Context, in *ListStockCorrectionsRequest, opts ...grpc.CallOption) (*ListStockCorrectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockCorrectionsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListStockCorrections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListCorrectionReasons(ctx context.Context, in *ListCorrectionReasonsRequest, opts ...grpc.CallOption) (*ListCorrectionReasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCorrectionReasonsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListCorrectionReasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SaveCorrectionReason(ctx context.Context, in *CorrectionReason, opts ...grpc.CallOption) (*CorrectionReason, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CorrectionReason)
+	err := c.cc.Invoke(ctx, InventoryService_SaveCorrectionReason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockMovementsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListStockMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReverseStockMovement(ctx context.Context, in *ReverseStockMovementRequest, opts ...grpc.CallOption) (*StockMovement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMovement)
+	err := c.cc.Invoke(ctx, InventoryService_ReverseStockMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetStockAsOf(ctx context.Context, in *StockAsOfRequest, opts ...grpc.CallOption) (*StockAsOfResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockAsOfResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockAsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReconcileStock(ctx context.Context, in *ReconcileStockRequest, opts ...grpc.CallOption) (*ReconcileStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReconcileStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetInventoryValuation(ctx context.Context, in *InventoryValuationRequest, opts ...grpc.CallOption) (*InventoryValuationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InventoryValuationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetInventoryValuation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*StockReservation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockReservation)
+	err := c.cc.Invoke(ctx, InventoryService_ReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*StockMovement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMovement)
+	err := c.cc.Invoke(ctx, InventoryService_CommitReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReleaseReservation(ctx context.Context, in *ReservationIdRequest, opts ...grpc.CallOption) (*StockReservation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockReservation)
+	err := c.cc.Invoke(ctx, InventoryService_ReleaseReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreateLocation(ctx context.Context, in *CreateLocationRequest, opts ...grpc.CallOption) (*Location, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Location)
+	err := c.cc.Invoke(ctx, InventoryService_CreateLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListLocations(ctx context.Context, in *ListLocationsRequest, opts ...grpc.CallOption) (*ListLocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLocationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListLocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetLocationStock(ctx context.Context, in *LocationIdRequest, opts ...grpc.CallOption) (*LocationStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LocationStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetLocationStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_TransferStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) MoveStockState(ctx context.Context, in *MoveStockStateRequest, opts ...grpc.CallOption) (*MoveStockStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveStockStateResponse)
+	err := c.cc.Invoke(ctx, InventoryService_MoveStockState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetProductStockStates(ctx context.Context, in *ProductStockStatesRequest, opts ...grpc.CallOption) (*ProductStockStatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductStockStatesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetProductStockStates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) AssembleKit(ctx context.Context, in *AssembleKitRequest, opts ...grpc.CallOption) (*KitAssembly, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KitAssembly)
+	err := c.cc.Invoke(ctx, InventoryService_AssembleKit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListStockBatches(ctx context.Context, in *ListStockBatchesRequest, opts ...grpc.CallOption) (*ListStockBatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockBatchesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListStockBatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListExpiringBatches(ctx context.Context, in *ListExpiringBatchesRequest, opts ...grpc.CallOption) (*ListStockBatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockBatchesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListExpiringBatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListSerialUnits(ctx context.Context, in *ListSerialUnitsRequest, opts ...grpc.CallOption) (*ListSerialUnitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSerialUnitsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListSerialUnits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetSerialHistory(ctx context.Context, in *SerialHistoryRequest, opts ...grpc.CallOption) (*ListSerialUnitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSerialUnitsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetSerialHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ScrapSerialUnits(ctx context.Context, in *ScrapSerialUnitsRequest, opts ...grpc.CallOption) (*ListSerialUnitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSerialUnitsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ScrapSerialUnits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RestoreSerialUnits(ctx context.Context, in *ScrapSerialUnitsRequest, opts ...grpc.CallOption) (*ListSerialUnitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSerialUnitsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RestoreSerialUnits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) OpenCycleCount(ctx context.Context, in *OpenCycleCountRequest, opts ...grpc.CallOption) (*CycleCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleCount)
+	err := c.cc.Invoke(ctx, InventoryService_OpenCycleCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListCycleCounts(ctx context.Context, in *ListCycleCountsRequest, opts ...grpc.CallOption) (*ListCycleCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCycleCountsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListCycleCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetCycleCount(ctx context.Context, in *CycleCountIdRequest, opts ...grpc.CallOption) (*CycleCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleCount)
+	err := c.cc.Invoke(ctx, InventoryService_GetCycleCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RecordCycleCount(ctx context.Context, in *RecordCycleCountRequest, opts ...grpc.CallOption) (*CycleCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleCount)
+	err := c.cc.Invoke(ctx, InventoryService_RecordCycleCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SubmitCycleCount(ctx context.Context, in *CycleCountIdRequest, opts ...grpc.CallOption) (*CycleCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleCount)
+	err := c.cc.Invoke(ctx, InventoryService_SubmitCycleCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ApproveCycleCount(ctx context.Context, in *ApproveCycleCountRequest, opts ...grpc.CallOption) (*CycleCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleCount)
+	err := c.cc.Invoke(ctx, InventoryService_ApproveCycleCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) PostCycleCount(ctx context.Context, in *CycleCountIdRequest, opts ...grpc.CallOption) (*CycleCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleCount)
+	err := c.cc.Invoke(ctx, InventoryService_PostCycleCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CancelCycleCount(ctx context.Context, in *CycleCountIdRequest, opts ...grpc.CallOption) (*CycleCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleCount)
+	err := c.cc.Invoke(ctx, InventoryService_CancelCycleCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListStockAlerts(ctx context.Context, in *ListStockAlertsRequest, opts ...grpc.CallOption) (*ListStockAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockAlertsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListStockAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SubscribeStockAlerts(ctx context.Context, in *SubscribeStockAlertsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StockAlert], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_SubscribeStockAlerts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeStockAlertsRequest, StockAlert]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_SubscribeStockAlertsClient = grpc.ServerStreamingClient[StockAlert]
+
+// InventoryServiceServer is the server API for InventoryService service.
+// All implementations must embed UnimplementedInventoryServiceServer
+// for forward compatibility.
+type InventoryServiceServer interface {
+	PurchaseInventoryProduct(context.Context, *PurchaseInventoryRequest) (*StockMovement, error)
+	SupplyInventoryProduct(context.Context, *ManageInventoryRequest) (*StockMovement, error)
+	RestockInventoryProduct(context.Context, *PurchaseInventoryRequest) (*StockMovement, error)
+	ReturnInventoryProduct(context.Context, *PurchaseInventoryRequest) (*StockMovement, error)
+	CorrectInventoryStock(context.Context, *ManageInventoryRequest) (*StockCorrection, error)
+	ApproveStockCorrection(context.Context, *ReviewStockCorrectionRequest) (*StockCorrection, error)
+	RejectStockCorrection(context.Context, *ReviewStockCorrectionRequest) (*StockCorrection, error)
+	ListStockCorrections(context.Context, *ListStockCorrectionsRequest) (*ListStockCorrectionsResponse, error)
+	ListCorrectionReasons(context.Context, *ListCorrectionReasonsRequest) (*ListCorrectionReasonsResponse, error)
+	SaveCorrectionReason(context.Context, *CorrectionReason) (*CorrectionReason, error)
+	ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error)
+	ReverseStockMovement(context.Context, *ReverseStockMovementRequest) (*StockMovement, error)
+	GetStockAsOf(context.Context, *StockAsOfRequest) (*StockAsOfResponse, error)
+	ReconcileStock(context.Context, *ReconcileStockRequest) (*ReconcileStockResponse, error)
+	GetInventoryValuation(context.Context, *InventoryValuationRequest) (*InventoryValuationResponse, error)
+	ReserveStock(context.Context, *ReserveStockRequest) (*StockReservation, error)
+	CommitReservation(context.Context, *CommitReservationRequest) (*StockMovement, error)
+	ReleaseReservation(context.Context, *ReservationIdRequest) (*StockReservation, error)
+	CreateLocation(context.Context, *CreateLocationRequest) (*Location, error)
+	ListLocations(context.Context, *ListLocationsRequest) (*ListLocationsResponse, error)
+	GetLocationStock(context.Context, *LocationIdRequest) (*LocationStockResponse, error)
+	TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error)
+	MoveStockState(context.Context, *MoveStockStateRequest) (*MoveStockStateResponse, error)
+	GetProductStockStates(context.Context, *ProductStockStatesRequest) (*ProductStockStatesResponse, error)
+	AssembleKit(context.Context, *AssembleKitRequest) (*KitAssembly, error)
+	ListStockBatches(context.Context, *ListStockBatchesRequest) (*ListStockBatchesResponse, error)
+	ListExpiringBatches(context.Context, *ListExpiringBatchesRequest) (*ListStockBatchesResponse, error)
+	ListSerialUnits(context.Context, *ListSerialUnitsRequest) (*ListSerialUnitsResponse, error)
+	GetSerialHistory(context.Context, *SerialHistoryRequest) (*ListSerialUnitsResponse, error)
+	ScrapSerialUnits(context.Context, *ScrapSerialUnitsRequest) (*ListSerialUnitsResponse, error)
+	RestoreSerialUnits(context.Context, *ScrapSerialUnitsRequest) (*ListSerialUnitsResponse, error)
+	OpenCycleCount(context.Context, *OpenCycleCountRequest) (*CycleCount, error)
+	ListCycleCounts(context.Context, *ListCycleCountsRequest) (*ListCycleCountsResponse, error)
+	GetCycleCount(context.Context, *CycleCountIdRequest) (*CycleCount, error)
+	RecordCycleCount(context.Context, *RecordCycleCountRequest) (*CycleCount, error)
+	SubmitCycleCount(context.Context, *CycleCountIdRequest) (*CycleCount, error)
+	ApproveCycleCount(context.Context, *ApproveCycleCountRequest) (*CycleCount, error)
+	PostCycleCount(context.Context, *CycleCountIdRequest) (*CycleCount, error)
+	CancelCycleCount(context.Context, *CycleCountIdRequest) (*CycleCount, error)
+	ListStockAlerts(context.Context, *ListStockAlertsRequest) (*ListStockAlertsResponse, error)
+	SubscribeStockAlerts(*SubscribeStockAlertsRequest, grpc.ServerStreamingServer[StockAlert]) error
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+// UnimplementedInventoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInventoryServiceServer struct{}
+
+func (UnimplementedInventoryServiceServer) PurchaseInventoryProduct(context.Context, *PurchaseInventoryRequest) (*StockMovement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseInventoryProduct not implemented")
+}
+func (UnimplementedInventoryServiceServer) SupplyInventoryProduct(context.Context, *ManageInventoryRequest) (*StockMovement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyInventoryProduct not implemented")
+}
+func (UnimplementedInventoryServiceServer) RestockInventoryProduct(context.Context, *PurchaseInventoryRequest) (*StockMovement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestockInventoryProduct not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReturnInventoryProduct(context.Context, *PurchaseInventoryRequest) (*StockMovement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnInventoryProduct not implemented")
+}
+func (UnimplementedInventoryServiceServer) CorrectInventoryStock(context.Context, *ManageInventoryRequest) (*StockCorrection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CorrectInventoryStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) ApproveStockCorrection(context.Context, *ReviewStockCorrectionRequest) (*StockCorrection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveStockCorrection not implemented")
+}
+func (UnimplementedInventoryServiceServer) RejectStockCorrection(context.Context, *ReviewStockCorrectionRequest) (*StockCorrection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectStockCorrection not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListStockCorrections(context.Context, *ListStockCorrectionsRequest) (*ListStockCorrectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockCorrections not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListCorrectionReasons(context.Context, *ListCorrectionReasonsRequest) (*ListCorrectionReasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCorrectionReasons not implemented")
+}
+func (UnimplementedInventoryServiceServer) SaveCorrectionReason(context.Context, *CorrectionReason) (*CorrectionReason, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCorrectionReason not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockMovements not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReverseStockMovement(context.Context, *ReverseStockMovementRequest) (*StockMovement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseStockMovement not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetStockAsOf(context.Context, *StockAsOfRequest) (*StockAsOfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockAsOf not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReconcileStock(context.Context, *ReconcileStockRequest) (*ReconcileStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetInventoryValuation(context.Context, *InventoryValuationRequest) (*InventoryValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryValuation not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*StockReservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) CommitReservation(context.Context, *CommitReservationRequest) (*StockMovement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservation not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReleaseReservation(context.Context, *ReservationIdRequest) (*StockReservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateLocation(context.Context, *CreateLocationRequest) (*Location, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLocation not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListLocations(context.Context, *ListLocationsRequest) (*ListLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocations not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetLocationStock(context.Context, *LocationIdRequest) (*LocationStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocationStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) MoveStockState(context.Context, *MoveStockStateRequest) (*MoveStockStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveStockState not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetProductStockStates(context.Context, *ProductStockStatesRequest) (*ProductStockStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductStockStates not implemented")
+}
+func (UnimplementedInventoryServiceServer) AssembleKit(context.Context, *AssembleKitRequest) (*KitAssembly, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssembleKit not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListStockBatches(context.Context, *ListStockBatchesRequest) (*ListStockBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockBatches not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListExpiringBatches(context.Context, *ListExpiringBatchesRequest) (*ListStockBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringBatches not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListSerialUnits(context.Context, *ListSerialUnitsRequest) (*ListSerialUnitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSerialUnits not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetSerialHistory(context.Context, *SerialHistoryRequest) (*ListSerialUnitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSerialHistory not implemented")
+}
+func (UnimplementedInventoryServiceServer) ScrapSerialUnits(context.Context, *ScrapSerialUnitsRequest) (*ListSerialUnitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScrapSerialUnits not implemented")
+}
+func (UnimplementedInventoryServiceServer) RestoreSerialUnits(context.Context, *ScrapSerialUnitsRequest) (*ListSerialUnitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSerialUnits not implemented")
+}
+func (UnimplementedInventoryServiceServer) OpenCycleCount(context.Context, *OpenCycleCountRequest) (*CycleCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenCycleCount not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListCycleCounts(context.Context, *ListCycleCountsRequest) (*ListCycleCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCycleCounts not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetCycleCount(context.Context, *CycleCountIdRequest) (*CycleCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCycleCount not implemented")
+}
+func (UnimplementedInventoryServiceServer) RecordCycleCount(context.Context, *RecordCycleCountRequest) (*CycleCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordCycleCount not implemented")
+}
+func (UnimplementedInventoryServiceServer) SubmitCycleCount(context.Context, *CycleCountIdRequest) (*CycleCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCycleCount not implemented")
+}
+func (UnimplementedInventoryServiceServer) ApproveCycleCount(context.Context, *ApproveCycleCountRequest) (*CycleCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCycleCount not implemented")
+}
+func (UnimplementedInventoryServiceServer) PostCycleCount(context.Context, *CycleCountIdRequest) (*CycleCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostCycleCount not implemented")
+}
+func (UnimplementedInventoryServiceServer) CancelCycleCount(context.Context, *CycleCountIdRequest) (*CycleCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCycleCount not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListStockAlerts(context.Context, *ListStockAlertsRequest) (*ListStockAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockAlerts not implemented")
+}
+func (UnimplementedInventoryServiceServer) SubscribeStockAlerts(*SubscribeStockAlertsRequest, grpc.ServerStreamingServer[StockAlert]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeStockAlerts not implemented")
+}
+func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
+func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServiceServer will
+// result in compilation errors.
+type UnsafeInventoryServiceServer interface {
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInventoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InventoryService_ServiceDesc, srv)
+}
+
+func _InventoryService_PurchaseInventoryProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).PurchaseInventoryProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_PurchaseInventoryProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).PurchaseInventoryProduct(ctx, req.(*PurchaseInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SupplyInventoryProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManageInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SupplyInventoryProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SupplyInventoryProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SupplyInventoryProduct(ctx, req.(*ManageInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RestockInventoryProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RestockInventoryProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RestockInventoryProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RestockInventoryProduct(ctx, req.(*PurchaseInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReturnInventoryProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReturnInventoryProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReturnInventoryProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReturnInventoryProduct(ctx, req.(*PurchaseInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CorrectInventoryStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManageInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CorrectInventoryStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CorrectInventoryStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CorrectInventoryStock(ctx, req.(*ManageInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ApproveStockCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewStockCorrectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ApproveStockCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ApproveStockCorrection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ApproveStockCorrection(ctx, req.(*ReviewStockCorrectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RejectStockCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewStockCorrectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RejectStockCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RejectStockCorrection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RejectStockCorrection(ctx, req.(*ReviewStockCorrectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListStockCorrections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockCorrectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListStockCorrections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListStockCorrections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListStockCorrections(ctx, req.(*ListStockCorrectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListCorrectionReasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCorrectionReasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListCorrectionReasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListCorrectionReasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListCorrectionReasons(ctx, req.(*ListCorrectionReasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SaveCorrectionReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrectionReason)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SaveCorrectionReason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SaveCorrectionReason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SaveCorrectionReason(ctx, req.(*CorrectionReason))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListStockMovements(ctx, req.(*ListStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReverseStockMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseStockMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReverseStockMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReverseStockMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReverseStockMovement(ctx, req.(*ReverseStockMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetStockAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockAsOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStockAsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStockAsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStockAsOf(ctx, req.(*StockAsOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReconcileStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReconcileStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReconcileStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReconcileStock(ctx, req.(*ReconcileStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetInventoryValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InventoryValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetInventoryValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetInventoryValuation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetInventoryValuation(ctx, req.(*InventoryValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CommitReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CommitReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CommitReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CommitReservation(ctx, req.(*CommitReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseReservation(ctx, req.(*ReservationIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateLocation(ctx, req.(*CreateLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListLocations(ctx, req.(*ListLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetLocationStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocationIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetLocationStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetLocationStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetLocationStock(ctx, req.(*LocationIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_TransferStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).TransferStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_TransferStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).TransferStock(ctx, req.(*TransferStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_MoveStockState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveStockStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).MoveStockState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_MoveStockState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).MoveStockState(ctx, req.(*MoveStockStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetProductStockStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductStockStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetProductStockStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetProductStockStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetProductStockStates(ctx, req.(*ProductStockStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AssembleKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssembleKitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AssembleKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AssembleKit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AssembleKit(ctx, req.(*AssembleKitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListStockBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListStockBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListStockBatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListStockBatches(ctx, req.(*ListStockBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListExpiringBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListExpiringBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListExpiringBatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListExpiringBatches(ctx, req.(*ListExpiringBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *inventoryServiceClient) ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListStockMovementsResponse)
-	err := c.cc.Invoke(ctx, InventoryService_ListStockMovements_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _InventoryService_ListSerialUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSerialUnitsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListSerialUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListSerialUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListSerialUnits(ctx, req.(*ListSerialUnitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// InventoryServiceServer is the server API for InventoryService service.
-// All implementations must embed UnimplementedInventoryServiceServer
-// for forward compatibility.
-type InventoryServiceServer interface {
-	PurchaseInventoryProduct(context.Context, *PurchaseInventoryRequest) (*StockMovement, error)
-	SupplyInventoryProduct(context.Context, *ManageInventoryRequest) (*StockMovement, error)
-	CorrectInventoryStock(context.Context, *ManageInventoryRequest) (*StockMovement, error)
-	ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error)
-	mustEmbedUnimplementedInventoryServiceServer()
+func _InventoryService_GetSerialHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SerialHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetSerialHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetSerialHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetSerialHistory(ctx, req.(*SerialHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedInventoryServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedInventoryServiceServer struct{}
+func _InventoryService_ScrapSerialUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrapSerialUnitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ScrapSerialUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ScrapSerialUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ScrapSerialUnits(ctx, req.(*ScrapSerialUnitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedInventoryServiceServer) PurchaseInventoryProduct(context.Context, *PurchaseInventoryRequest) (*StockMovement, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurchaseInventoryProduct not implemented")
+func _InventoryService_RestoreSerialUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrapSerialUnitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RestoreSerialUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RestoreSerialUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RestoreSerialUnits(ctx, req.(*ScrapSerialUnitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedInventoryServiceServer) SupplyInventoryProduct(context.Context, *ManageInventoryRequest) (*StockMovement, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupplyInventoryProduct not implemented")
+
+func _InventoryService_OpenCycleCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenCycleCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).OpenCycleCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_OpenCycleCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).OpenCycleCount(ctx, req.(*OpenCycleCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedInventoryServiceServer) CorrectInventoryStock(context.Context, *ManageInventoryRequest) (*StockMovement, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CorrectInventoryStock not implemented")
+
+func _InventoryService_ListCycleCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCycleCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListCycleCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListCycleCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListCycleCounts(ctx, req.(*ListCycleCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedInventoryServiceServer) ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListStockMovements not implemented")
+
+func _InventoryService_GetCycleCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CycleCountIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetCycleCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetCycleCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetCycleCount(ctx, req.(*CycleCountIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
-func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to InventoryServiceServer will
-// result in compilation errors.
-type UnsafeInventoryServiceServer interface {
-	mustEmbedUnimplementedInventoryServiceServer()
+func _InventoryService_RecordCycleCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordCycleCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RecordCycleCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RecordCycleCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RecordCycleCount(ctx, req.(*RecordCycleCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
-	// If the following call pancis, it indicates UnimplementedInventoryServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _InventoryService_SubmitCycleCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CycleCountIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&InventoryService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SubmitCycleCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SubmitCycleCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SubmitCycleCount(ctx, req.(*CycleCountIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_PurchaseInventoryProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurchaseInventoryRequest)
+func _InventoryService_ApproveCycleCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveCycleCountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).PurchaseInventoryProduct(ctx, in)
+		return srv.(InventoryServiceServer).ApproveCycleCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_PurchaseInventoryProduct_FullMethodName,
+		FullMethod: InventoryService_ApproveCycleCount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).PurchaseInventoryProduct(ctx, req.(*PurchaseInventoryRequest))
+		return srv.(InventoryServiceServer).ApproveCycleCount(ctx, req.(*ApproveCycleCountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_SupplyInventoryProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ManageInventoryRequest)
+func _InventoryService_PostCycleCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CycleCountIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).SupplyInventoryProduct(ctx, in)
+		return srv.(InventoryServiceServer).PostCycleCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_SupplyInventoryProduct_FullMethodName,
+		FullMethod: InventoryService_PostCycleCount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).SupplyInventoryProduct(ctx, req.(*ManageInventoryRequest))
+		return srv.(InventoryServiceServer).PostCycleCount(ctx, req.(*CycleCountIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_CorrectInventoryStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ManageInventoryRequest)
+func _InventoryService_CancelCycleCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CycleCountIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).CorrectInventoryStock(ctx, in)
+		return srv.(InventoryServiceServer).CancelCycleCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_CorrectInventoryStock_FullMethodName,
+		FullMethod: InventoryService_CancelCycleCount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).CorrectInventoryStock(ctx, req.(*ManageInventoryRequest))
+		return srv.(InventoryServiceServer).CancelCycleCount(ctx, req.(*CycleCountIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_ListStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListStockMovementsRequest)
+func _InventoryService_ListStockAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockAlertsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).ListStockMovements(ctx, in)
+		return srv.(InventoryServiceServer).ListStockAlerts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_ListStockMovements_FullMethodName,
+		FullMethod: InventoryService_ListStockAlerts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).ListStockMovements(ctx, req.(*ListStockMovementsRequest))
+		return srv.(InventoryServiceServer).ListStockAlerts(ctx, req.(*ListStockAlertsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_SubscribeStockAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeStockAlertsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).SubscribeStockAlerts(m, &grpc.GenericServerStream[SubscribeStockAlertsRequest, StockAlert]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_SubscribeStockAlertsServer = grpc.ServerStreamingServer[StockAlert]
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,15 +1481,165 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SupplyInventoryProduct",
 			Handler:    _InventoryService_SupplyInventoryProduct_Handler,
 		},
+		{
+			MethodName: "RestockInventoryProduct",
+			Handler:    _InventoryService_RestockInventoryProduct_Handler,
+		},
+		{
+			MethodName: "ReturnInventoryProduct",
+			Handler:    _InventoryService_ReturnInventoryProduct_Handler,
+		},
 		{
 			MethodName: "CorrectInventoryStock",
 			Handler:    _InventoryService_CorrectInventoryStock_Handler,
 		},
+		{
+			MethodName: "ApproveStockCorrection",
+			Handler:    _InventoryService_ApproveStockCorrection_Handler,
+		},
+		{
+			MethodName: "RejectStockCorrection",
+			Handler:    _InventoryService_RejectStockCorrection_Handler,
+		},
+		{
+			MethodName: "ListStockCorrections",
+			Handler:    _InventoryService_ListStockCorrections_Handler,
+		},
+		{
+			MethodName: "ListCorrectionReasons",
+			Handler:    _InventoryService_ListCorrectionReasons_Handler,
+		},
+		{
+			MethodName: "SaveCorrectionReason",
+			Handler:    _InventoryService_SaveCorrectionReason_Handler,
+		},
 		{
 			MethodName: "ListStockMovements",
 			Handler:    _InventoryService_ListStockMovements_Handler,
 		},
+		{
+			MethodName: "ReverseStockMovement",
+			Handler:    _InventoryService_ReverseStockMovement_Handler,
+		},
+		{
+			MethodName: "GetStockAsOf",
+			Handler:    _InventoryService_GetStockAsOf_Handler,
+		},
+		{
+			MethodName: "ReconcileStock",
+			Handler:    _InventoryService_ReconcileStock_Handler,
+		},
+		{
+			MethodName: "GetInventoryValuation",
+			Handler:    _InventoryService_GetInventoryValuation_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _InventoryService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "CommitReservation",
+			Handler:    _InventoryService_CommitReservation_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _InventoryService_ReleaseReservation_Handler,
+		},
+		{
+			MethodName: "CreateLocation",
+			Handler:    _InventoryService_CreateLocation_Handler,
+		},
+		{
+			MethodName: "ListLocations",
+			Handler:    _InventoryService_ListLocations_Handler,
+		},
+		{
+			MethodName: "GetLocationStock",
+			Handler:    _InventoryService_GetLocationStock_Handler,
+		},
+		{
+			MethodName: "TransferStock",
+			Handler:    _InventoryService_TransferStock_Handler,
+		},
+		{
+			MethodName: "MoveStockState",
+			Handler:    _InventoryService_MoveStockState_Handler,
+		},
+		{
+			MethodName: "GetProductStockStates",
+			Handler:    _InventoryService_GetProductStockStates_Handler,
+		},
+		{
+			MethodName: "AssembleKit",
+			Handler:    _InventoryService_AssembleKit_Handler,
+		},
+		{
+			MethodName: "ListStockBatches",
+			Handler:    _InventoryService_ListStockBatches_Handler,
+		},
+		{
+			MethodName: "ListExpiringBatches",
+			Handler:    _InventoryService_ListExpiringBatches_Handler,
+		},
+		{
+			MethodName: "ListSerialUnits",
+			Handler:    _InventoryService_ListSerialUnits_Handler,
+		},
+		{
+			MethodName: "GetSerialHistory",
+			Handler:    _InventoryService_GetSerialHistory_Handler,
+		},
+		{
+			MethodName: "ScrapSerialUnits",
+			Handler:    _InventoryService_ScrapSerialUnits_Handler,
+		},
+		{
+			MethodName: "RestoreSerialUnits",
+			Handler:    _InventoryService_RestoreSerialUnits_Handler,
+		},
+		{
+			MethodName: "OpenCycleCount",
+			Handler:    _InventoryService_OpenCycleCount_Handler,
+		},
+		{
+			MethodName: "ListCycleCounts",
+			Handler:    _InventoryService_ListCycleCounts_Handler,
+		},
+		{
+			MethodName: "GetCycleCount",
+			Handler:    _InventoryService_GetCycleCount_Handler,
+		},
+		{
+			MethodName: "RecordCycleCount",
+			Handler:    _InventoryService_RecordCycleCount_Handler,
+		},
+		{
+			MethodName: "SubmitCycleCount",
+			Handler:    _InventoryService_SubmitCycleCount_Handler,
+		},
+		{
+			MethodName: "ApproveCycleCount",
+			Handler:    _InventoryService_ApproveCycleCount_Handler,
+		},
+		{
+			MethodName: "PostCycleCount",
+			Handler:    _InventoryService_PostCycleCount_Handler,
+		},
+		{
+			MethodName: "CancelCycleCount",
+			Handler:    _InventoryService_CancelCycleCount_Handler,
+		},
+		{
+			MethodName: "ListStockAlerts",
+			Handler:    _InventoryService_ListStockAlerts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeStockAlerts",
+			Handler:       _InventoryService_SubscribeStockAlerts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory.proto",
 }
@@ -27,6 +27,7 @@ type CreateOrderRequest struct {
 	PaymentReference string                 `protobuf:"bytes,2,opt,name=PaymentReference,proto3" json:"PaymentReference,omitempty"`
 	CustomerName     string                 `protobuf:"bytes,3,opt,name=CustomerName,proto3" json:"CustomerName,omitempty"`
 	CustomerContact  string                 `protobuf:"bytes,4,opt,name=CustomerContact,proto3" json:"CustomerContact,omitempty"`
+	CouponCodes      []string               `protobuf:"bytes,5,rep,name=CouponCodes,proto3" json:"CouponCodes,omitempty"` // Optional, promotions without a code apply on their own
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -89,12 +90,31 @@ func (x *CreateOrderRequest) GetCustomerContact() string {
 	return ""
 }
 
+func (x *CreateOrderRequest) GetCouponCodes() []string {
+	if x != nil {
+		return x.CouponCodes
+	}
+	return nil
+}
+
 type OrderItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
-	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity         int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	ReservationId    int64                  `protobuf:"varint,3,opt,name=ReservationId,proto3" json:"ReservationId,omitempty"` // Stock reservation held in the inventory service
+	Unit             string                 `protobuf:"bytes,4,opt,name=Unit,proto3" json:"Unit,omitempty"`                    // Sell unit code, Quantity is in base units when empty
+	BaseQuantity     int64                  `protobuf:"varint,5,opt,name=BaseQuantity,proto3" json:"BaseQuantity,omitempty"`   // Quantity converted to base units
+	Id               int64                  `protobuf:"varint,6,opt,name=Id,proto3" json:"Id,omitempty"`
+	ReturnedQuantity int64                  `protobuf:"varint,7,opt,name=ReturnedQuantity,proto3" json:"ReturnedQuantity,omitempty"` // Quantity brought back on received returns, in the line's unit
+	UnitPrice        string                 `protobuf:"bytes,8,opt,name=UnitPrice,proto3" json:"UnitPrice,omitempty"`                // Price per line unit snapshotted from the product when ordered, decimal string
+	Subtotal         string                 `protobuf:"bytes,9,opt,name=Subtotal,proto3" json:"Subtotal,omitempty"`                  // UnitPrice times Quantity
+	Discount         string                 `protobuf:"bytes,10,opt,name=Discount,proto3" json:"Discount,omitempty"`                 // Amount taken off the subtotal, may be given when ordering
+	TaxRate          string                 `protobuf:"bytes,11,opt,name=TaxRate,proto3" json:"TaxRate,omitempty"`                   // Tax percentage applied to the discounted subtotal
+	Tax              string                 `protobuf:"bytes,12,opt,name=Tax,proto3" json:"Tax,omitempty"`
+	Total            string                 `protobuf:"bytes,13,opt,name=Total,proto3" json:"Total,omitempty"`                      // Subtotal less Discount plus Tax
+	ShippedQuantity  int64                  `protobuf:"varint,14,opt,name=ShippedQuantity,proto3" json:"ShippedQuantity,omitempty"` // Quantity sent on shipments, in the line's unit
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *OrderItem) Reset() {
@@ -141,6 +161,90 @@ func (x *OrderItem) GetQuantity() int64 {
 	return 0
 }
 
+func (x *OrderItem) GetReservationId() int64 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *OrderItem) GetBaseQuantity() int64 {
+	if x != nil {
+		return x.BaseQuantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderItem) GetReturnedQuantity() int64 {
+	if x != nil {
+		return x.ReturnedQuantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUnitPrice() string {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return ""
+}
+
+func (x *OrderItem) GetSubtotal() string {
+	if x != nil {
+		return x.Subtotal
+	}
+	return ""
+}
+
+func (x *OrderItem) GetDiscount() string {
+	if x != nil {
+		return x.Discount
+	}
+	return ""
+}
+
+func (x *OrderItem) GetTaxRate() string {
+	if x != nil {
+		return x.TaxRate
+	}
+	return ""
+}
+
+func (x *OrderItem) GetTax() string {
+	if x != nil {
+		return x.Tax
+	}
+	return ""
+}
+
+func (x *OrderItem) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *OrderItem) GetShippedQuantity() int64 {
+	if x != nil {
+		return x.ShippedQuantity
+	}
+	return 0
+}
+
 type Order struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
@@ -148,8 +252,13 @@ type Order struct {
 	CustomerContact  string                 `protobuf:"bytes,3,opt,name=CustomerContact,proto3" json:"CustomerContact,omitempty"`
 	PaymentReference string                 `protobuf:"bytes,4,opt,name=PaymentReference,proto3" json:"PaymentReference,omitempty"`
 	Items            []*OrderItem           `protobuf:"bytes,5,rep,name=Items,proto3" json:"Items,omitempty"`
-	Status           string                 `protobuf:"bytes,6,opt,name=Status,proto3" json:"Status,omitempty"`
+	Status           string                 `protobuf:"bytes,6,opt,name=Status,proto3" json:"Status,omitempty"` // pending, confirmed, picking, partially_shipped, shipped, delivered, cancelled, partially_returned or returned
 	CreatedAt        string                 `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Subtotal         string                 `protobuf:"bytes,8,opt,name=Subtotal,proto3" json:"Subtotal,omitempty"` // Money amounts are decimal strings with two places
+	DiscountTotal    string                 `protobuf:"bytes,9,opt,name=DiscountTotal,proto3" json:"DiscountTotal,omitempty"`
+	TaxTotal         string                 `protobuf:"bytes,10,opt,name=TaxTotal,proto3" json:"TaxTotal,omitempty"`
+	GrandTotal       string                 `protobuf:"bytes,11,opt,name=GrandTotal,proto3" json:"GrandTotal,omitempty"`
+	Discounts        []*OrderDiscount       `protobuf:"bytes,12,rep,name=Discounts,proto3" json:"Discounts,omitempty"` // Promotions applied, one entry per promotion and item
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -233,6 +342,117 @@ func (x *Order) GetCreatedAt() string {
 	return ""
 }
 
+func (x *Order) GetSubtotal() string {
+	if x != nil {
+		return x.Subtotal
+	}
+	return ""
+}
+
+func (x *Order) GetDiscountTotal() string {
+	if x != nil {
+		return x.DiscountTotal
+	}
+	return ""
+}
+
+func (x *Order) GetTaxTotal() string {
+	if x != nil {
+		return x.TaxTotal
+	}
+	return ""
+}
+
+func (x *Order) GetGrandTotal() string {
+	if x != nil {
+		return x.GrandTotal
+	}
+	return ""
+}
+
+func (x *Order) GetDiscounts() []*OrderDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+type OrderDiscount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=PromotionId,proto3" json:"PromotionId,omitempty"` // 0 once the promotion is deleted
+	Code          string                 `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	OrderItemId   int64                  `protobuf:"varint,4,opt,name=OrderItemId,proto3" json:"OrderItemId,omitempty"`
+	Amount        string                 `protobuf:"bytes,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderDiscount) Reset() {
+	*x = OrderDiscount{}
+	mi := &file_orders_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderDiscount) ProtoMessage() {}
+
+func (x *OrderDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderDiscount.ProtoReflect.Descriptor instead.
+func (*OrderDiscount) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderDiscount) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *OrderDiscount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OrderDiscount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderDiscount) GetOrderItemId() int64 {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return 0
+}
+
+func (x *OrderDiscount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
 type OrderIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
@@ -242,7 +462,7 @@ type OrderIdRequest struct {
 
 func (x *OrderIdRequest) Reset() {
 	*x = OrderIdRequest{}
-	mi := &file_orders_proto_msgTypes[3]
+	mi := &file_orders_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -254,7 +474,7 @@ func (x *OrderIdRequest) String() string {
 func (*OrderIdRequest) ProtoMessage() {}
 
 func (x *OrderIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[3]
+	mi := &file_orders_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +487,7 @@ func (x *OrderIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderIdRequest.ProtoReflect.Descriptor instead.
 func (*OrderIdRequest) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{3}
+	return file_orders_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *OrderIdRequest) GetId() int64 {
@@ -288,7 +508,7 @@ type ListOrdersRequest struct {
 
 func (x *ListOrdersRequest) Reset() {
 	*x = ListOrdersRequest{}
-	mi := &file_orders_proto_msgTypes[4]
+	mi := &file_orders_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -300,7 +520,7 @@ func (x *ListOrdersRequest) String() string {
 func (*ListOrdersRequest) ProtoMessage() {}
 
 func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[4]
+	mi := &file_orders_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,7 +533,7 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
 func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{4}
+	return file_orders_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListOrdersRequest) GetStatus() string {
@@ -347,7 +567,7 @@ type ListOrdersResponse struct {
 
 func (x *ListOrdersResponse) Reset() {
 	*x = ListOrdersResponse{}
-	mi := &file_orders_proto_msgTypes[5]
+	mi := &file_orders_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +579,7 @@ func (x *ListOrdersResponse) String() string {
 func (*ListOrdersResponse) ProtoMessage() {}
 
 func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[5]
+	mi := &file_orders_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +592,7 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
 func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{5}
+	return file_orders_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListOrdersResponse) GetOrders() []*Order {
@@ -397,7 +617,7 @@ type DeleteOrderResponse struct {
 
 func (x *DeleteOrderResponse) Reset() {
 	*x = DeleteOrderResponse{}
-	mi := &file_orders_proto_msgTypes[6]
+	mi := &file_orders_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -409,7 +629,7 @@ func (x *DeleteOrderResponse) String() string {
 func (*DeleteOrderResponse) ProtoMessage() {}
 
 func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[6]
+	mi := &file_orders_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,20 +642,22 @@ func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteOrderResponse.ProtoReflect.Descriptor instead.
 func (*DeleteOrderResponse) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{6}
+	return file_orders_proto_rawDescGZIP(), []int{7}
 }
 
 type ChangeOrderStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"` // New status for the order
+	Status        string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`       // New status for the order
+	ChangedBy     string                 `protobuf:"bytes,3,opt,name=ChangedBy,proto3" json:"ChangedBy,omitempty"` // User making the change
+	Note          string                 `protobuf:"bytes,4,opt,name=Note,proto3" json:"Note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChangeOrderStatusRequest) Reset() {
 	*x = ChangeOrderStatusRequest{}
-	mi := &file_orders_proto_msgTypes[7]
+	mi := &file_orders_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -447,7 +669,7 @@ func (x *ChangeOrderStatusRequest) String() string {
 func (*ChangeOrderStatusRequest) ProtoMessage() {}
 
 func (x *ChangeOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[7]
+	mi := &file_orders_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +682,7 @@ func (x *ChangeOrderStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeOrderStatusRequest.ProtoReflect.Descriptor instead.
 func (*ChangeOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{7}
+	return file_orders_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ChangeOrderStatusRequest) GetId() int64 {
@@ -477,51 +699,1720 @@ func (x *ChangeOrderStatusRequest) GetStatus() string {
 	return ""
 }
 
-var File_orders_proto protoreflect.FileDescriptor
+func (x *ChangeOrderStatusRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
 
-const file_orders_proto_rawDesc = "" +
-	"\n" +
-	"\forders.proto\"\xb0\x01\n" +
-	"\x12CreateOrderRequest\x12 \n" +
-	"\x05Items\x18\x01 \x03(\v2\n" +
-	".OrderItemR\x05Items\x12*\n" +
-	"\x10PaymentReference\x18\x02 \x01(\tR\x10PaymentReference\x12\"\n" +
-	"\fCustomerName\x18\x03 \x01(\tR\fCustomerName\x12(\n" +
-	"\x0fCustomerContact\x18\x04 \x01(\tR\x0fCustomerContact\"E\n" +
-	"\tOrderItem\x12\x1c\n" +
-	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1a\n" +
-	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\"\xe9\x01\n" +
-	"\x05Order\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\"\n" +
-	"\fCustomerName\x18\x02 \x01(\tR\fCustomerName\x12(\n" +
-	"\x0fCustomerContact\x18\x03 \x01(\tR\x0fCustomerContact\x12*\n" +
-	"\x10PaymentReference\x18\x04 \x01(\tR\x10PaymentReference\x12 \n" +
-	"\x05Items\x18\x05 \x03(\v2\n" +
-	".OrderItemR\x05Items\x12\x16\n" +
-	"\x06Status\x18\x06 \x01(\tR\x06Status\x12\x1c\n" +
-	"\tCreatedAt\x18\a \x01(\tR\tCreatedAt\" \n" +
-	"\x0eOrderIdRequest\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x03R\x02Id\"[\n" +
-	"\x11ListOrdersRequest\x12\x16\n" +
-	"\x06Status\x18\x01 \x01(\tR\x06Status\x12\x12\n" +
-	"\x04Page\x18\x03 \x01(\x03R\x04Page\x12\x1a\n" +
-	"\bPageSize\x18\x04 \x01(\x03R\bPageSize\"T\n" +
-	"\x12ListOrdersResponse\x12\x1e\n" +
-	"\x06Orders\x18\x01 \x03(\v2\x06.OrderR\x06Orders\x12\x1e\n" +
-	"\n" +
-	"TotalCount\x18\x02 \x01(\x03R\n" +
-	"TotalCount\"\x15\n" +
-	"\x13DeleteOrderResponse\"B\n" +
-	"\x18ChangeOrderStatusRequest\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x16\n" +
-	"\x06Status\x18\x02 \x01(\tR\x06Status2\x85\x02\n" +
+func (x *ChangeOrderStatusRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type OrderStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromStatus    string                 `protobuf:"bytes,1,opt,name=FromStatus,proto3" json:"FromStatus,omitempty"` // Empty for the order being placed
+	ToStatus      string                 `protobuf:"bytes,2,opt,name=ToStatus,proto3" json:"ToStatus,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,3,opt,name=ChangedBy,proto3" json:"ChangedBy,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=Note,proto3" json:"Note,omitempty"`
+	ChangedAt     string                 `protobuf:"bytes,5,opt,name=ChangedAt,proto3" json:"ChangedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusChange) Reset() {
+	*x = OrderStatusChange{}
+	mi := &file_orders_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusChange) ProtoMessage() {}
+
+func (x *OrderStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusChange.ProtoReflect.Descriptor instead.
+func (*OrderStatusChange) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OrderStatusChange) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+type OrderStatusHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
+	Changes       []*OrderStatusChange   `protobuf:"bytes,2,rep,name=Changes,proto3" json:"Changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusHistory) Reset() {
+	*x = OrderStatusHistory{}
+	mi := &file_orders_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusHistory) ProtoMessage() {}
+
+func (x *OrderStatusHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusHistory.ProtoReflect.Descriptor instead.
+func (*OrderStatusHistory) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OrderStatusHistory) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderStatusHistory) GetChanges() []*OrderStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ReturnLineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId   int64                  `protobuf:"varint,1,opt,name=OrderItemId,proto3" json:"OrderItemId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"` // In the order line's unit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnLineRequest) Reset() {
+	*x = ReturnLineRequest{}
+	mi := &file_orders_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnLineRequest) ProtoMessage() {}
+
+func (x *ReturnLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnLineRequest.ProtoReflect.Descriptor instead.
+func (*ReturnLineRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReturnLineRequest) GetOrderItemId() int64 {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return 0
+}
+
+func (x *ReturnLineRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CreateReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
+	Lines         []*ReturnLineRequest   `protobuf:"bytes,2,rep,name=Lines,proto3" json:"Lines,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=Note,proto3" json:"Note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReturnRequest) Reset() {
+	*x = CreateReturnRequest{}
+	mi := &file_orders_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReturnRequest) ProtoMessage() {}
+
+func (x *CreateReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnRequest.ProtoReflect.Descriptor instead.
+func (*CreateReturnRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateReturnRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CreateReturnRequest) GetLines() []*ReturnLineRequest {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CreateReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateReturnRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReturnLine struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	OrderItemId     int64                  `protobuf:"varint,2,opt,name=OrderItemId,proto3" json:"OrderItemId,omitempty"`
+	ProductId       int64                  `protobuf:"varint,3,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity        int64                  `protobuf:"varint,4,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Unit            string                 `protobuf:"bytes,5,opt,name=Unit,proto3" json:"Unit,omitempty"`
+	Disposition     string                 `protobuf:"bytes,6,opt,name=Disposition,proto3" json:"Disposition,omitempty"` // restock or scrap, empty until dispositioned
+	LocationId      int64                  `protobuf:"varint,7,opt,name=LocationId,proto3" json:"LocationId,omitempty"`  // Location restocked into
+	MovementId      int64                  `protobuf:"varint,8,opt,name=MovementId,proto3" json:"MovementId,omitempty"`  // Return movement posted by a restock
+	DispositionedAt string                 `protobuf:"bytes,9,opt,name=DispositionedAt,proto3" json:"DispositionedAt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReturnLine) Reset() {
+	*x = ReturnLine{}
+	mi := &file_orders_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnLine) ProtoMessage() {}
+
+func (x *ReturnLine) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnLine.ProtoReflect.Descriptor instead.
+func (*ReturnLine) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReturnLine) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReturnLine) GetOrderItemId() int64 {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return 0
+}
+
+func (x *ReturnLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReturnLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReturnLine) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ReturnLine) GetDisposition() string {
+	if x != nil {
+		return x.Disposition
+	}
+	return ""
+}
+
+func (x *ReturnLine) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *ReturnLine) GetMovementId() int64 {
+	if x != nil {
+		return x.MovementId
+	}
+	return 0
+}
+
+func (x *ReturnLine) GetDispositionedAt() string {
+	if x != nil {
+		return x.DispositionedAt
+	}
+	return ""
+}
+
+type Return struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Number        string                 `protobuf:"bytes,2,opt,name=Number,proto3" json:"Number,omitempty"` // RMA-000001
+	OrderId       int64                  `protobuf:"varint,3,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"` // requested, received, closed or cancelled
+	Reason        string                 `protobuf:"bytes,5,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=Note,proto3" json:"Note,omitempty"`
+	Lines         []*ReturnLine          `protobuf:"bytes,7,rep,name=Lines,proto3" json:"Lines,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	ReceivedAt    string                 `protobuf:"bytes,9,opt,name=ReceivedAt,proto3" json:"ReceivedAt,omitempty"`
+	ClosedAt      string                 `protobuf:"bytes,10,opt,name=ClosedAt,proto3" json:"ClosedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Return) Reset() {
+	*x = Return{}
+	mi := &file_orders_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Return) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Return) ProtoMessage() {}
+
+func (x *Return) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Return.ProtoReflect.Descriptor instead.
+func (*Return) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Return) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Return) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Return) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Return) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Return) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Return) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Return) GetLines() []*ReturnLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Return) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Return) GetReceivedAt() string {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return ""
+}
+
+func (x *Return) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+type ReturnIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnIdRequest) Reset() {
+	*x = ReturnIdRequest{}
+	mi := &file_orders_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnIdRequest) ProtoMessage() {}
+
+func (x *ReturnIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnIdRequest.ProtoReflect.Descriptor instead.
+func (*ReturnIdRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReturnIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListReturnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=OrderId,proto3" json:"OrderId,omitempty"` // Optional filter by order
+	Status        string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`    // Optional filter by status
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnsRequest) Reset() {
+	*x = ListReturnsRequest{}
+	mi := &file_orders_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnsRequest) ProtoMessage() {}
+
+func (x *ListReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnsRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListReturnsRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ListReturnsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListReturnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Returns       []*Return              `protobuf:"bytes,1,rep,name=Returns,proto3" json:"Returns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnsResponse) Reset() {
+	*x = ListReturnsResponse{}
+	mi := &file_orders_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnsResponse) ProtoMessage() {}
+
+func (x *ListReturnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnsResponse.ProtoReflect.Descriptor instead.
+func (*ListReturnsResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListReturnsResponse) GetReturns() []*Return {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+type LineDisposition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        int64                  `protobuf:"varint,1,opt,name=LineId,proto3" json:"LineId,omitempty"`
+	Disposition   string                 `protobuf:"bytes,2,opt,name=Disposition,proto3" json:"Disposition,omitempty"`     // restock or scrap
+	LocationId    int64                  `protobuf:"varint,3,opt,name=LocationId,proto3" json:"LocationId,omitempty"`      // Optional restock location, defaults to the main location
+	SerialNumbers []string               `protobuf:"bytes,4,rep,name=SerialNumbers,proto3" json:"SerialNumbers,omitempty"` // Required for serialized products, one per unit restocked or scrapped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineDisposition) Reset() {
+	*x = LineDisposition{}
+	mi := &file_orders_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineDisposition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineDisposition) ProtoMessage() {}
+
+func (x *LineDisposition) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineDisposition.ProtoReflect.Descriptor instead.
+func (*LineDisposition) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LineDisposition) GetLineId() int64 {
+	if x != nil {
+		return x.LineId
+	}
+	return 0
+}
+
+func (x *LineDisposition) GetDisposition() string {
+	if x != nil {
+		return x.Disposition
+	}
+	return ""
+}
+
+func (x *LineDisposition) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *LineDisposition) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+type DispositionReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Lines         []*LineDisposition     `protobuf:"bytes,2,rep,name=Lines,proto3" json:"Lines,omitempty"` // One for every line still without a disposition
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DispositionReturnRequest) Reset() {
+	*x = DispositionReturnRequest{}
+	mi := &file_orders_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispositionReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispositionReturnRequest) ProtoMessage() {}
+
+func (x *DispositionReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispositionReturnRequest.ProtoReflect.Descriptor instead.
+func (*DispositionReturnRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DispositionReturnRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DispositionReturnRequest) GetLines() []*LineDisposition {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type ShipmentLineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId   int64                  `protobuf:"varint,1,opt,name=OrderItemId,proto3" json:"OrderItemId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"` // In the order item's unit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentLineRequest) Reset() {
+	*x = ShipmentLineRequest{}
+	mi := &file_orders_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentLineRequest) ProtoMessage() {}
+
+func (x *ShipmentLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentLineRequest.ProtoReflect.Descriptor instead.
+func (*ShipmentLineRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ShipmentLineRequest) GetOrderItemId() int64 {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return 0
+}
+
+func (x *ShipmentLineRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CreateShipmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        int64                  `protobuf:"varint,1,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
+	Carrier        string                 `protobuf:"bytes,2,opt,name=Carrier,proto3" json:"Carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,3,opt,name=TrackingNumber,proto3" json:"TrackingNumber,omitempty"`
+	Lines          []*ShipmentLineRequest `protobuf:"bytes,4,rep,name=Lines,proto3" json:"Lines,omitempty"`
+	ShippedBy      string                 `protobuf:"bytes,5,opt,name=ShippedBy,proto3" json:"ShippedBy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateShipmentRequest) Reset() {
+	*x = CreateShipmentRequest{}
+	mi := &file_orders_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequest) ProtoMessage() {}
+
+func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateShipmentRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CreateShipmentRequest) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetLines() []*ShipmentLineRequest {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CreateShipmentRequest) GetShippedBy() string {
+	if x != nil {
+		return x.ShippedBy
+	}
+	return ""
+}
+
+type ShipmentLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	OrderItemId   int64                  `protobuf:"varint,2,opt,name=OrderItemId,proto3" json:"OrderItemId,omitempty"`
+	ProductId     int64                  `protobuf:"varint,3,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,4,opt,name=Quantity,proto3" json:"Quantity,omitempty"` // In the order item's unit
+	BaseQuantity  int64                  `protobuf:"varint,5,opt,name=BaseQuantity,proto3" json:"BaseQuantity,omitempty"`
+	MovementId    int64                  `protobuf:"varint,6,opt,name=MovementId,proto3" json:"MovementId,omitempty"` // Purchase movement that took the units out of stock
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentLine) Reset() {
+	*x = ShipmentLine{}
+	mi := &file_orders_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentLine) ProtoMessage() {}
+
+func (x *ShipmentLine) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentLine.ProtoReflect.Descriptor instead.
+func (*ShipmentLine) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ShipmentLine) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShipmentLine) GetOrderItemId() int64 {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return 0
+}
+
+func (x *ShipmentLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ShipmentLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShipmentLine) GetBaseQuantity() int64 {
+	if x != nil {
+		return x.BaseQuantity
+	}
+	return 0
+}
+
+func (x *ShipmentLine) GetMovementId() int64 {
+	if x != nil {
+		return x.MovementId
+	}
+	return 0
+}
+
+type Shipment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Number         string                 `protobuf:"bytes,2,opt,name=Number,proto3" json:"Number,omitempty"` // SHP-000001, the reference of the shipment's movements
+	OrderId        int64                  `protobuf:"varint,3,opt,name=OrderId,proto3" json:"OrderId,omitempty"`
+	Carrier        string                 `protobuf:"bytes,4,opt,name=Carrier,proto3" json:"Carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,5,opt,name=TrackingNumber,proto3" json:"TrackingNumber,omitempty"`
+	ShippedBy      string                 `protobuf:"bytes,6,opt,name=ShippedBy,proto3" json:"ShippedBy,omitempty"`
+	Lines          []*ShipmentLine        `protobuf:"bytes,7,rep,name=Lines,proto3" json:"Lines,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_orders_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Shipment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Shipment) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Shipment) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Shipment) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *Shipment) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *Shipment) GetShippedBy() string {
+	if x != nil {
+		return x.ShippedBy
+	}
+	return ""
+}
+
+func (x *Shipment) GetLines() []*ShipmentLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Shipment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ShipmentIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentIdRequest) Reset() {
+	*x = ShipmentIdRequest{}
+	mi := &file_orders_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentIdRequest) ProtoMessage() {}
+
+func (x *ShipmentIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentIdRequest.ProtoReflect.Descriptor instead.
+func (*ShipmentIdRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ShipmentIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListShipmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipments     []*Shipment            `protobuf:"bytes,1,rep,name=Shipments,proto3" json:"Shipments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShipmentsResponse) Reset() {
+	*x = ListShipmentsResponse{}
+	mi := &file_orders_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShipmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShipmentsResponse) ProtoMessage() {}
+
+func (x *ListShipmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShipmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListShipmentsResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListShipmentsResponse) GetShipments() []*Shipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+type Promotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"` // Coupon code to enter when ordering, empty for promotions applied automatically
+	Name          string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`                    // percentage, fixed or buy_x_get_y
+	Percentage    string                 `protobuf:"bytes,5,opt,name=Percentage,proto3" json:"Percentage,omitempty"`        // percentage only, percent taken off
+	Amount        string                 `protobuf:"bytes,6,opt,name=Amount,proto3" json:"Amount,omitempty"`                // fixed only, amount taken off
+	ProductId     int64                  `protobuf:"varint,7,opt,name=ProductId,proto3" json:"ProductId,omitempty"`         // Optional, limits the promotion to one product. Required for buy_x_get_y
+	BuyQuantity   int64                  `protobuf:"varint,8,opt,name=BuyQuantity,proto3" json:"BuyQuantity,omitempty"`     // buy_x_get_y only, base units to pay for
+	GetQuantity   int64                  `protobuf:"varint,9,opt,name=GetQuantity,proto3" json:"GetQuantity,omitempty"`     // buy_x_get_y only, base units given free for every BuyQuantity paid for
+	MinQuantity   int64                  `protobuf:"varint,10,opt,name=MinQuantity,proto3" json:"MinQuantity,omitempty"`    // Optional, base units of the product, or of the whole order, required
+	MinOrderTotal string                 `protobuf:"bytes,11,opt,name=MinOrderTotal,proto3" json:"MinOrderTotal,omitempty"` // Optional, order subtotal before discounts required
+	UsageLimit    int64                  `protobuf:"varint,12,opt,name=UsageLimit,proto3" json:"UsageLimit,omitempty"`      // Optional, orders the promotion can be used on, 0 for no limit
+	UsageCount    int64                  `protobuf:"varint,13,opt,name=UsageCount,proto3" json:"UsageCount,omitempty"`      // Orders using the promotion that were not cancelled
+	StartsAt      string                 `protobuf:"bytes,14,opt,name=StartsAt,proto3" json:"StartsAt,omitempty"`           // Optional validity window, RFC 3339
+	EndsAt        string                 `protobuf:"bytes,15,opt,name=EndsAt,proto3" json:"EndsAt,omitempty"`
+	Active        bool                   `protobuf:"varint,16,opt,name=Active,proto3" json:"Active,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,17,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_orders_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Promotion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Promotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Promotion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Promotion) GetPercentage() string {
+	if x != nil {
+		return x.Percentage
+	}
+	return ""
+}
+
+func (x *Promotion) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Promotion) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Promotion) GetBuyQuantity() int64 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *Promotion) GetGetQuantity() int64 {
+	if x != nil {
+		return x.GetQuantity
+	}
+	return 0
+}
+
+func (x *Promotion) GetMinQuantity() int64 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *Promotion) GetMinOrderTotal() string {
+	if x != nil {
+		return x.MinOrderTotal
+	}
+	return ""
+}
+
+func (x *Promotion) GetUsageLimit() int64 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *Promotion) GetUsageCount() int64 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *Promotion) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *Promotion) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *Promotion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Promotion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type PromotionIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionIdRequest) Reset() {
+	*x = PromotionIdRequest{}
+	mi := &file_orders_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionIdRequest) ProtoMessage() {}
+
+func (x *PromotionIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionIdRequest.ProtoReflect.Descriptor instead.
+func (*PromotionIdRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PromotionIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListPromotionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly    bool                   `protobuf:"varint,1,opt,name=ActiveOnly,proto3" json:"ActiveOnly,omitempty"` // Only active promotions inside their validity window
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsRequest) Reset() {
+	*x = ListPromotionsRequest{}
+	mi := &file_orders_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsRequest) ProtoMessage() {}
+
+func (x *ListPromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListPromotionsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListPromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*Promotion           `protobuf:"bytes,1,rep,name=Promotions,proto3" json:"Promotions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsResponse) Reset() {
+	*x = ListPromotionsResponse{}
+	mi := &file_orders_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsResponse) ProtoMessage() {}
+
+func (x *ListPromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListPromotionsResponse) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+type DeletePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromotionResponse) Reset() {
+	*x = DeletePromotionResponse{}
+	mi := &file_orders_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromotionResponse) ProtoMessage() {}
+
+func (x *DeletePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromotionResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{30}
+}
+
+var File_orders_proto protoreflect.FileDescriptor
+
+const file_orders_proto_rawDesc = "" +
+	"\n" +
+	"\forders.proto\"\xd2\x01\n" +
+	"\x12CreateOrderRequest\x12 \n" +
+	"\x05Items\x18\x01 \x03(\v2\n" +
+	".OrderItemR\x05Items\x12*\n" +
+	"\x10PaymentReference\x18\x02 \x01(\tR\x10PaymentReference\x12\"\n" +
+	"\fCustomerName\x18\x03 \x01(\tR\fCustomerName\x12(\n" +
+	"\x0fCustomerContact\x18\x04 \x01(\tR\x0fCustomerContact\x12 \n" +
+	"\vCouponCodes\x18\x05 \x03(\tR\vCouponCodes\"\xa1\x03\n" +
+	"\tOrderItem\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12$\n" +
+	"\rReservationId\x18\x03 \x01(\x03R\rReservationId\x12\x12\n" +
+	"\x04Unit\x18\x04 \x01(\tR\x04Unit\x12\"\n" +
+	"\fBaseQuantity\x18\x05 \x01(\x03R\fBaseQuantity\x12\x0e\n" +
+	"\x02Id\x18\x06 \x01(\x03R\x02Id\x12*\n" +
+	"\x10ReturnedQuantity\x18\a \x01(\x03R\x10ReturnedQuantity\x12\x1c\n" +
+	"\tUnitPrice\x18\b \x01(\tR\tUnitPrice\x12\x1a\n" +
+	"\bSubtotal\x18\t \x01(\tR\bSubtotal\x12\x1a\n" +
+	"\bDiscount\x18\n" +
+	" \x01(\tR\bDiscount\x12\x18\n" +
+	"\aTaxRate\x18\v \x01(\tR\aTaxRate\x12\x10\n" +
+	"\x03Tax\x18\f \x01(\tR\x03Tax\x12\x14\n" +
+	"\x05Total\x18\r \x01(\tR\x05Total\x12(\n" +
+	"\x0fShippedQuantity\x18\x0e \x01(\x03R\x0fShippedQuantity\"\x95\x03\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\"\n" +
+	"\fCustomerName\x18\x02 \x01(\tR\fCustomerName\x12(\n" +
+	"\x0fCustomerContact\x18\x03 \x01(\tR\x0fCustomerContact\x12*\n" +
+	"\x10PaymentReference\x18\x04 \x01(\tR\x10PaymentReference\x12 \n" +
+	"\x05Items\x18\x05 \x03(\v2\n" +
+	".OrderItemR\x05Items\x12\x16\n" +
+	"\x06Status\x18\x06 \x01(\tR\x06Status\x12\x1c\n" +
+	"\tCreatedAt\x18\a \x01(\tR\tCreatedAt\x12\x1a\n" +
+	"\bSubtotal\x18\b \x01(\tR\bSubtotal\x12$\n" +
+	"\rDiscountTotal\x18\t \x01(\tR\rDiscountTotal\x12\x1a\n" +
+	"\bTaxTotal\x18\n" +
+	" \x01(\tR\bTaxTotal\x12\x1e\n" +
+	"\n" +
+	"GrandTotal\x18\v \x01(\tR\n" +
+	"GrandTotal\x12,\n" +
+	"\tDiscounts\x18\f \x03(\v2\x0e.OrderDiscountR\tDiscounts\"\x93\x01\n" +
+	"\rOrderDiscount\x12 \n" +
+	"\vPromotionId\x18\x01 \x01(\x03R\vPromotionId\x12\x12\n" +
+	"\x04Code\x18\x02 \x01(\tR\x04Code\x12\x12\n" +
+	"\x04Name\x18\x03 \x01(\tR\x04Name\x12 \n" +
+	"\vOrderItemId\x18\x04 \x01(\x03R\vOrderItemId\x12\x16\n" +
+	"\x06Amount\x18\x05 \x01(\tR\x06Amount\" \n" +
+	"\x0eOrderIdRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"[\n" +
+	"\x11ListOrdersRequest\x12\x16\n" +
+	"\x06Status\x18\x01 \x01(\tR\x06Status\x12\x12\n" +
+	"\x04Page\x18\x03 \x01(\x03R\x04Page\x12\x1a\n" +
+	"\bPageSize\x18\x04 \x01(\x03R\bPageSize\"T\n" +
+	"\x12ListOrdersResponse\x12\x1e\n" +
+	"\x06Orders\x18\x01 \x03(\v2\x06.OrderR\x06Orders\x12\x1e\n" +
+	"\n" +
+	"TotalCount\x18\x02 \x01(\x03R\n" +
+	"TotalCount\"\x15\n" +
+	"\x13DeleteOrderResponse\"t\n" +
+	"\x18ChangeOrderStatusRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x16\n" +
+	"\x06Status\x18\x02 \x01(\tR\x06Status\x12\x1c\n" +
+	"\tChangedBy\x18\x03 \x01(\tR\tChangedBy\x12\x12\n" +
+	"\x04Note\x18\x04 \x01(\tR\x04Note\"\x9f\x01\n" +
+	"\x11OrderStatusChange\x12\x1e\n" +
+	"\n" +
+	"FromStatus\x18\x01 \x01(\tR\n" +
+	"FromStatus\x12\x1a\n" +
+	"\bToStatus\x18\x02 \x01(\tR\bToStatus\x12\x1c\n" +
+	"\tChangedBy\x18\x03 \x01(\tR\tChangedBy\x12\x12\n" +
+	"\x04Note\x18\x04 \x01(\tR\x04Note\x12\x1c\n" +
+	"\tChangedAt\x18\x05 \x01(\tR\tChangedAt\"\\\n" +
+	"\x12OrderStatusHistory\x12\x18\n" +
+	"\aOrderId\x18\x01 \x01(\x03R\aOrderId\x12,\n" +
+	"\aChanges\x18\x02 \x03(\v2\x12.OrderStatusChangeR\aChanges\"Q\n" +
+	"\x11ReturnLineRequest\x12 \n" +
+	"\vOrderItemId\x18\x01 \x01(\x03R\vOrderItemId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\"\x85\x01\n" +
+	"\x13CreateReturnRequest\x12\x18\n" +
+	"\aOrderId\x18\x01 \x01(\x03R\aOrderId\x12(\n" +
+	"\x05Lines\x18\x02 \x03(\v2\x12.ReturnLineRequestR\x05Lines\x12\x16\n" +
+	"\x06Reason\x18\x03 \x01(\tR\x06Reason\x12\x12\n" +
+	"\x04Note\x18\x04 \x01(\tR\x04Note\"\x98\x02\n" +
+	"\n" +
+	"ReturnLine\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12 \n" +
+	"\vOrderItemId\x18\x02 \x01(\x03R\vOrderItemId\x12\x1c\n" +
+	"\tProductId\x18\x03 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x04 \x01(\x03R\bQuantity\x12\x12\n" +
+	"\x04Unit\x18\x05 \x01(\tR\x04Unit\x12 \n" +
+	"\vDisposition\x18\x06 \x01(\tR\vDisposition\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\a \x01(\x03R\n" +
+	"LocationId\x12\x1e\n" +
+	"\n" +
+	"MovementId\x18\b \x01(\x03R\n" +
+	"MovementId\x12(\n" +
+	"\x0fDispositionedAt\x18\t \x01(\tR\x0fDispositionedAt\"\x8b\x02\n" +
+	"\x06Return\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x16\n" +
+	"\x06Number\x18\x02 \x01(\tR\x06Number\x12\x18\n" +
+	"\aOrderId\x18\x03 \x01(\x03R\aOrderId\x12\x16\n" +
+	"\x06Status\x18\x04 \x01(\tR\x06Status\x12\x16\n" +
+	"\x06Reason\x18\x05 \x01(\tR\x06Reason\x12\x12\n" +
+	"\x04Note\x18\x06 \x01(\tR\x04Note\x12!\n" +
+	"\x05Lines\x18\a \x03(\v2\v.ReturnLineR\x05Lines\x12\x1c\n" +
+	"\tCreatedAt\x18\b \x01(\tR\tCreatedAt\x12\x1e\n" +
+	"\n" +
+	"ReceivedAt\x18\t \x01(\tR\n" +
+	"ReceivedAt\x12\x1a\n" +
+	"\bClosedAt\x18\n" +
+	" \x01(\tR\bClosedAt\"!\n" +
+	"\x0fReturnIdRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"F\n" +
+	"\x12ListReturnsRequest\x12\x18\n" +
+	"\aOrderId\x18\x01 \x01(\x03R\aOrderId\x12\x16\n" +
+	"\x06Status\x18\x02 \x01(\tR\x06Status\"8\n" +
+	"\x13ListReturnsResponse\x12!\n" +
+	"\aReturns\x18\x01 \x03(\v2\a.ReturnR\aReturns\"\x91\x01\n" +
+	"\x0fLineDisposition\x12\x16\n" +
+	"\x06LineId\x18\x01 \x01(\x03R\x06LineId\x12 \n" +
+	"\vDisposition\x18\x02 \x01(\tR\vDisposition\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x03 \x01(\x03R\n" +
+	"LocationId\x12$\n" +
+	"\rSerialNumbers\x18\x04 \x03(\tR\rSerialNumbers\"R\n" +
+	"\x18DispositionReturnRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12&\n" +
+	"\x05Lines\x18\x02 \x03(\v2\x10.LineDispositionR\x05Lines\"S\n" +
+	"\x13ShipmentLineRequest\x12 \n" +
+	"\vOrderItemId\x18\x01 \x01(\x03R\vOrderItemId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\"\xbd\x01\n" +
+	"\x15CreateShipmentRequest\x12\x18\n" +
+	"\aOrderId\x18\x01 \x01(\x03R\aOrderId\x12\x18\n" +
+	"\aCarrier\x18\x02 \x01(\tR\aCarrier\x12&\n" +
+	"\x0eTrackingNumber\x18\x03 \x01(\tR\x0eTrackingNumber\x12*\n" +
+	"\x05Lines\x18\x04 \x03(\v2\x14.ShipmentLineRequestR\x05Lines\x12\x1c\n" +
+	"\tShippedBy\x18\x05 \x01(\tR\tShippedBy\"\xbe\x01\n" +
+	"\fShipmentLine\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12 \n" +
+	"\vOrderItemId\x18\x02 \x01(\x03R\vOrderItemId\x12\x1c\n" +
+	"\tProductId\x18\x03 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x04 \x01(\x03R\bQuantity\x12\"\n" +
+	"\fBaseQuantity\x18\x05 \x01(\x03R\fBaseQuantity\x12\x1e\n" +
+	"\n" +
+	"MovementId\x18\x06 \x01(\x03R\n" +
+	"MovementId\"\xef\x01\n" +
+	"\bShipment\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x16\n" +
+	"\x06Number\x18\x02 \x01(\tR\x06Number\x12\x18\n" +
+	"\aOrderId\x18\x03 \x01(\x03R\aOrderId\x12\x18\n" +
+	"\aCarrier\x18\x04 \x01(\tR\aCarrier\x12&\n" +
+	"\x0eTrackingNumber\x18\x05 \x01(\tR\x0eTrackingNumber\x12\x1c\n" +
+	"\tShippedBy\x18\x06 \x01(\tR\tShippedBy\x12#\n" +
+	"\x05Lines\x18\a \x03(\v2\r.ShipmentLineR\x05Lines\x12\x1c\n" +
+	"\tCreatedAt\x18\b \x01(\tR\tCreatedAt\"#\n" +
+	"\x11ShipmentIdRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"@\n" +
+	"\x15ListShipmentsResponse\x12'\n" +
+	"\tShipments\x18\x01 \x03(\v2\t.ShipmentR\tShipments\"\xe3\x03\n" +
+	"\tPromotion\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x12\n" +
+	"\x04Code\x18\x02 \x01(\tR\x04Code\x12\x12\n" +
+	"\x04Name\x18\x03 \x01(\tR\x04Name\x12\x12\n" +
+	"\x04Type\x18\x04 \x01(\tR\x04Type\x12\x1e\n" +
+	"\n" +
+	"Percentage\x18\x05 \x01(\tR\n" +
+	"Percentage\x12\x16\n" +
+	"\x06Amount\x18\x06 \x01(\tR\x06Amount\x12\x1c\n" +
+	"\tProductId\x18\a \x01(\x03R\tProductId\x12 \n" +
+	"\vBuyQuantity\x18\b \x01(\x03R\vBuyQuantity\x12 \n" +
+	"\vGetQuantity\x18\t \x01(\x03R\vGetQuantity\x12 \n" +
+	"\vMinQuantity\x18\n" +
+	" \x01(\x03R\vMinQuantity\x12$\n" +
+	"\rMinOrderTotal\x18\v \x01(\tR\rMinOrderTotal\x12\x1e\n" +
+	"\n" +
+	"UsageLimit\x18\f \x01(\x03R\n" +
+	"UsageLimit\x12\x1e\n" +
+	"\n" +
+	"UsageCount\x18\r \x01(\x03R\n" +
+	"UsageCount\x12\x1a\n" +
+	"\bStartsAt\x18\x0e \x01(\tR\bStartsAt\x12\x16\n" +
+	"\x06EndsAt\x18\x0f \x01(\tR\x06EndsAt\x12\x16\n" +
+	"\x06Active\x18\x10 \x01(\bR\x06Active\x12\x1c\n" +
+	"\tCreatedAt\x18\x11 \x01(\tR\tCreatedAt\"$\n" +
+	"\x12PromotionIdRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"7\n" +
+	"\x15ListPromotionsRequest\x12\x1e\n" +
+	"\n" +
+	"ActiveOnly\x18\x01 \x01(\bR\n" +
+	"ActiveOnly\"D\n" +
+	"\x16ListPromotionsResponse\x12*\n" +
+	"\n" +
+	"Promotions\x18\x01 \x03(\v2\n" +
+	".PromotionR\n" +
+	"Promotions\"\x19\n" +
+	"\x17DeletePromotionResponse2\x8e\b\n" +
 	"\rOrdersService\x12*\n" +
 	"\vCreateOrder\x12\x13.CreateOrderRequest\x1a\x06.Order\x12#\n" +
 	"\bGetOrder\x12\x0f.OrderIdRequest\x1a\x06.Order\x125\n" +
 	"\n" +
 	"ListOrders\x12\x12.ListOrdersRequest\x1a\x13.ListOrdersResponse\x124\n" +
 	"\vDeleteOrder\x12\x0f.OrderIdRequest\x1a\x14.DeleteOrderResponse\x126\n" +
-	"\x11ChangeOrderStatus\x12\x19.ChangeOrderStatusRequest\x1a\x06.OrderB3Z1github.com/logan2k02/ims/shared/protobuf;protobufb\x06proto3"
+	"\x11ChangeOrderStatus\x12\x19.ChangeOrderStatusRequest\x1a\x06.Order\x12=\n" +
+	"\x15GetOrderStatusHistory\x12\x0f.OrderIdRequest\x1a\x13.OrderStatusHistory\x12-\n" +
+	"\fCreateReturn\x12\x14.CreateReturnRequest\x1a\a.Return\x12&\n" +
+	"\tGetReturn\x12\x10.ReturnIdRequest\x1a\a.Return\x128\n" +
+	"\vListReturns\x12\x13.ListReturnsRequest\x1a\x14.ListReturnsResponse\x12*\n" +
+	"\rReceiveReturn\x12\x10.ReturnIdRequest\x1a\a.Return\x127\n" +
+	"\x11DispositionReturn\x12\x19.DispositionReturnRequest\x1a\a.Return\x12)\n" +
+	"\fCancelReturn\x12\x10.ReturnIdRequest\x1a\a.Return\x123\n" +
+	"\x0eCreateShipment\x12\x16.CreateShipmentRequest\x1a\t.Shipment\x12,\n" +
+	"\vGetShipment\x12\x12.ShipmentIdRequest\x1a\t.Shipment\x128\n" +
+	"\rListShipments\x12\x0f.OrderIdRequest\x1a\x16.ListShipmentsResponse\x12)\n" +
+	"\x0fCreatePromotion\x12\n" +
+	".Promotion\x1a\n" +
+	".Promotion\x12)\n" +
+	"\x0fUpdatePromotion\x12\n" +
+	".Promotion\x1a\n" +
+	".Promotion\x12/\n" +
+	"\fGetPromotion\x12\x13.PromotionIdRequest\x1a\n" +
+	".Promotion\x12A\n" +
+	"\x0eListPromotions\x12\x16.ListPromotionsRequest\x1a\x17.ListPromotionsResponse\x12@\n" +
+	"\x0fDeletePromotion\x12\x13.PromotionIdRequest\x1a\x18.DeletePromotionResponseB3Z1github.com/logan2k02/ims/shared/protobuf;protobufb\x06proto3"
 
 var (
 	file_orders_proto_rawDescOnce sync.Once
@@ -535,36 +2426,99 @@ func file_orders_proto_rawDescGZIP() []byte {
 	return file_orders_proto_rawDescData
 }
 
-var file_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_orders_proto_goTypes = []any{
 	(*CreateOrderRequest)(nil),       // 0: CreateOrderRequest
 	(*OrderItem)(nil),                // 1: OrderItem
 	(*Order)(nil),                    // 2: Order
-	(*OrderIdRequest)(nil),           // 3: OrderIdRequest
-	(*ListOrdersRequest)(nil),        // 4: ListOrdersRequest
-	(*ListOrdersResponse)(nil),       // 5: ListOrdersResponse
-	(*DeleteOrderResponse)(nil),      // 6: DeleteOrderResponse
-	(*ChangeOrderStatusRequest)(nil), // 7: ChangeOrderStatusRequest
+	(*OrderDiscount)(nil),            // 3: OrderDiscount
+	(*OrderIdRequest)(nil),           // 4: OrderIdRequest
+	(*ListOrdersRequest)(nil),        // 5: ListOrdersRequest
+	(*ListOrdersResponse)(nil),       // 6: ListOrdersResponse
+	(*DeleteOrderResponse)(nil),      // 7: DeleteOrderResponse
+	(*ChangeOrderStatusRequest)(nil), // 8: ChangeOrderStatusRequest
+	(*OrderStatusChange)(nil),        // 9: OrderStatusChange
+	(*OrderStatusHistory)(nil),       // 10: OrderStatusHistory
+	(*ReturnLineRequest)(nil),        // 11: ReturnLineRequest
+	(*CreateReturnRequest)(nil),      // 12: CreateReturnRequest
+	(*ReturnLine)(nil),               // 13: ReturnLine
+	(*Return)(nil),                   // 14: Return
+	(*ReturnIdRequest)(nil),          // 15: ReturnIdRequest
+	(*ListReturnsRequest)(nil),       // 16: ListReturnsRequest
+	(*ListReturnsResponse)(nil),      // 17: ListReturnsResponse
+	(*LineDisposition)(nil),          // 18: LineDisposition
+	(*DispositionReturnRequest)(nil), // 19: DispositionReturnRequest
+	(*ShipmentLineRequest)(nil),      // 20: ShipmentLineRequest
+	(*CreateShipmentRequest)(nil),    // 21: CreateShipmentRequest
+	(*ShipmentLine)(nil),             // 22: ShipmentLine
+	(*Shipment)(nil),                 // 23: Shipment
+	(*ShipmentIdRequest)(nil),        // 24: ShipmentIdRequest
+	(*ListShipmentsResponse)(nil),    // 25: ListShipmentsResponse
+	(*Promotion)(nil),                // 26: Promotion
+	(*PromotionIdRequest)(nil),       // 27: PromotionIdRequest
+	(*ListPromotionsRequest)(nil),    // 28: ListPromotionsRequest
+	(*ListPromotionsResponse)(nil),   // 29: ListPromotionsResponse
+	(*DeletePromotionResponse)(nil),  // 30: DeletePromotionResponse
 }
 var file_orders_proto_depIdxs = []int32{
-	1, // 0: CreateOrderRequest.Items:type_name -> OrderItem
-	1, // 1: Order.Items:type_name -> OrderItem
-	2, // 2: ListOrdersResponse.Orders:type_name -> Order
-	0, // 3: OrdersService.CreateOrder:input_type -> CreateOrderRequest
-	3, // 4: OrdersService.GetOrder:input_type -> OrderIdRequest
-	4, // 5: OrdersService.ListOrders:input_type -> ListOrdersRequest
-	3, // 6: OrdersService.DeleteOrder:input_type -> OrderIdRequest
-	7, // 7: OrdersService.ChangeOrderStatus:input_type -> ChangeOrderStatusRequest
-	2, // 8: OrdersService.CreateOrder:output_type -> Order
-	2, // 9: OrdersService.GetOrder:output_type -> Order
-	5, // 10: OrdersService.ListOrders:output_type -> ListOrdersResponse
-	6, // 11: OrdersService.DeleteOrder:output_type -> DeleteOrderResponse
-	2, // 12: OrdersService.ChangeOrderStatus:output_type -> Order
-	8, // [8:13] is the sub-list for method output_type
-	3, // [3:8] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: CreateOrderRequest.Items:type_name -> OrderItem
+	1,  // 1: Order.Items:type_name -> OrderItem
+	3,  // 2: Order.Discounts:type_name -> OrderDiscount
+	2,  // 3: ListOrdersResponse.Orders:type_name -> Order
+	9,  // 4: OrderStatusHistory.Changes:type_name -> OrderStatusChange
+	11, // 5: CreateReturnRequest.Lines:type_name -> ReturnLineRequest
+	13, // 6: Return.Lines:type_name -> ReturnLine
+	14, // 7: ListReturnsResponse.Returns:type_name -> Return
+	18, // 8: DispositionReturnRequest.Lines:type_name -> LineDisposition
+	20, // 9: CreateShipmentRequest.Lines:type_name -> ShipmentLineRequest
+	22, // 10: Shipment.Lines:type_name -> ShipmentLine
+	23, // 11: ListShipmentsResponse.Shipments:type_name -> Shipment
+	26, // 12: ListPromotionsResponse.Promotions:type_name -> Promotion
+	0,  // 13: OrdersService.CreateOrder:input_type -> CreateOrderRequest
+	4,  // 14: OrdersService.GetOrder:input_type -> OrderIdRequest
+	5,  // 15: OrdersService.ListOrders:input_type -> ListOrdersRequest
+	4,  // 16: OrdersService.DeleteOrder:input_type -> OrderIdRequest
+	8,  // 17: OrdersService.ChangeOrderStatus:input_type -> ChangeOrderStatusRequest
+	4,  // 18: OrdersService.GetOrderStatusHistory:input_type -> OrderIdRequest
+	12, // 19: OrdersService.CreateReturn:input_type -> CreateReturnRequest
+	15, // 20: OrdersService.GetReturn:input_type -> ReturnIdRequest
+	16, // 21: OrdersService.ListReturns:input_type -> ListReturnsRequest
+	15, // 22: OrdersService.ReceiveReturn:input_type -> ReturnIdRequest
+	19, // 23: OrdersService.DispositionReturn:input_type -> DispositionReturnRequest
+	15, // 24: OrdersService.CancelReturn:input_type -> ReturnIdRequest
+	21, // 25: OrdersService.CreateShipment:input_type -> CreateShipmentRequest
+	24, // 26: OrdersService.GetShipment:input_type -> ShipmentIdRequest
+	4,  // 27: OrdersService.ListShipments:input_type -> OrderIdRequest
+	26, // 28: OrdersService.CreatePromotion:input_type -> Promotion
+	26, // 29: OrdersService.UpdatePromotion:input_type -> Promotion
+	27, // 30: OrdersService.GetPromotion:input_type -> PromotionIdRequest
+	28, // 31: OrdersService.ListPromotions:input_type -> ListPromotionsRequest
+	27, // 32: OrdersService.DeletePromotion:input_type -> PromotionIdRequest
+	2,  // 33: OrdersService.CreateOrder:output_type -> Order
+	2,  // 34: OrdersService.GetOrder:output_type -> Order
+	6,  // 35: OrdersService.ListOrders:output_type -> ListOrdersResponse
+	7,  // 36: OrdersService.DeleteOrder:output_type -> DeleteOrderResponse
+	2,  // 37: OrdersService.ChangeOrderStatus:output_type -> Order
+	10, // 38: OrdersService.GetOrderStatusHistory:output_type -> OrderStatusHistory
+	14, // 39: OrdersService.CreateReturn:output_type -> Return
+	14, // 40: OrdersService.GetReturn:output_type -> Return
+	17, // 41: OrdersService.ListReturns:output_type -> ListReturnsResponse
+	14, // 42: OrdersService.ReceiveReturn:output_type -> Return
+	14, // 43: OrdersService.DispositionReturn:output_type -> Return
+	14, // 44: OrdersService.CancelReturn:output_type -> Return
+	23, // 45: OrdersService.CreateShipment:output_type -> Shipment
+	23, // 46: OrdersService.GetShipment:output_type -> Shipment
+	25, // 47: OrdersService.ListShipments:output_type -> ListShipmentsResponse
+	26, // 48: OrdersService.CreatePromotion:output_type -> Promotion
+	26, // 49: OrdersService.UpdatePromotion:output_type -> Promotion
+	26, // 50: OrdersService.GetPromotion:output_type -> Promotion
+	29, // 51: OrdersService.ListPromotions:output_type -> ListPromotionsResponse
+	30, // 52: OrdersService.DeletePromotion:output_type -> DeletePromotionResponse
+	33, // [33:53] is the sub-list for method output_type
+	13, // [13:33] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_orders_proto_init() }
@@ -578,7 +2532,7 @@ func file_orders_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_orders_proto_rawDesc), len(file_orders_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
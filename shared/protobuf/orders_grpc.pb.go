@@ -19,11 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrdersService_CreateOrder_FullMethodName       = "/OrdersService/CreateOrder"
-	OrdersService_GetOrder_FullMethodName          = "/OrdersService/GetOrder"
-	OrdersService_ListOrders_FullMethodName        = "/OrdersService/ListOrders"
-	OrdersService_DeleteOrder_FullMethodName       = "/OrdersService/DeleteOrder"
-	OrdersService_ChangeOrderStatus_FullMethodName = "/OrdersService/ChangeOrderStatus"
+	OrdersService_CreateOrder_FullMethodName           = "/OrdersService/CreateOrder"
+	OrdersService_GetOrder_FullMethodName              = "/OrdersService/GetOrder"
+	OrdersService_ListOrders_FullMethodName            = "/OrdersService/ListOrders"
+	OrdersService_DeleteOrder_FullMethodName           = "/OrdersService/DeleteOrder"
+	OrdersService_ChangeOrderStatus_FullMethodName     = "/OrdersService/ChangeOrderStatus"
+	OrdersService_GetOrderStatusHistory_FullMethodName = "/OrdersService/GetOrderStatusHistory"
+	OrdersService_CreateReturn_FullMethodName          = "/OrdersService/CreateReturn"
+	OrdersService_GetReturn_FullMethodName             = "/OrdersService/GetReturn"
+	OrdersService_ListReturns_FullMethodName           = "/OrdersService/ListReturns"
+	OrdersService_ReceiveReturn_FullMethodName         = "/OrdersService/ReceiveReturn"
+	OrdersService_DispositionReturn_FullMethodName     = "/OrdersService/DispositionReturn"
+	OrdersService_CancelReturn_FullMethodName          = "/OrdersService/CancelReturn"
+	OrdersService_CreateShipment_FullMethodName        = "/OrdersService/CreateShipment"
+	OrdersService_GetShipment_FullMethodName           = "/OrdersService/GetShipment"
+	OrdersService_ListShipments_FullMethodName         = "/OrdersService/ListShipments"
+	OrdersService_CreatePromotion_FullMethodName       = "/OrdersService/CreatePromotion"
+	OrdersService_UpdatePromotion_FullMethodName       = "/OrdersService/UpdatePromotion"
+	OrdersService_GetPromotion_FullMethodName          = "/OrdersService/GetPromotion"
+	OrdersService_ListPromotions_FullMethodName        = "/OrdersService/ListPromotions"
+	OrdersService_DeletePromotion_FullMethodName       = "/OrdersService/DeletePromotion"
 )
 
 // OrdersServiceClient is the client API for OrdersService service.
@@ -35,6 +50,21 @@ type OrdersServiceClient interface {
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	DeleteOrder(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error)
 	ChangeOrderStatus(ctx context.Context, in *ChangeOrderStatusRequest, opts ...grpc.CallOption) (*Order, error)
+	GetOrderStatusHistory(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*OrderStatusHistory, error)
+	CreateReturn(ctx context.Context, in *CreateReturnRequest, opts ...grpc.CallOption) (*Return, error)
+	GetReturn(ctx context.Context, in *ReturnIdRequest, opts ...grpc.CallOption) (*Return, error)
+	ListReturns(ctx context.Context, in *ListReturnsRequest, opts ...grpc.CallOption) (*ListReturnsResponse, error)
+	ReceiveReturn(ctx context.Context, in *ReturnIdRequest, opts ...grpc.CallOption) (*Return, error)
+	DispositionReturn(ctx context.Context, in *DispositionReturnRequest, opts ...grpc.CallOption) (*Return, error)
+	CancelReturn(ctx context.Context, in *ReturnIdRequest, opts ...grpc.CallOption) (*Return, error)
+	CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*Shipment, error)
+	GetShipment(ctx context.Context, in *ShipmentIdRequest, opts ...grpc.CallOption) (*Shipment, error)
+	ListShipments(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*ListShipmentsResponse, error)
+	CreatePromotion(ctx context.Context, in *Promotion, opts ...grpc.CallOption) (*Promotion, error)
+	UpdatePromotion(ctx context.Context, in *Promotion, opts ...grpc.CallOption) (*Promotion, error)
+	GetPromotion(ctx context.Context, in *PromotionIdRequest, opts ...grpc.CallOption) (*Promotion, error)
+	ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error)
+	DeletePromotion(ctx context.Context, in *PromotionIdRequest, opts ...grpc.CallOption) (*DeletePromotionResponse, error)
 }
 
 type ordersServiceClient struct {
@@ -95,6 +125,156 @@ func (c *ordersServiceClient) ChangeOrderStatus(ctx context.Context, in *ChangeO
 	return out, nil
 }
 
+func (c *ordersServiceClient) GetOrderStatusHistory(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*OrderStatusHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderStatusHistory)
+	err := c.cc.Invoke(ctx, OrdersService_GetOrderStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreateReturn(ctx context.Context, in *CreateReturnRequest, opts ...grpc.CallOption) (*Return, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Return)
+	err := c.cc.Invoke(ctx, OrdersService_CreateReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetReturn(ctx context.Context, in *ReturnIdRequest, opts ...grpc.CallOption) (*Return, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Return)
+	err := c.cc.Invoke(ctx, OrdersService_GetReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListReturns(ctx context.Context, in *ListReturnsRequest, opts ...grpc.CallOption) (*ListReturnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReturnsResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ListReturns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ReceiveReturn(ctx context.Context, in *ReturnIdRequest, opts ...grpc.CallOption) (*Return, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Return)
+	err := c.cc.Invoke(ctx, OrdersService_ReceiveReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) DispositionReturn(ctx context.Context, in *DispositionReturnRequest, opts ...grpc.CallOption) (*Return, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Return)
+	err := c.cc.Invoke(ctx, OrdersService_DispositionReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CancelReturn(ctx context.Context, in *ReturnIdRequest, opts ...grpc.CallOption) (*Return, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Return)
+	err := c.cc.Invoke(ctx, OrdersService_CancelReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, OrdersService_CreateShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetShipment(ctx context.Context, in *ShipmentIdRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, OrdersService_GetShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListShipments(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*ListShipmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShipmentsResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ListShipments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreatePromotion(ctx context.Context, in *Promotion, opts ...grpc.CallOption) (*Promotion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Promotion)
+	err := c.cc.Invoke(ctx, OrdersService_CreatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) UpdatePromotion(ctx context.Context, in *Promotion, opts ...grpc.CallOption) (*Promotion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Promotion)
+	err := c.cc.Invoke(ctx, OrdersService_UpdatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetPromotion(ctx context.Context, in *PromotionIdRequest, opts ...grpc.CallOption) (*Promotion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Promotion)
+	err := c.cc.Invoke(ctx, OrdersService_GetPromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromotionsResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ListPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) DeletePromotion(ctx context.Context, in *PromotionIdRequest, opts ...grpc.CallOption) (*DeletePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePromotionResponse)
+	err := c.cc.Invoke(ctx, OrdersService_DeletePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrdersServiceServer is the server API for OrdersService service.
 // All implementations must embed UnimplementedOrdersServiceServer
 // for forward compatibility.
@@ -104,6 +284,21 @@ type OrdersServiceServer interface {
 	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	DeleteOrder(context.Context, *OrderIdRequest) (*DeleteOrderResponse, error)
 	ChangeOrderStatus(context.Context, *ChangeOrderStatusRequest) (*Order, error)
+	GetOrderStatusHistory(context.Context, *OrderIdRequest) (*OrderStatusHistory, error)
+	CreateReturn(context.Context, *CreateReturnRequest) (*Return, error)
+	GetReturn(context.Context, *ReturnIdRequest) (*Return, error)
+	ListReturns(context.Context, *ListReturnsRequest) (*ListReturnsResponse, error)
+	ReceiveReturn(context.Context, *ReturnIdRequest) (*Return, error)
+	DispositionReturn(context.Context, *DispositionReturnRequest) (*Return, error)
+	CancelReturn(context.Context, *ReturnIdRequest) (*Return, error)
+	CreateShipment(context.Context, *CreateShipmentRequest) (*Shipment, error)
+	GetShipment(context.Context, *ShipmentIdRequest) (*Shipment, error)
+	ListShipments(context.Context, *OrderIdRequest) (*ListShipmentsResponse, error)
+	CreatePromotion(context.Context, *Promotion) (*Promotion, error)
+	UpdatePromotion(context.Context, *Promotion) (*Promotion, error)
+	GetPromotion(context.Context, *PromotionIdRequest) (*Promotion, error)
+	ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error)
+	DeletePromotion(context.Context, *PromotionIdRequest) (*DeletePromotionResponse, error)
 	mustEmbedUnimplementedOrdersServiceServer()
 }
 
@@ -129,6 +324,51 @@ func (UnimplementedOrdersServiceServer) DeleteOrder(context.Context, *OrderIdReq
 func (UnimplementedOrdersServiceServer) ChangeOrderStatus(context.Context, *ChangeOrderStatusRequest) (*Order, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeOrderStatus not implemented")
 }
+func (UnimplementedOrdersServiceServer) GetOrderStatusHistory(context.Context, *OrderIdRequest) (*OrderStatusHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderStatusHistory not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreateReturn(context.Context, *CreateReturnRequest) (*Return, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetReturn(context.Context, *ReturnIdRequest) (*Return, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListReturns(context.Context, *ListReturnsRequest) (*ListReturnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReturns not implemented")
+}
+func (UnimplementedOrdersServiceServer) ReceiveReturn(context.Context, *ReturnIdRequest) (*Return, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) DispositionReturn(context.Context, *DispositionReturnRequest) (*Return, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DispositionReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) CancelReturn(context.Context, *ReturnIdRequest) (*Return, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReturn not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreateShipment(context.Context, *CreateShipmentRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShipment not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetShipment(context.Context, *ShipmentIdRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShipment not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListShipments(context.Context, *OrderIdRequest) (*ListShipmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShipments not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreatePromotion(context.Context, *Promotion) (*Promotion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (UnimplementedOrdersServiceServer) UpdatePromotion(context.Context, *Promotion) (*Promotion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePromotion not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetPromotion(context.Context, *PromotionIdRequest) (*Promotion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromotion not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromotions not implemented")
+}
+func (UnimplementedOrdersServiceServer) DeletePromotion(context.Context, *PromotionIdRequest) (*DeletePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePromotion not implemented")
+}
 func (UnimplementedOrdersServiceServer) mustEmbedUnimplementedOrdersServiceServer() {}
 func (UnimplementedOrdersServiceServer) testEmbeddedByValue()                       {}
 
@@ -240,6 +480,276 @@ func _OrdersService_ChangeOrderStatus_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_GetOrderStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetOrderStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetOrderStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetOrderStatusHistory(ctx, req.(*OrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CreateReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreateReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreateReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreateReturn(ctx, req.(*CreateReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetReturn(ctx, req.(*ReturnIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReturnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListReturns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListReturns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListReturns(ctx, req.(*ListReturnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ReceiveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ReceiveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ReceiveReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ReceiveReturn(ctx, req.(*ReturnIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_DispositionReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DispositionReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).DispositionReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_DispositionReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).DispositionReturn(ctx, req.(*DispositionReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CancelReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CancelReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CancelReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CancelReturn(ctx, req.(*ReturnIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CreateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreateShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreateShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreateShipment(ctx, req.(*CreateShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipmentIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetShipment(ctx, req.(*ShipmentIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListShipments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListShipments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListShipments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListShipments(ctx, req.(*OrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Promotion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreatePromotion(ctx, req.(*Promotion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_UpdatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Promotion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).UpdatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_UpdatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).UpdatePromotion(ctx, req.(*Promotion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetPromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetPromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetPromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetPromotion(ctx, req.(*PromotionIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromotionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListPromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListPromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListPromotions(ctx, req.(*ListPromotionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_DeletePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).DeletePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_DeletePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).DeletePromotion(ctx, req.(*PromotionIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrdersService_ServiceDesc is the grpc.ServiceDesc for OrdersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +777,66 @@ var OrdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeOrderStatus",
 			Handler:    _OrdersService_ChangeOrderStatus_Handler,
 		},
+		{
+			MethodName: "GetOrderStatusHistory",
+			Handler:    _OrdersService_GetOrderStatusHistory_Handler,
+		},
+		{
+			MethodName: "CreateReturn",
+			Handler:    _OrdersService_CreateReturn_Handler,
+		},
+		{
+			MethodName: "GetReturn",
+			Handler:    _OrdersService_GetReturn_Handler,
+		},
+		{
+			MethodName: "ListReturns",
+			Handler:    _OrdersService_ListReturns_Handler,
+		},
+		{
+			MethodName: "ReceiveReturn",
+			Handler:    _OrdersService_ReceiveReturn_Handler,
+		},
+		{
+			MethodName: "DispositionReturn",
+			Handler:    _OrdersService_DispositionReturn_Handler,
+		},
+		{
+			MethodName: "CancelReturn",
+			Handler:    _OrdersService_CancelReturn_Handler,
+		},
+		{
+			MethodName: "CreateShipment",
+			Handler:    _OrdersService_CreateShipment_Handler,
+		},
+		{
+			MethodName: "GetShipment",
+			Handler:    _OrdersService_GetShipment_Handler,
+		},
+		{
+			MethodName: "ListShipments",
+			Handler:    _OrdersService_ListShipments_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _OrdersService_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "UpdatePromotion",
+			Handler:    _OrdersService_UpdatePromotion_Handler,
+		},
+		{
+			MethodName: "GetPromotion",
+			Handler:    _OrdersService_GetPromotion_Handler,
+		},
+		{
+			MethodName: "ListPromotions",
+			Handler:    _OrdersService_ListPromotions_Handler,
+		},
+		{
+			MethodName: "DeletePromotion",
+			Handler:    _OrdersService_DeletePromotion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orders.proto",
@@ -30,6 +30,9 @@ type CreateProductRequest struct {
 	ReorderLevel    int64                  `protobuf:"varint,5,opt,name=ReorderLevel,proto3" json:"ReorderLevel,omitempty"`
 	ReorderQuantity int64                  `protobuf:"varint,6,opt,name=ReorderQuantity,proto3" json:"ReorderQuantity,omitempty"`
 	InitialQuantity int64                  `protobuf:"varint,7,opt,name=InitialQuantity,proto3" json:"InitialQuantity,omitempty"`
+	AllowBackorder  bool                   `protobuf:"varint,8,opt,name=AllowBackorder,proto3" json:"AllowBackorder,omitempty"`
+	Serialized      bool                   `protobuf:"varint,9,opt,name=Serialized,proto3" json:"Serialized,omitempty"`
+	CostingMethod   string                 `protobuf:"bytes,10,opt,name=CostingMethod,proto3" json:"CostingMethod,omitempty"` // fifo or average, defaults to fifo
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -113,6 +116,27 @@ func (x *CreateProductRequest) GetInitialQuantity() int64 {
 	return 0
 }
 
+func (x *CreateProductRequest) GetAllowBackorder() bool {
+	if x != nil {
+		return x.AllowBackorder
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetSerialized() bool {
+	if x != nil {
+		return x.Serialized
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetCostingMethod() string {
+	if x != nil {
+		return x.CostingMethod
+	}
+	return ""
+}
+
 type ProductIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
@@ -158,18 +182,26 @@ func (x *ProductIdRequest) GetId() int64 {
 }
 
 type Product struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Sku             string                 `protobuf:"bytes,3,opt,name=Sku,proto3" json:"Sku,omitempty"`
-	Description     string                 `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description,omitempty"`
-	Price           float64                `protobuf:"fixed64,5,opt,name=Price,proto3" json:"Price,omitempty"`
-	CreatedAt       string                 `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	ReorderLevel    int64                  `protobuf:"varint,7,opt,name=ReorderLevel,proto3" json:"ReorderLevel,omitempty"`
-	ReorderQuantity int64                  `protobuf:"varint,8,opt,name=ReorderQuantity,proto3" json:"ReorderQuantity,omitempty"`
-	StockQuantity   int64                  `protobuf:"varint,9,opt,name=StockQuantity,proto3" json:"StockQuantity,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Sku               string                 `protobuf:"bytes,3,opt,name=Sku,proto3" json:"Sku,omitempty"`
+	Description       string                 `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description,omitempty"`
+	Price             float64                `protobuf:"fixed64,5,opt,name=Price,proto3" json:"Price,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	ReorderLevel      int64                  `protobuf:"varint,7,opt,name=ReorderLevel,proto3" json:"ReorderLevel,omitempty"`
+	ReorderQuantity   int64                  `protobuf:"varint,8,opt,name=ReorderQuantity,proto3" json:"ReorderQuantity,omitempty"`
+	StockQuantity     int64                  `protobuf:"varint,9,opt,name=StockQuantity,proto3" json:"StockQuantity,omitempty"`
+	ReservedQuantity  int64                  `protobuf:"varint,10,opt,name=ReservedQuantity,proto3" json:"ReservedQuantity,omitempty"`
+	AvailableQuantity int64                  `protobuf:"varint,11,opt,name=AvailableQuantity,proto3" json:"AvailableQuantity,omitempty"` // StockQuantity minus active reservations and held stock
+	AllowBackorder    bool                   `protobuf:"varint,12,opt,name=AllowBackorder,proto3" json:"AllowBackorder,omitempty"`       // Whether purchases may take stock below zero
+	Serialized        bool                   `protobuf:"varint,13,opt,name=Serialized,proto3" json:"Serialized,omitempty"`               // Whether every unit carries its own serial number
+	CostingMethod     string                 `protobuf:"bytes,14,opt,name=CostingMethod,proto3" json:"CostingMethod,omitempty"`          // fifo or average
+	IsKit             bool                   `protobuf:"varint,15,opt,name=IsKit,proto3" json:"IsKit,omitempty"`                         // Whether the product is assembled from component products
+	BuildableQuantity int64                  `protobuf:"varint,16,opt,name=BuildableQuantity,proto3" json:"BuildableQuantity,omitempty"` // Kits only, units the available component stock can still make
+	HeldQuantity      int64                  `protobuf:"varint,17,opt,name=HeldQuantity,proto3" json:"HeldQuantity,omitempty"`           // Stock quarantined, damaged or in transit, not sellable
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
@@ -265,6 +297,62 @@ func (x *Product) GetStockQuantity() int64 {
 	return 0
 }
 
+func (x *Product) GetReservedQuantity() int64 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *Product) GetAvailableQuantity() int64 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *Product) GetAllowBackorder() bool {
+	if x != nil {
+		return x.AllowBackorder
+	}
+	return false
+}
+
+func (x *Product) GetSerialized() bool {
+	if x != nil {
+		return x.Serialized
+	}
+	return false
+}
+
+func (x *Product) GetCostingMethod() string {
+	if x != nil {
+		return x.CostingMethod
+	}
+	return ""
+}
+
+func (x *Product) GetIsKit() bool {
+	if x != nil {
+		return x.IsKit
+	}
+	return false
+}
+
+func (x *Product) GetBuildableQuantity() int64 {
+	if x != nil {
+		return x.BuildableQuantity
+	}
+	return 0
+}
+
+func (x *Product) GetHeldQuantity() int64 {
+	if x != nil {
+		return x.HeldQuantity
+	}
+	return 0
+}
+
 type ListProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ids           []int64                `protobuf:"varint,1,rep,packed,name=Ids,proto3" json:"Ids,omitempty"`
@@ -398,6 +486,9 @@ type UpdateProductRequest struct {
 	Price           float64                `protobuf:"fixed64,5,opt,name=Price,proto3" json:"Price,omitempty"`
 	ReorderLevel    int64                  `protobuf:"varint,6,opt,name=ReorderLevel,proto3" json:"ReorderLevel,omitempty"`
 	ReorderQuantity int64                  `protobuf:"varint,7,opt,name=ReorderQuantity,proto3" json:"ReorderQuantity,omitempty"`
+	AllowBackorder  bool                   `protobuf:"varint,8,opt,name=AllowBackorder,proto3" json:"AllowBackorder,omitempty"`
+	Serialized      bool                   `protobuf:"varint,9,opt,name=Serialized,proto3" json:"Serialized,omitempty"`
+	CostingMethod   string                 `protobuf:"bytes,10,opt,name=CostingMethod,proto3" json:"CostingMethod,omitempty"` // fifo or average, defaults to fifo
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -481,11 +572,360 @@ func (x *UpdateProductRequest) GetReorderQuantity() int64 {
 	return 0
 }
 
+func (x *UpdateProductRequest) GetAllowBackorder() bool {
+	if x != nil {
+		return x.AllowBackorder
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetSerialized() bool {
+	if x != nil {
+		return x.Serialized
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetCostingMethod() string {
+	if x != nil {
+		return x.CostingMethod
+	}
+	return ""
+}
+
+type KitComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"` // Units of the component in one kit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitComponent) Reset() {
+	*x = KitComponent{}
+	mi := &file_products_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitComponent) ProtoMessage() {}
+
+func (x *KitComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitComponent.ProtoReflect.Descriptor instead.
+func (*KitComponent) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KitComponent) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *KitComponent) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type SetKitComponentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KitId         int64                  `protobuf:"varint,1,opt,name=KitId,proto3" json:"KitId,omitempty"`
+	Components    []*KitComponent        `protobuf:"bytes,2,rep,name=Components,proto3" json:"Components,omitempty"` // Replaces the bill of materials, empty turns the kit back into a plain product
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetKitComponentsRequest) Reset() {
+	*x = SetKitComponentsRequest{}
+	mi := &file_products_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetKitComponentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKitComponentsRequest) ProtoMessage() {}
+
+func (x *SetKitComponentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKitComponentsRequest.ProtoReflect.Descriptor instead.
+func (*SetKitComponentsRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetKitComponentsRequest) GetKitId() int64 {
+	if x != nil {
+		return x.KitId
+	}
+	return 0
+}
+
+func (x *SetKitComponentsRequest) GetComponents() []*KitComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type Kit struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	KitId             int64                  `protobuf:"varint,1,opt,name=KitId,proto3" json:"KitId,omitempty"`
+	Components        []*KitComponent        `protobuf:"bytes,2,rep,name=Components,proto3" json:"Components,omitempty"`
+	BuildableQuantity int64                  `protobuf:"varint,3,opt,name=BuildableQuantity,proto3" json:"BuildableQuantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Kit) Reset() {
+	*x = Kit{}
+	mi := &file_products_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Kit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kit) ProtoMessage() {}
+
+func (x *Kit) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kit.ProtoReflect.Descriptor instead.
+func (*Kit) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Kit) GetKitId() int64 {
+	if x != nil {
+		return x.KitId
+	}
+	return 0
+}
+
+func (x *Kit) GetComponents() []*KitComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *Kit) GetBuildableQuantity() int64 {
+	if x != nil {
+		return x.BuildableQuantity
+	}
+	return 0
+}
+
+type ProductUnit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`      // e.g. box, lowercase letters and digits
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`      // e.g. Box of 12
+	Factor        int64                  `protobuf:"varint,3,opt,name=Factor,proto3" json:"Factor,omitempty"` // Base units in one of this unit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductUnit) Reset() {
+	*x = ProductUnit{}
+	mi := &file_products_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductUnit) ProtoMessage() {}
+
+func (x *ProductUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductUnit.ProtoReflect.Descriptor instead.
+func (*ProductUnit) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProductUnit) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ProductUnit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductUnit) GetFactor() int64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+type SetProductUnitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Units         []*ProductUnit         `protobuf:"bytes,2,rep,name=Units,proto3" json:"Units,omitempty"` // Replaces the product's units, the base unit each is implied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductUnitsRequest) Reset() {
+	*x = SetProductUnitsRequest{}
+	mi := &file_products_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductUnitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductUnitsRequest) ProtoMessage() {}
+
+func (x *SetProductUnitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductUnitsRequest.ProtoReflect.Descriptor instead.
+func (*SetProductUnitsRequest) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetProductUnitsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetProductUnitsRequest) GetUnits() []*ProductUnit {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+type ProductUnits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Units         []*ProductUnit         `protobuf:"bytes,2,rep,name=Units,proto3" json:"Units,omitempty"` // Starts with the base unit each
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductUnits) Reset() {
+	*x = ProductUnits{}
+	mi := &file_products_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductUnits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductUnits) ProtoMessage() {}
+
+func (x *ProductUnits) ProtoReflect() protoreflect.Message {
+	mi := &file_products_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductUnits.ProtoReflect.Descriptor instead.
+func (*ProductUnits) Descriptor() ([]byte, []int) {
+	return file_products_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProductUnits) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductUnits) GetUnits() []*ProductUnit {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
 var File_products_proto protoreflect.FileDescriptor
 
 const file_products_proto_rawDesc = "" +
 	"\n" +
-	"\x0eproducts.proto\"\xec\x01\n" +
+	"\x0eproducts.proto\"\xda\x02\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x10\n" +
 	"\x03Sku\x18\x02 \x01(\tR\x03Sku\x12 \n" +
@@ -493,9 +933,15 @@ const file_products_proto_rawDesc = "" +
 	"\x05Price\x18\x04 \x01(\x01R\x05Price\x12\"\n" +
 	"\fReorderLevel\x18\x05 \x01(\x03R\fReorderLevel\x12(\n" +
 	"\x0fReorderQuantity\x18\x06 \x01(\x03R\x0fReorderQuantity\x12(\n" +
-	"\x0fInitialQuantity\x18\a \x01(\x03R\x0fInitialQuantity\"\"\n" +
+	"\x0fInitialQuantity\x18\a \x01(\x03R\x0fInitialQuantity\x12&\n" +
+	"\x0eAllowBackorder\x18\b \x01(\bR\x0eAllowBackorder\x12\x1e\n" +
+	"\n" +
+	"Serialized\x18\t \x01(\bR\n" +
+	"Serialized\x12$\n" +
+	"\rCostingMethod\x18\n" +
+	" \x01(\tR\rCostingMethod\"\"\n" +
 	"\x10ProductIdRequest\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x03R\x02Id\"\x89\x02\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"\xb9\x04\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x10\n" +
@@ -505,12 +951,23 @@ const file_products_proto_rawDesc = "" +
 	"\tCreatedAt\x18\x06 \x01(\tR\tCreatedAt\x12\"\n" +
 	"\fReorderLevel\x18\a \x01(\x03R\fReorderLevel\x12(\n" +
 	"\x0fReorderQuantity\x18\b \x01(\x03R\x0fReorderQuantity\x12$\n" +
-	"\rStockQuantity\x18\t \x01(\x03R\rStockQuantity\"'\n" +
+	"\rStockQuantity\x18\t \x01(\x03R\rStockQuantity\x12*\n" +
+	"\x10ReservedQuantity\x18\n" +
+	" \x01(\x03R\x10ReservedQuantity\x12,\n" +
+	"\x11AvailableQuantity\x18\v \x01(\x03R\x11AvailableQuantity\x12&\n" +
+	"\x0eAllowBackorder\x18\f \x01(\bR\x0eAllowBackorder\x12\x1e\n" +
+	"\n" +
+	"Serialized\x18\r \x01(\bR\n" +
+	"Serialized\x12$\n" +
+	"\rCostingMethod\x18\x0e \x01(\tR\rCostingMethod\x12\x14\n" +
+	"\x05IsKit\x18\x0f \x01(\bR\x05IsKit\x12,\n" +
+	"\x11BuildableQuantity\x18\x10 \x01(\x03R\x11BuildableQuantity\x12\"\n" +
+	"\fHeldQuantity\x18\x11 \x01(\x03R\fHeldQuantity\"'\n" +
 	"\x13ListProductsRequest\x12\x10\n" +
 	"\x03Ids\x18\x01 \x03(\x03R\x03Ids\"<\n" +
 	"\x14ListProductsResponse\x12$\n" +
 	"\bProducts\x18\x01 \x03(\v2\b.ProductR\bProducts\"\x17\n" +
-	"\x15DeleteProductResponse\"\xd2\x01\n" +
+	"\x15DeleteProductResponse\"\xc0\x02\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x10\n" +
@@ -518,14 +975,48 @@ const file_products_proto_rawDesc = "" +
 	"\vDescription\x18\x04 \x01(\tR\vDescription\x12\x14\n" +
 	"\x05Price\x18\x05 \x01(\x01R\x05Price\x12\"\n" +
 	"\fReorderLevel\x18\x06 \x01(\x03R\fReorderLevel\x12(\n" +
-	"\x0fReorderQuantity\x18\a \x01(\x03R\x0fReorderQuantity2\x99\x02\n" +
+	"\x0fReorderQuantity\x18\a \x01(\x03R\x0fReorderQuantity\x12&\n" +
+	"\x0eAllowBackorder\x18\b \x01(\bR\x0eAllowBackorder\x12\x1e\n" +
+	"\n" +
+	"Serialized\x18\t \x01(\bR\n" +
+	"Serialized\x12$\n" +
+	"\rCostingMethod\x18\n" +
+	" \x01(\tR\rCostingMethod\"H\n" +
+	"\fKitComponent\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\"^\n" +
+	"\x17SetKitComponentsRequest\x12\x14\n" +
+	"\x05KitId\x18\x01 \x01(\x03R\x05KitId\x12-\n" +
+	"\n" +
+	"Components\x18\x02 \x03(\v2\r.KitComponentR\n" +
+	"Components\"x\n" +
+	"\x03Kit\x12\x14\n" +
+	"\x05KitId\x18\x01 \x01(\x03R\x05KitId\x12-\n" +
+	"\n" +
+	"Components\x18\x02 \x03(\v2\r.KitComponentR\n" +
+	"Components\x12,\n" +
+	"\x11BuildableQuantity\x18\x03 \x01(\x03R\x11BuildableQuantity\"M\n" +
+	"\vProductUnit\x12\x12\n" +
+	"\x04Code\x18\x01 \x01(\tR\x04Code\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x16\n" +
+	"\x06Factor\x18\x03 \x01(\x03R\x06Factor\"Z\n" +
+	"\x16SetProductUnitsRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\"\n" +
+	"\x05Units\x18\x02 \x03(\v2\f.ProductUnitR\x05Units\"P\n" +
+	"\fProductUnits\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\"\n" +
+	"\x05Units\x18\x02 \x03(\v2\f.ProductUnitR\x05Units2\xe1\x03\n" +
 	"\x0fProductsService\x120\n" +
 	"\rCreateProduct\x12\x15.CreateProductRequest\x1a\b.Product\x12)\n" +
 	"\n" +
 	"GetProduct\x12\x11.ProductIdRequest\x1a\b.Product\x12;\n" +
 	"\fListProducts\x12\x14.ListProductsRequest\x1a\x15.ListProductsResponse\x12:\n" +
 	"\rDeleteProduct\x12\x11.ProductIdRequest\x1a\x16.DeleteProductResponse\x120\n" +
-	"\rUpdateProduct\x12\x15.UpdateProductRequest\x1a\b.ProductB3Z1github.com/logan2k02/ims/shared/protobuf;protobufb\x06proto3"
+	"\rUpdateProduct\x12\x15.UpdateProductRequest\x1a\b.Product\x122\n" +
+	"\x10SetKitComponents\x12\x18.SetKitComponentsRequest\x1a\x04.Kit\x12!\n" +
+	"\x06GetKit\x12\x11.ProductIdRequest\x1a\x04.Kit\x129\n" +
+	"\x0fSetProductUnits\x12\x17.SetProductUnitsRequest\x1a\r.ProductUnits\x124\n" +
+	"\x10ListProductUnits\x12\x11.ProductIdRequest\x1a\r.ProductUnitsB3Z1github.com/logan2k02/ims/shared/protobuf;protobufb\x06proto3"
 
 var (
 	file_products_proto_rawDescOnce sync.Once
@@ -539,33 +1030,51 @@ func file_products_proto_rawDescGZIP() []byte {
 	return file_products_proto_rawDescData
 }
 
-var file_products_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_products_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_products_proto_goTypes = []any{
-	(*CreateProductRequest)(nil),  // 0: CreateProductRequest
-	(*ProductIdRequest)(nil),      // 1: ProductIdRequest
-	(*Product)(nil),               // 2: Product
-	(*ListProductsRequest)(nil),   // 3: ListProductsRequest
-	(*ListProductsResponse)(nil),  // 4: ListProductsResponse
-	(*DeleteProductResponse)(nil), // 5: DeleteProductResponse
-	(*UpdateProductRequest)(nil),  // 6: UpdateProductRequest
+	(*CreateProductRequest)(nil),    // 0: CreateProductRequest
+	(*ProductIdRequest)(nil),        // 1: ProductIdRequest
+	(*Product)(nil),                 // 2: Product
+	(*ListProductsRequest)(nil),     // 3: ListProductsRequest
+	(*ListProductsResponse)(nil),    // 4: ListProductsResponse
+	(*DeleteProductResponse)(nil),   // 5: DeleteProductResponse
+	(*UpdateProductRequest)(nil),    // 6: UpdateProductRequest
+	(*KitComponent)(nil),            // 7: KitComponent
+	(*SetKitComponentsRequest)(nil), // 8: SetKitComponentsRequest
+	(*Kit)(nil),                     // 9: Kit
+	(*ProductUnit)(nil),             // 10: ProductUnit
+	(*SetProductUnitsRequest)(nil),  // 11: SetProductUnitsRequest
+	(*ProductUnits)(nil),            // 12: ProductUnits
 }
 var file_products_proto_depIdxs = []int32{
-	2, // 0: ListProductsResponse.Products:type_name -> Product
-	0, // 1: ProductsService.CreateProduct:input_type -> CreateProductRequest
-	1, // 2: ProductsService.GetProduct:input_type -> ProductIdRequest
-	3, // 3: ProductsService.ListProducts:input_type -> ListProductsRequest
-	1, // 4: ProductsService.DeleteProduct:input_type -> ProductIdRequest
-	6, // 5: ProductsService.UpdateProduct:input_type -> UpdateProductRequest
-	2, // 6: ProductsService.CreateProduct:output_type -> Product
-	2, // 7: ProductsService.GetProduct:output_type -> Product
-	4, // 8: ProductsService.ListProducts:output_type -> ListProductsResponse
-	5, // 9: ProductsService.DeleteProduct:output_type -> DeleteProductResponse
-	2, // 10: ProductsService.UpdateProduct:output_type -> Product
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: ListProductsResponse.Products:type_name -> Product
+	7,  // 1: SetKitComponentsRequest.Components:type_name -> KitComponent
+	7,  // 2: Kit.Components:type_name -> KitComponent
+	10, // 3: SetProductUnitsRequest.Units:type_name -> ProductUnit
+	10, // 4: ProductUnits.Units:type_name -> ProductUnit
+	0,  // 5: ProductsService.CreateProduct:input_type -> CreateProductRequest
+	1,  // 6: ProductsService.GetProduct:input_type -> ProductIdRequest
+	3,  // 7: ProductsService.ListProducts:input_type -> ListProductsRequest
+	1,  // 8: ProductsService.DeleteProduct:input_type -> ProductIdRequest
+	6,  // 9: ProductsService.UpdateProduct:input_type -> UpdateProductRequest
+	8,  // 10: ProductsService.SetKitComponents:input_type -> SetKitComponentsRequest
+	1,  // 11: ProductsService.GetKit:input_type -> ProductIdRequest
+	11, // 12: ProductsService.SetProductUnits:input_type -> SetProductUnitsRequest
+	1,  // 13: ProductsService.ListProductUnits:input_type -> ProductIdRequest
+	2,  // 14: ProductsService.CreateProduct:output_type -> Product
+	2,  // 15: ProductsService.GetProduct:output_type -> Product
+	4,  // 16: ProductsService.ListProducts:output_type -> ListProductsResponse
+	5,  // 17: ProductsService.DeleteProduct:output_type -> DeleteProductResponse
+	2,  // 18: ProductsService.UpdateProduct:output_type -> Product
+	9,  // 19: ProductsService.SetKitComponents:output_type -> Kit
+	9,  // 20: ProductsService.GetKit:output_type -> Kit
+	12, // 21: ProductsService.SetProductUnits:output_type -> ProductUnits
+	12, // 22: ProductsService.ListProductUnits:output_type -> ProductUnits
+	14, // [14:23] is the sub-list for method output_type
+	5,  // [5:14] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_products_proto_init() }
@@ -579,7 +1088,7 @@ func file_products_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_products_proto_rawDesc), len(file_products_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductsService_CreateProduct_FullMethodName = "/ProductsService/CreateProduct"
-	ProductsService_GetProduct_FullMethodName    = "/ProductsService/GetProduct"
-	ProductsService_ListProducts_FullMethodName  = "/ProductsService/ListProducts"
-	ProductsService_DeleteProduct_FullMethodName = "/ProductsService/DeleteProduct"
-	ProductsService_UpdateProduct_FullMethodName = "/ProductsService/UpdateProduct"
+	ProductsService_CreateProduct_FullMethodName    = "/ProductsService/CreateProduct"
+	ProductsService_GetProduct_FullMethodName       = "/ProductsService/GetProduct"
+	ProductsService_ListProducts_FullMethodName     = "/ProductsService/ListProducts"
+	ProductsService_DeleteProduct_FullMethodName    = "/ProductsService/DeleteProduct"
+	ProductsService_UpdateProduct_FullMethodName    = "/ProductsService/UpdateProduct"
+	ProductsService_SetKitComponents_FullMethodName = "/ProductsService/SetKitComponents"
+	ProductsService_GetKit_FullMethodName           = "/ProductsService/GetKit"
+	ProductsService_SetProductUnits_FullMethodName  = "/ProductsService/SetProductUnits"
+	ProductsService_ListProductUnits_FullMethodName = "/ProductsService/ListProductUnits"
 )
 
 // ProductsServiceClient is the client API for ProductsService service.
@@ -35,6 +39,10 @@ type ProductsServiceClient interface {
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
 	DeleteProduct(ctx context.Context, in *ProductIdRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*Product, error)
+	SetKitComponents(ctx context.Context, in *SetKitComponentsRequest, opts ...grpc.CallOption) (*Kit, error)
+	GetKit(ctx context.Context, in *ProductIdRequest, opts ...grpc.CallOption) (*Kit, error)
+	SetProductUnits(ctx context.Context, in *SetProductUnitsRequest, opts ...grpc.CallOption) (*ProductUnits, error)
+	ListProductUnits(ctx context.Context, in *ProductIdRequest, opts ...grpc.CallOption) (*ProductUnits, error)
 }
 
 type productsServiceClient struct {
@@ -95,6 +103,46 @@ func (c *productsServiceClient) UpdateProduct(ctx context.Context, in *UpdatePro
 	return out, nil
 }
 
+func (c *productsServiceClient) SetKitComponents(ctx context.Context, in *SetKitComponentsRequest, opts ...grpc.CallOption) (*Kit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Kit)
+	err := c.cc.Invoke(ctx, ProductsService_SetKitComponents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsServiceClient) GetKit(ctx context.Context, in *ProductIdRequest, opts ...grpc.CallOption) (*Kit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Kit)
+	err := c.cc.Invoke(ctx, ProductsService_GetKit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsServiceClient) SetProductUnits(ctx context.Context, in *SetProductUnitsRequest, opts ...grpc.CallOption) (*ProductUnits, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductUnits)
+	err := c.cc.Invoke(ctx, ProductsService_SetProductUnits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsServiceClient) ListProductUnits(ctx context.Context, in *ProductIdRequest, opts ...grpc.CallOption) (*ProductUnits, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductUnits)
+	err := c.cc.Invoke(ctx, ProductsService_ListProductUnits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductsServiceServer is the server API for ProductsService service.
 // All implementations must embed UnimplementedProductsServiceServer
 // for forward compatibility.
@@ -104,6 +152,10 @@ type ProductsServiceServer interface {
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
 	DeleteProduct(context.Context, *ProductIdRequest) (*DeleteProductResponse, error)
 	UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error)
+	SetKitComponents(context.Context, *SetKitComponentsRequest) (*Kit, error)
+	GetKit(context.Context, *ProductIdRequest) (*Kit, error)
+	SetProductUnits(context.Context, *SetProductUnitsRequest) (*ProductUnits, error)
+	ListProductUnits(context.Context, *ProductIdRequest) (*ProductUnits, error)
 	mustEmbedUnimplementedProductsServiceServer()
 }
 
@@ -129,6 +181,18 @@ func (UnimplementedProductsServiceServer) DeleteProduct(context.Context, *Produc
 func (UnimplementedProductsServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
 }
+func (UnimplementedProductsServiceServer) SetKitComponents(context.Context, *SetKitComponentsRequest) (*Kit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKitComponents not implemented")
+}
+func (UnimplementedProductsServiceServer) GetKit(context.Context, *ProductIdRequest) (*Kit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKit not implemented")
+}
+func (UnimplementedProductsServiceServer) SetProductUnits(context.Context, *SetProductUnitsRequest) (*ProductUnits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductUnits not implemented")
+}
+func (UnimplementedProductsServiceServer) ListProductUnits(context.Context, *ProductIdRequest) (*ProductUnits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductUnits not implemented")
+}
 func (UnimplementedProductsServiceServer) mustEmbedUnimplementedProductsServiceServer() {}
 func (UnimplementedProductsServiceServer) testEmbeddedByValue()                         {}
 
@@ -240,6 +304,78 @@ func _ProductsService_UpdateProduct_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductsService_SetKitComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKitComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServiceServer).SetKitComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsService_SetKitComponents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServiceServer).SetKitComponents(ctx, req.(*SetKitComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsService_GetKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServiceServer).GetKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsService_GetKit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServiceServer).GetKit(ctx, req.(*ProductIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsService_SetProductUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductUnitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServiceServer).SetProductUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsService_SetProductUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServiceServer).SetProductUnits(ctx, req.(*SetProductUnitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsService_ListProductUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServiceServer).ListProductUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsService_ListProductUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServiceServer).ListProductUnits(ctx, req.(*ProductIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductsService_ServiceDesc is the grpc.ServiceDesc for ProductsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +403,22 @@ var ProductsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProduct",
 			Handler:    _ProductsService_UpdateProduct_Handler,
 		},
+		{
+			MethodName: "SetKitComponents",
+			Handler:    _ProductsService_SetKitComponents_Handler,
+		},
+		{
+			MethodName: "GetKit",
+			Handler:    _ProductsService_GetKit_Handler,
+		},
+		{
+			MethodName: "SetProductUnits",
+			Handler:    _ProductsService_SetProductUnits_Handler,
+		},
+		{
+			MethodName: "ListProductUnits",
+			Handler:    _ProductsService_ListProductUnits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "products.proto",
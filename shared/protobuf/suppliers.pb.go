@@ -0,0 +1,2104 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: suppliers.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Supplier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Supplier) Reset() {
+	*x = Supplier{}
+	mi := &file_suppliers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Supplier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Supplier) ProtoMessage() {}
+
+func (x *Supplier) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Supplier.ProtoReflect.Descriptor instead.
+func (*Supplier) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Supplier) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Supplier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Supplier) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Supplier) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Supplier) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateSupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSupplierRequest) Reset() {
+	*x = CreateSupplierRequest{}
+	mi := &file_suppliers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSupplierRequest) ProtoMessage() {}
+
+func (x *CreateSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSupplierRequest.ProtoReflect.Descriptor instead.
+func (*CreateSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateSupplierRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type SupplierIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupplierIdRequest) Reset() {
+	*x = SupplierIdRequest{}
+	mi := &file_suppliers_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupplierIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplierIdRequest) ProtoMessage() {}
+
+func (x *SupplierIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplierIdRequest.ProtoReflect.Descriptor instead.
+func (*SupplierIdRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SupplierIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListSuppliersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersRequest) Reset() {
+	*x = ListSuppliersRequest{}
+	mi := &file_suppliers_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersRequest) ProtoMessage() {}
+
+func (x *ListSuppliersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersRequest.ProtoReflect.Descriptor instead.
+func (*ListSuppliersRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{3}
+}
+
+type ListSuppliersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suppliers     []*Supplier            `protobuf:"bytes,1,rep,name=Suppliers,proto3" json:"Suppliers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersResponse) Reset() {
+	*x = ListSuppliersResponse{}
+	mi := &file_suppliers_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersResponse) ProtoMessage() {}
+
+func (x *ListSuppliersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersResponse.ProtoReflect.Descriptor instead.
+func (*ListSuppliersResponse) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSuppliersResponse) GetSuppliers() []*Supplier {
+	if x != nil {
+		return x.Suppliers
+	}
+	return nil
+}
+
+type SupplierProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId    int64                  `protobuf:"varint,1,opt,name=SupplierId,proto3" json:"SupplierId,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	SupplierSku   string                 `protobuf:"bytes,3,opt,name=SupplierSku,proto3" json:"SupplierSku,omitempty"`
+	UnitCost      float64                `protobuf:"fixed64,4,opt,name=UnitCost,proto3" json:"UnitCost,omitempty"`
+	PackSize      int64                  `protobuf:"varint,5,opt,name=PackSize,proto3" json:"PackSize,omitempty"` // Units per pack, orders are rounded up to whole packs
+	LeadTimeDays  int64                  `protobuf:"varint,6,opt,name=LeadTimeDays,proto3" json:"LeadTimeDays,omitempty"`
+	Preferred     bool                   `protobuf:"varint,7,opt,name=Preferred,proto3" json:"Preferred,omitempty"` // Used first when proposing replenishment
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupplierProduct) Reset() {
+	*x = SupplierProduct{}
+	mi := &file_suppliers_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupplierProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplierProduct) ProtoMessage() {}
+
+func (x *SupplierProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplierProduct.ProtoReflect.Descriptor instead.
+func (*SupplierProduct) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SupplierProduct) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *SupplierProduct) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SupplierProduct) GetSupplierSku() string {
+	if x != nil {
+		return x.SupplierSku
+	}
+	return ""
+}
+
+func (x *SupplierProduct) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *SupplierProduct) GetPackSize() int64 {
+	if x != nil {
+		return x.PackSize
+	}
+	return 0
+}
+
+func (x *SupplierProduct) GetLeadTimeDays() int64 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *SupplierProduct) GetPreferred() bool {
+	if x != nil {
+		return x.Preferred
+	}
+	return false
+}
+
+type LinkSupplierProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId    int64                  `protobuf:"varint,1,opt,name=SupplierId,proto3" json:"SupplierId,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	SupplierSku   string                 `protobuf:"bytes,3,opt,name=SupplierSku,proto3" json:"SupplierSku,omitempty"`
+	UnitCost      float64                `protobuf:"fixed64,4,opt,name=UnitCost,proto3" json:"UnitCost,omitempty"`
+	PackSize      int64                  `protobuf:"varint,5,opt,name=PackSize,proto3" json:"PackSize,omitempty"` // Optional, defaults to 1
+	LeadTimeDays  int64                  `protobuf:"varint,6,opt,name=LeadTimeDays,proto3" json:"LeadTimeDays,omitempty"`
+	Preferred     bool                   `protobuf:"varint,7,opt,name=Preferred,proto3" json:"Preferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkSupplierProductRequest) Reset() {
+	*x = LinkSupplierProductRequest{}
+	mi := &file_suppliers_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkSupplierProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkSupplierProductRequest) ProtoMessage() {}
+
+func (x *LinkSupplierProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkSupplierProductRequest.ProtoReflect.Descriptor instead.
+func (*LinkSupplierProductRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LinkSupplierProductRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *LinkSupplierProductRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *LinkSupplierProductRequest) GetSupplierSku() string {
+	if x != nil {
+		return x.SupplierSku
+	}
+	return ""
+}
+
+func (x *LinkSupplierProductRequest) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *LinkSupplierProductRequest) GetPackSize() int64 {
+	if x != nil {
+		return x.PackSize
+	}
+	return 0
+}
+
+func (x *LinkSupplierProductRequest) GetLeadTimeDays() int64 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *LinkSupplierProductRequest) GetPreferred() bool {
+	if x != nil {
+		return x.Preferred
+	}
+	return false
+}
+
+type ListSupplierProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId    int64                  `protobuf:"varint,1,opt,name=SupplierId,proto3" json:"SupplierId,omitempty"` // Optional filter by supplier
+	ProductId     int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`   // Optional filter by product
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSupplierProductsRequest) Reset() {
+	*x = ListSupplierProductsRequest{}
+	mi := &file_suppliers_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSupplierProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupplierProductsRequest) ProtoMessage() {}
+
+func (x *ListSupplierProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupplierProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListSupplierProductsRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSupplierProductsRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *ListSupplierProductsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type ListSupplierProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*SupplierProduct     `protobuf:"bytes,1,rep,name=Products,proto3" json:"Products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSupplierProductsResponse) Reset() {
+	*x = ListSupplierProductsResponse{}
+	mi := &file_suppliers_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSupplierProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupplierProductsResponse) ProtoMessage() {}
+
+func (x *ListSupplierProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupplierProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListSupplierProductsResponse) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSupplierProductsResponse) GetProducts() []*SupplierProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type ReplenishmentLine struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId       int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	SupplierSku     string                 `protobuf:"bytes,3,opt,name=SupplierSku,proto3" json:"SupplierSku,omitempty"`
+	StockQuantity   int64                  `protobuf:"varint,4,opt,name=StockQuantity,proto3" json:"StockQuantity,omitempty"`
+	OnOrderQuantity int64                  `protobuf:"varint,5,opt,name=OnOrderQuantity,proto3" json:"OnOrderQuantity,omitempty"` // Already on open purchase orders
+	ReorderLevel    int64                  `protobuf:"varint,6,opt,name=ReorderLevel,proto3" json:"ReorderLevel,omitempty"`
+	ReorderQuantity int64                  `protobuf:"varint,7,opt,name=ReorderQuantity,proto3" json:"ReorderQuantity,omitempty"`
+	PackSize        int64                  `protobuf:"varint,8,opt,name=PackSize,proto3" json:"PackSize,omitempty"`
+	Packs           int64                  `protobuf:"varint,9,opt,name=Packs,proto3" json:"Packs,omitempty"`
+	Quantity        int64                  `protobuf:"varint,10,opt,name=Quantity,proto3" json:"Quantity,omitempty"` // Packs * PackSize
+	UnitCost        float64                `protobuf:"fixed64,11,opt,name=UnitCost,proto3" json:"UnitCost,omitempty"`
+	PurchaseOrderId int64                  `protobuf:"varint,12,opt,name=PurchaseOrderId,proto3" json:"PurchaseOrderId,omitempty"` // Set once the line is approved
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReplenishmentLine) Reset() {
+	*x = ReplenishmentLine{}
+	mi := &file_suppliers_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplenishmentLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplenishmentLine) ProtoMessage() {}
+
+func (x *ReplenishmentLine) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplenishmentLine.ProtoReflect.Descriptor instead.
+func (*ReplenishmentLine) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReplenishmentLine) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReplenishmentLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReplenishmentLine) GetSupplierSku() string {
+	if x != nil {
+		return x.SupplierSku
+	}
+	return ""
+}
+
+func (x *ReplenishmentLine) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *ReplenishmentLine) GetOnOrderQuantity() int64 {
+	if x != nil {
+		return x.OnOrderQuantity
+	}
+	return 0
+}
+
+func (x *ReplenishmentLine) GetReorderLevel() int64 {
+	if x != nil {
+		return x.ReorderLevel
+	}
+	return 0
+}
+
+func (x *ReplenishmentLine) GetReorderQuantity() int64 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *ReplenishmentLine) GetPackSize() int64 {
+	if x != nil {
+		return x.PackSize
+	}
+	return 0
+}
+
+func (x *ReplenishmentLine) GetPacks() int64 {
+	if x != nil {
+		return x.Packs
+	}
+	return 0
+}
+
+func (x *ReplenishmentLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReplenishmentLine) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *ReplenishmentLine) GetPurchaseOrderId() int64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+type ReplenishmentGroup struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId      int64                  `protobuf:"varint,1,opt,name=SupplierId,proto3" json:"SupplierId,omitempty"` // 0 for products without a linked supplier
+	SupplierName    string                 `protobuf:"bytes,2,opt,name=SupplierName,proto3" json:"SupplierName,omitempty"`
+	LeadTimeDays    int64                  `protobuf:"varint,3,opt,name=LeadTimeDays,proto3" json:"LeadTimeDays,omitempty"` // Longest lead time in the group
+	TotalCost       float64                `protobuf:"fixed64,4,opt,name=TotalCost,proto3" json:"TotalCost,omitempty"`
+	PurchaseOrderId int64                  `protobuf:"varint,5,opt,name=PurchaseOrderId,proto3" json:"PurchaseOrderId,omitempty"`
+	Lines           []*ReplenishmentLine   `protobuf:"bytes,6,rep,name=Lines,proto3" json:"Lines,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReplenishmentGroup) Reset() {
+	*x = ReplenishmentGroup{}
+	mi := &file_suppliers_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplenishmentGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplenishmentGroup) ProtoMessage() {}
+
+func (x *ReplenishmentGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplenishmentGroup.ProtoReflect.Descriptor instead.
+func (*ReplenishmentGroup) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReplenishmentGroup) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *ReplenishmentGroup) GetSupplierName() string {
+	if x != nil {
+		return x.SupplierName
+	}
+	return ""
+}
+
+func (x *ReplenishmentGroup) GetLeadTimeDays() int64 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *ReplenishmentGroup) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *ReplenishmentGroup) GetPurchaseOrderId() int64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+func (x *ReplenishmentGroup) GetLines() []*ReplenishmentLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type ReplenishmentProposal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"` // open, approved or superseded
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	ApprovedAt    string                 `protobuf:"bytes,4,opt,name=ApprovedAt,proto3" json:"ApprovedAt,omitempty"`
+	Groups        []*ReplenishmentGroup  `protobuf:"bytes,5,rep,name=Groups,proto3" json:"Groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplenishmentProposal) Reset() {
+	*x = ReplenishmentProposal{}
+	mi := &file_suppliers_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplenishmentProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplenishmentProposal) ProtoMessage() {}
+
+func (x *ReplenishmentProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplenishmentProposal.ProtoReflect.Descriptor instead.
+func (*ReplenishmentProposal) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReplenishmentProposal) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReplenishmentProposal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReplenishmentProposal) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ReplenishmentProposal) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+func (x *ReplenishmentProposal) GetGroups() []*ReplenishmentGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type CreateReplenishmentProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []int64                `protobuf:"varint,1,rep,packed,name=ProductIds,proto3" json:"ProductIds,omitempty"` // Optional, defaults to every product
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReplenishmentProposalRequest) Reset() {
+	*x = CreateReplenishmentProposalRequest{}
+	mi := &file_suppliers_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReplenishmentProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReplenishmentProposalRequest) ProtoMessage() {}
+
+func (x *CreateReplenishmentProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReplenishmentProposalRequest.ProtoReflect.Descriptor instead.
+func (*CreateReplenishmentProposalRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateReplenishmentProposalRequest) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type ReplenishmentProposalIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplenishmentProposalIdRequest) Reset() {
+	*x = ReplenishmentProposalIdRequest{}
+	mi := &file_suppliers_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplenishmentProposalIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplenishmentProposalIdRequest) ProtoMessage() {}
+
+func (x *ReplenishmentProposalIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplenishmentProposalIdRequest.ProtoReflect.Descriptor instead.
+func (*ReplenishmentProposalIdRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReplenishmentProposalIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ApproveReplenishmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	SupplierIds   []int64                `protobuf:"varint,2,rep,packed,name=SupplierIds,proto3" json:"SupplierIds,omitempty"` // Optional, defaults to every supplier in the proposal
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveReplenishmentRequest) Reset() {
+	*x = ApproveReplenishmentRequest{}
+	mi := &file_suppliers_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveReplenishmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveReplenishmentRequest) ProtoMessage() {}
+
+func (x *ApproveReplenishmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveReplenishmentRequest.ProtoReflect.Descriptor instead.
+func (*ApproveReplenishmentRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApproveReplenishmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApproveReplenishmentRequest) GetSupplierIds() []int64 {
+	if x != nil {
+		return x.SupplierIds
+	}
+	return nil
+}
+
+type ApproveReplenishmentResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Proposal       *ReplenishmentProposal `protobuf:"bytes,1,opt,name=Proposal,proto3" json:"Proposal,omitempty"`
+	PurchaseOrders []*PurchaseOrder       `protobuf:"bytes,2,rep,name=PurchaseOrders,proto3" json:"PurchaseOrders,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApproveReplenishmentResponse) Reset() {
+	*x = ApproveReplenishmentResponse{}
+	mi := &file_suppliers_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveReplenishmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveReplenishmentResponse) ProtoMessage() {}
+
+func (x *ApproveReplenishmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveReplenishmentResponse.ProtoReflect.Descriptor instead.
+func (*ApproveReplenishmentResponse) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ApproveReplenishmentResponse) GetProposal() *ReplenishmentProposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+func (x *ApproveReplenishmentResponse) GetPurchaseOrders() []*PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrders
+	}
+	return nil
+}
+
+type PurchaseOrderLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId        int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	SupplierSku      string                 `protobuf:"bytes,3,opt,name=SupplierSku,proto3" json:"SupplierSku,omitempty"`
+	Quantity         int64                  `protobuf:"varint,4,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	UnitCost         float64                `protobuf:"fixed64,5,opt,name=UnitCost,proto3" json:"UnitCost,omitempty"`
+	ReceivedQuantity int64                  `protobuf:"varint,6,opt,name=ReceivedQuantity,proto3" json:"ReceivedQuantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderLine) Reset() {
+	*x = PurchaseOrderLine{}
+	mi := &file_suppliers_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderLine) ProtoMessage() {}
+
+func (x *PurchaseOrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderLine.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderLine) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PurchaseOrderLine) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetSupplierSku() string {
+	if x != nil {
+		return x.SupplierSku
+	}
+	return ""
+}
+
+func (x *PurchaseOrderLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetReceivedQuantity() int64 {
+	if x != nil {
+		return x.ReceivedQuantity
+	}
+	return 0
+}
+
+type PurchaseOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Number        string                 `protobuf:"bytes,2,opt,name=Number,proto3" json:"Number,omitempty"`
+	SupplierId    int64                  `protobuf:"varint,3,opt,name=SupplierId,proto3" json:"SupplierId,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"`          // draft, sent, partially_received, received or closed
+	ProposalId    int64                  `protobuf:"varint,5,opt,name=ProposalId,proto3" json:"ProposalId,omitempty"` // Replenishment proposal the order was approved from
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Lines         []*PurchaseOrderLine   `protobuf:"bytes,7,rep,name=Lines,proto3" json:"Lines,omitempty"` // Not filled in by ListPurchaseOrders
+	Note          string                 `protobuf:"bytes,8,opt,name=Note,proto3" json:"Note,omitempty"`
+	SentAt        string                 `protobuf:"bytes,9,opt,name=SentAt,proto3" json:"SentAt,omitempty"`
+	ExpectedAt    string                 `protobuf:"bytes,10,opt,name=ExpectedAt,proto3" json:"ExpectedAt,omitempty"` // Sent date plus the longest supplier lead time
+	ClosedAt      string                 `protobuf:"bytes,11,opt,name=ClosedAt,proto3" json:"ClosedAt,omitempty"`
+	Receipts      []*GoodsReceipt        `protobuf:"bytes,12,rep,name=Receipts,proto3" json:"Receipts,omitempty"` // Not filled in by ListPurchaseOrders
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrder) Reset() {
+	*x = PurchaseOrder{}
+	mi := &file_suppliers_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrder) ProtoMessage() {}
+
+func (x *PurchaseOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrder.ProtoReflect.Descriptor instead.
+func (*PurchaseOrder) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PurchaseOrder) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetProposalId() int64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetLines() []*PurchaseOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetSentAt() string {
+	if x != nil {
+		return x.SentAt
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetExpectedAt() string {
+	if x != nil {
+		return x.ExpectedAt
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetReceipts() []*GoodsReceipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+type PurchaseOrderLineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	UnitCost      float64                `protobuf:"fixed64,3,opt,name=UnitCost,proto3" json:"UnitCost,omitempty"` // Optional, defaults to the supplier's cost
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderLineRequest) Reset() {
+	*x = PurchaseOrderLineRequest{}
+	mi := &file_suppliers_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderLineRequest) ProtoMessage() {}
+
+func (x *PurchaseOrderLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderLineRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderLineRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PurchaseOrderLineRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLineRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLineRequest) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+type CreatePurchaseOrderRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	SupplierId    int64                       `protobuf:"varint,1,opt,name=SupplierId,proto3" json:"SupplierId,omitempty"`
+	Note          string                      `protobuf:"bytes,2,opt,name=Note,proto3" json:"Note,omitempty"`
+	Lines         []*PurchaseOrderLineRequest `protobuf:"bytes,3,rep,name=Lines,proto3" json:"Lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseOrderRequest) Reset() {
+	*x = CreatePurchaseOrderRequest{}
+	mi := &file_suppliers_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *CreatePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreatePurchaseOrderRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *CreatePurchaseOrderRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreatePurchaseOrderRequest) GetLines() []*PurchaseOrderLineRequest {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type GoodsReceiptLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        int64                  `protobuf:"varint,1,opt,name=LineId,proto3" json:"LineId,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	MovementId    int64                  `protobuf:"varint,4,opt,name=MovementId,proto3" json:"MovementId,omitempty"` // Supply movement posted in the inventory service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsReceiptLine) Reset() {
+	*x = GoodsReceiptLine{}
+	mi := &file_suppliers_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsReceiptLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsReceiptLine) ProtoMessage() {}
+
+func (x *GoodsReceiptLine) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsReceiptLine.ProtoReflect.Descriptor instead.
+func (*GoodsReceiptLine) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GoodsReceiptLine) GetLineId() int64 {
+	if x != nil {
+		return x.LineId
+	}
+	return 0
+}
+
+func (x *GoodsReceiptLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *GoodsReceiptLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GoodsReceiptLine) GetMovementId() int64 {
+	if x != nil {
+		return x.MovementId
+	}
+	return 0
+}
+
+type GoodsReceipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	LocationId    int64                  `protobuf:"varint,2,opt,name=LocationId,proto3" json:"LocationId,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=Note,proto3" json:"Note,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Lines         []*GoodsReceiptLine    `protobuf:"bytes,5,rep,name=Lines,proto3" json:"Lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsReceipt) Reset() {
+	*x = GoodsReceipt{}
+	mi := &file_suppliers_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsReceipt) ProtoMessage() {}
+
+func (x *GoodsReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsReceipt.ProtoReflect.Descriptor instead.
+func (*GoodsReceipt) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GoodsReceipt) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsReceipt) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *GoodsReceipt) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *GoodsReceipt) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GoodsReceipt) GetLines() []*GoodsReceiptLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type ReceiptLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        int64                  `protobuf:"varint,1,opt,name=LineId,proto3" json:"LineId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	LotNumber     string                 `protobuf:"bytes,3,opt,name=LotNumber,proto3" json:"LotNumber,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`         // YYYY-MM-DD
+	SerialNumbers []string               `protobuf:"bytes,5,rep,name=SerialNumbers,proto3" json:"SerialNumbers,omitempty"` // Required for serialized products, one per unit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptLine) Reset() {
+	*x = ReceiptLine{}
+	mi := &file_suppliers_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptLine) ProtoMessage() {}
+
+func (x *ReceiptLine) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptLine.ProtoReflect.Descriptor instead.
+func (*ReceiptLine) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReceiptLine) GetLineId() int64 {
+	if x != nil {
+		return x.LineId
+	}
+	return 0
+}
+
+func (x *ReceiptLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReceiptLine) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *ReceiptLine) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ReceiptLine) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+type ReceivePurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	LocationId    int64                  `protobuf:"varint,2,opt,name=LocationId,proto3" json:"LocationId,omitempty"` // Optional, defaults to the main location
+	Note          string                 `protobuf:"bytes,3,opt,name=Note,proto3" json:"Note,omitempty"`
+	Lines         []*ReceiptLine         `protobuf:"bytes,4,rep,name=Lines,proto3" json:"Lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceivePurchaseOrderRequest) Reset() {
+	*x = ReceivePurchaseOrderRequest{}
+	mi := &file_suppliers_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *ReceivePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReceivePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReceivePurchaseOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReceivePurchaseOrderRequest) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *ReceivePurchaseOrderRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ReceivePurchaseOrderRequest) GetLines() []*ReceiptLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type PurchaseOrderIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderIdRequest) Reset() {
+	*x = PurchaseOrderIdRequest{}
+	mi := &file_suppliers_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderIdRequest) ProtoMessage() {}
+
+func (x *PurchaseOrderIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderIdRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderIdRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PurchaseOrderIdRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListPurchaseOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId    int64                  `protobuf:"varint,1,opt,name=SupplierId,proto3" json:"SupplierId,omitempty"` // Optional filter by supplier
+	Status        string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`          // Optional filter by status
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersRequest) Reset() {
+	*x = ListPurchaseOrdersRequest{}
+	mi := &file_suppliers_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersRequest) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPurchaseOrdersRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *ListPurchaseOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListPurchaseOrdersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrders []*PurchaseOrder       `protobuf:"bytes,1,rep,name=PurchaseOrders,proto3" json:"PurchaseOrders,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersResponse) Reset() {
+	*x = ListPurchaseOrdersResponse{}
+	mi := &file_suppliers_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersResponse) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_suppliers_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_suppliers_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListPurchaseOrdersResponse) GetPurchaseOrders() []*PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrders
+	}
+	return nil
+}
+
+var File_suppliers_proto protoreflect.FileDescriptor
+
+const file_suppliers_proto_rawDesc = "" +
+	"\n" +
+	"\x0fsuppliers.proto\"x\n" +
+	"\bSupplier\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x14\n" +
+	"\x05Email\x18\x03 \x01(\tR\x05Email\x12\x14\n" +
+	"\x05Phone\x18\x04 \x01(\tR\x05Phone\x12\x1c\n" +
+	"\tCreatedAt\x18\x05 \x01(\tR\tCreatedAt\"W\n" +
+	"\x15CreateSupplierRequest\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x14\n" +
+	"\x05Email\x18\x02 \x01(\tR\x05Email\x12\x14\n" +
+	"\x05Phone\x18\x03 \x01(\tR\x05Phone\"#\n" +
+	"\x11SupplierIdRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"\x16\n" +
+	"\x14ListSuppliersRequest\"@\n" +
+	"\x15ListSuppliersResponse\x12'\n" +
+	"\tSuppliers\x18\x01 \x03(\v2\t.SupplierR\tSuppliers\"\xeb\x01\n" +
+	"\x0fSupplierProduct\x12\x1e\n" +
+	"\n" +
+	"SupplierId\x18\x01 \x01(\x03R\n" +
+	"SupplierId\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12 \n" +
+	"\vSupplierSku\x18\x03 \x01(\tR\vSupplierSku\x12\x1a\n" +
+	"\bUnitCost\x18\x04 \x01(\x01R\bUnitCost\x12\x1a\n" +
+	"\bPackSize\x18\x05 \x01(\x03R\bPackSize\x12\"\n" +
+	"\fLeadTimeDays\x18\x06 \x01(\x03R\fLeadTimeDays\x12\x1c\n" +
+	"\tPreferred\x18\a \x01(\bR\tPreferred\"\xf6\x01\n" +
+	"\x1aLinkSupplierProductRequest\x12\x1e\n" +
+	"\n" +
+	"SupplierId\x18\x01 \x01(\x03R\n" +
+	"SupplierId\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12 \n" +
+	"\vSupplierSku\x18\x03 \x01(\tR\vSupplierSku\x12\x1a\n" +
+	"\bUnitCost\x18\x04 \x01(\x01R\bUnitCost\x12\x1a\n" +
+	"\bPackSize\x18\x05 \x01(\x03R\bPackSize\x12\"\n" +
+	"\fLeadTimeDays\x18\x06 \x01(\x03R\fLeadTimeDays\x12\x1c\n" +
+	"\tPreferred\x18\a \x01(\bR\tPreferred\"[\n" +
+	"\x1bListSupplierProductsRequest\x12\x1e\n" +
+	"\n" +
+	"SupplierId\x18\x01 \x01(\x03R\n" +
+	"SupplierId\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\"L\n" +
+	"\x1cListSupplierProductsResponse\x12,\n" +
+	"\bProducts\x18\x01 \x03(\v2\x10.SupplierProductR\bProducts\"\x95\x03\n" +
+	"\x11ReplenishmentLine\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12 \n" +
+	"\vSupplierSku\x18\x03 \x01(\tR\vSupplierSku\x12$\n" +
+	"\rStockQuantity\x18\x04 \x01(\x03R\rStockQuantity\x12(\n" +
+	"\x0fOnOrderQuantity\x18\x05 \x01(\x03R\x0fOnOrderQuantity\x12\"\n" +
+	"\fReorderLevel\x18\x06 \x01(\x03R\fReorderLevel\x12(\n" +
+	"\x0fReorderQuantity\x18\a \x01(\x03R\x0fReorderQuantity\x12\x1a\n" +
+	"\bPackSize\x18\b \x01(\x03R\bPackSize\x12\x14\n" +
+	"\x05Packs\x18\t \x01(\x03R\x05Packs\x12\x1a\n" +
+	"\bQuantity\x18\n" +
+	" \x01(\x03R\bQuantity\x12\x1a\n" +
+	"\bUnitCost\x18\v \x01(\x01R\bUnitCost\x12(\n" +
+	"\x0fPurchaseOrderId\x18\f \x01(\x03R\x0fPurchaseOrderId\"\xee\x01\n" +
+	"\x12ReplenishmentGroup\x12\x1e\n" +
+	"\n" +
+	"SupplierId\x18\x01 \x01(\x03R\n" +
+	"SupplierId\x12\"\n" +
+	"\fSupplierName\x18\x02 \x01(\tR\fSupplierName\x12\"\n" +
+	"\fLeadTimeDays\x18\x03 \x01(\x03R\fLeadTimeDays\x12\x1c\n" +
+	"\tTotalCost\x18\x04 \x01(\x01R\tTotalCost\x12(\n" +
+	"\x0fPurchaseOrderId\x18\x05 \x01(\x03R\x0fPurchaseOrderId\x12(\n" +
+	"\x05Lines\x18\x06 \x03(\v2\x12.ReplenishmentLineR\x05Lines\"\xaa\x01\n" +
+	"\x15ReplenishmentProposal\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x16\n" +
+	"\x06Status\x18\x02 \x01(\tR\x06Status\x12\x1c\n" +
+	"\tCreatedAt\x18\x03 \x01(\tR\tCreatedAt\x12\x1e\n" +
+	"\n" +
+	"ApprovedAt\x18\x04 \x01(\tR\n" +
+	"ApprovedAt\x12+\n" +
+	"\x06Groups\x18\x05 \x03(\v2\x13.ReplenishmentGroupR\x06Groups\"D\n" +
+	"\"CreateReplenishmentProposalRequest\x12\x1e\n" +
+	"\n" +
+	"ProductIds\x18\x01 \x03(\x03R\n" +
+	"ProductIds\"0\n" +
+	"\x1eReplenishmentProposalIdRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"O\n" +
+	"\x1bApproveReplenishmentRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12 \n" +
+	"\vSupplierIds\x18\x02 \x03(\x03R\vSupplierIds\"\x8a\x01\n" +
+	"\x1cApproveReplenishmentResponse\x122\n" +
+	"\bProposal\x18\x01 \x01(\v2\x16.ReplenishmentProposalR\bProposal\x126\n" +
+	"\x0ePurchaseOrders\x18\x02 \x03(\v2\x0e.PurchaseOrderR\x0ePurchaseOrders\"\xc7\x01\n" +
+	"\x11PurchaseOrderLine\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12 \n" +
+	"\vSupplierSku\x18\x03 \x01(\tR\vSupplierSku\x12\x1a\n" +
+	"\bQuantity\x18\x04 \x01(\x03R\bQuantity\x12\x1a\n" +
+	"\bUnitCost\x18\x05 \x01(\x01R\bUnitCost\x12*\n" +
+	"\x10ReceivedQuantity\x18\x06 \x01(\x03R\x10ReceivedQuantity\"\xea\x02\n" +
+	"\rPurchaseOrder\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x16\n" +
+	"\x06Number\x18\x02 \x01(\tR\x06Number\x12\x1e\n" +
+	"\n" +
+	"SupplierId\x18\x03 \x01(\x03R\n" +
+	"SupplierId\x12\x16\n" +
+	"\x06Status\x18\x04 \x01(\tR\x06Status\x12\x1e\n" +
+	"\n" +
+	"ProposalId\x18\x05 \x01(\x03R\n" +
+	"ProposalId\x12\x1c\n" +
+	"\tCreatedAt\x18\x06 \x01(\tR\tCreatedAt\x12(\n" +
+	"\x05Lines\x18\a \x03(\v2\x12.PurchaseOrderLineR\x05Lines\x12\x12\n" +
+	"\x04Note\x18\b \x01(\tR\x04Note\x12\x16\n" +
+	"\x06SentAt\x18\t \x01(\tR\x06SentAt\x12\x1e\n" +
+	"\n" +
+	"ExpectedAt\x18\n" +
+	" \x01(\tR\n" +
+	"ExpectedAt\x12\x1a\n" +
+	"\bClosedAt\x18\v \x01(\tR\bClosedAt\x12)\n" +
+	"\bReceipts\x18\f \x03(\v2\r.GoodsReceiptR\bReceipts\"p\n" +
+	"\x18PurchaseOrderLineRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1a\n" +
+	"\bUnitCost\x18\x03 \x01(\x01R\bUnitCost\"\x81\x01\n" +
+	"\x1aCreatePurchaseOrderRequest\x12\x1e\n" +
+	"\n" +
+	"SupplierId\x18\x01 \x01(\x03R\n" +
+	"SupplierId\x12\x12\n" +
+	"\x04Note\x18\x02 \x01(\tR\x04Note\x12/\n" +
+	"\x05Lines\x18\x03 \x03(\v2\x19.PurchaseOrderLineRequestR\x05Lines\"\x84\x01\n" +
+	"\x10GoodsReceiptLine\x12\x16\n" +
+	"\x06LineId\x18\x01 \x01(\x03R\x06LineId\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\x03R\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x03 \x01(\x03R\bQuantity\x12\x1e\n" +
+	"\n" +
+	"MovementId\x18\x04 \x01(\x03R\n" +
+	"MovementId\"\x99\x01\n" +
+	"\fGoodsReceipt\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x02 \x01(\x03R\n" +
+	"LocationId\x12\x12\n" +
+	"\x04Note\x18\x03 \x01(\tR\x04Note\x12\x1c\n" +
+	"\tCreatedAt\x18\x04 \x01(\tR\tCreatedAt\x12'\n" +
+	"\x05Lines\x18\x05 \x03(\v2\x11.GoodsReceiptLineR\x05Lines\"\xa3\x01\n" +
+	"\vReceiptLine\x12\x16\n" +
+	"\x06LineId\x18\x01 \x01(\x03R\x06LineId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1c\n" +
+	"\tLotNumber\x18\x03 \x01(\tR\tLotNumber\x12\x1c\n" +
+	"\tExpiresAt\x18\x04 \x01(\tR\tExpiresAt\x12$\n" +
+	"\rSerialNumbers\x18\x05 \x03(\tR\rSerialNumbers\"\x85\x01\n" +
+	"\x1bReceivePurchaseOrderRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1e\n" +
+	"\n" +
+	"LocationId\x18\x02 \x01(\x03R\n" +
+	"LocationId\x12\x12\n" +
+	"\x04Note\x18\x03 \x01(\tR\x04Note\x12\"\n" +
+	"\x05Lines\x18\x04 \x03(\v2\f.ReceiptLineR\x05Lines\"(\n" +
+	"\x16PurchaseOrderIdRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"S\n" +
+	"\x19ListPurchaseOrdersRequest\x12\x1e\n" +
+	"\n" +
+	"SupplierId\x18\x01 \x01(\x03R\n" +
+	"SupplierId\x12\x16\n" +
+	"\x06Status\x18\x02 \x01(\tR\x06Status\"T\n" +
+	"\x1aListPurchaseOrdersResponse\x126\n" +
+	"\x0ePurchaseOrders\x18\x01 \x03(\v2\x0e.PurchaseOrderR\x0ePurchaseOrders2\xf1\a\n" +
+	"\x10SuppliersService\x123\n" +
+	"\x0eCreateSupplier\x12\x16.CreateSupplierRequest\x1a\t.Supplier\x12,\n" +
+	"\vGetSupplier\x12\x12.SupplierIdRequest\x1a\t.Supplier\x12>\n" +
+	"\rListSuppliers\x12\x15.ListSuppliersRequest\x1a\x16.ListSuppliersResponse\x12D\n" +
+	"\x13LinkSupplierProduct\x12\x1b.LinkSupplierProductRequest\x1a\x10.SupplierProduct\x12S\n" +
+	"\x14ListSupplierProducts\x12\x1c.ListSupplierProductsRequest\x1a\x1d.ListSupplierProductsResponse\x12Z\n" +
+	"\x1bCreateReplenishmentProposal\x12#.CreateReplenishmentProposalRequest\x1a\x16.ReplenishmentProposal\x12S\n" +
+	"\x18GetReplenishmentProposal\x12\x1f.ReplenishmentProposalIdRequest\x1a\x16.ReplenishmentProposal\x12[\n" +
+	"\x1cApproveReplenishmentProposal\x12\x1c.ApproveReplenishmentRequest\x1a\x1d.ApproveReplenishmentResponse\x12B\n" +
+	"\x13CreatePurchaseOrder\x12\x1b.CreatePurchaseOrderRequest\x1a\x0e.PurchaseOrder\x12;\n" +
+	"\x10GetPurchaseOrder\x12\x17.PurchaseOrderIdRequest\x1a\x0e.PurchaseOrder\x12M\n" +
+	"\x12ListPurchaseOrders\x12\x1a.ListPurchaseOrdersRequest\x1a\x1b.ListPurchaseOrdersResponse\x12<\n" +
+	"\x11SendPurchaseOrder\x12\x17.PurchaseOrderIdRequest\x1a\x0e.PurchaseOrder\x12D\n" +
+	"\x14ReceivePurchaseOrder\x12\x1c.ReceivePurchaseOrderRequest\x1a\x0e.PurchaseOrder\x12=\n" +
+	"\x12ClosePurchaseOrder\x12\x17.PurchaseOrderIdRequest\x1a\x0e.PurchaseOrderB3Z1github.com/logan2k02/ims/shared/protobuf;protobufb\x06proto3"
+
+var (
+	file_suppliers_proto_rawDescOnce sync.Once
+	file_suppliers_proto_rawDescData []byte
+)
+
+func file_suppliers_proto_rawDescGZIP() []byte {
+	file_suppliers_proto_rawDescOnce.Do(func() {
+		file_suppliers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_suppliers_proto_rawDesc), len(file_suppliers_proto_rawDesc)))
+	})
+	return file_suppliers_proto_rawDescData
+}
+
+var file_suppliers_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_suppliers_proto_goTypes = []any{
+	(*Supplier)(nil),                           // 0: Supplier
+	(*CreateSupplierRequest)(nil),              // 1: CreateSupplierRequest
+	(*SupplierIdRequest)(nil),                  // 2: SupplierIdRequest
+	(*ListSuppliersRequest)(nil),               // 3: ListSuppliersRequest
+	(*ListSuppliersResponse)(nil),              // 4: ListSuppliersResponse
+	(*SupplierProduct)(nil),                    // 5: SupplierProduct
+	(*LinkSupplierProductRequest)(nil),         // 6: LinkSupplierProductRequest
+	(*ListSupplierProductsRequest)(nil),        // 7: ListSupplierProductsRequest
+	(*ListSupplierProductsResponse)(nil),       // 8: ListSupplierProductsResponse
+	(*ReplenishmentLine)(nil),                  // 9: ReplenishmentLine
+	(*ReplenishmentGroup)(nil),                 // 10: ReplenishmentGroup
+	(*ReplenishmentProposal)(nil),              // 11: ReplenishmentProposal
+	(*CreateReplenishmentProposalRequest)(nil), // 12: CreateReplenishmentProposalRequest
+	(*ReplenishmentProposalIdRequest)(nil),     // 13: ReplenishmentProposalIdRequest
+	(*ApproveReplenishmentRequest)(nil),        // 14: ApproveReplenishmentRequest
+	(*ApproveReplenishmentResponse)(nil),       // 15: ApproveReplenishmentResponse
+	(*PurchaseOrderLine)(nil),                  // 16: PurchaseOrderLine
+	(*PurchaseOrder)(nil),                      // 17: PurchaseOrder
+	(*PurchaseOrderLineRequest)(nil),           // 18: PurchaseOrderLineRequest
+	(*CreatePurchaseOrderRequest)(nil),         // 19: CreatePurchaseOrderRequest
+	(*GoodsReceiptLine)(nil),                   // 20: GoodsReceiptLine
+	(*GoodsReceipt)(nil),                       // 21: GoodsReceipt
+	(*ReceiptLine)(nil),                        // 22: ReceiptLine
+	(*ReceivePurchaseOrderRequest)(nil),        // 23: ReceivePurchaseOrderRequest
+	(*PurchaseOrderIdRequest)(nil),             // 24: PurchaseOrderIdRequest
+	(*ListPurchaseOrdersRequest)(nil),          // 25: ListPurchaseOrdersRequest
+	(*ListPurchaseOrdersResponse)(nil),         // 26: ListPurchaseOrdersResponse
+}
+var file_suppliers_proto_depIdxs = []int32{
+	0,  // 0: ListSuppliersResponse.Suppliers:type_name -> Supplier
+	5,  // 1: ListSupplierProductsResponse.Products:type_name -> SupplierProduct
+	9,  // 2: ReplenishmentGroup.Lines:type_name -> ReplenishmentLine
+	10, // 3: ReplenishmentProposal.Groups:type_name -> ReplenishmentGroup
+	11, // 4: ApproveReplenishmentResponse.Proposal:type_name -> ReplenishmentProposal
+	17, // 5: ApproveReplenishmentResponse.PurchaseOrders:type_name -> PurchaseOrder
+	16, // 6: PurchaseOrder.Lines:type_name -> PurchaseOrderLine
+	21, // 7: PurchaseOrder.Receipts:type_name -> GoodsReceipt
+	18, // 8: CreatePurchaseOrderRequest.Lines:type_name -> PurchaseOrderLineRequest
+	20, // 9: GoodsReceipt.Lines:type_name -> GoodsReceiptLine
+	22, // 10: ReceivePurchaseOrderRequest.Lines:type_name -> ReceiptLine
+	17, // 11: ListPurchaseOrdersResponse.PurchaseOrders:type_name -> PurchaseOrder
+	1,  // 12: SuppliersService.CreateSupplier:input_type -> CreateSupplierRequest
+	2,  // 13: SuppliersService.GetSupplier:input_type -> SupplierIdRequest
+	3,  // 14: SuppliersService.ListSuppliers:input_type -> ListSuppliersRequest
+	6,  // 15: SuppliersService.LinkSupplierProduct:input_type -> LinkSupplierProductRequest
+	7,  // 16: SuppliersService.ListSupplierProducts:input_type -> ListSupplierProductsRequest
+	12, // 17: SuppliersService.CreateReplenishmentProposal:input_type -> CreateReplenishmentProposalRequest
+	13, // 18: SuppliersService.GetReplenishmentProposal:input_type -> ReplenishmentProposalIdRequest
+	14, // 19: SuppliersService.ApproveReplenishmentProposal:input_type -> ApproveReplenishmentRequest
+	19, // 20: SuppliersService.CreatePurchaseOrder:input_type -> CreatePurchaseOrderRequest
+	24, // 21: SuppliersService.GetPurchaseOrder:input_type -> PurchaseOrderIdRequest
+	25, // 22: SuppliersService.ListPurchaseOrders:input_type -> ListPurchaseOrdersRequest
+	24, // 23: SuppliersService.SendPurchaseOrder:input_type -> PurchaseOrderIdRequest
+	23, // 24: SuppliersService.ReceivePurchaseOrder:input_type -> ReceivePurchaseOrderRequest
+	24, // 25: SuppliersService.ClosePurchaseOrder:input_type -> PurchaseOrderIdRequest
+	0,  // 26: SuppliersService.CreateSupplier:output_type -> Supplier
+	0,  // 27: SuppliersService.GetSupplier:output_type -> Supplier
+	4,  // 28: SuppliersService.ListSuppliers:output_type -> ListSuppliersResponse
+	5,  // 29: SuppliersService.LinkSupplierProduct:output_type -> SupplierProduct
+	8,  // 30: SuppliersService.ListSupplierProducts:output_type -> ListSupplierProductsResponse
+	11, // 31: SuppliersService.CreateReplenishmentProposal:output_type -> ReplenishmentProposal
+	11, // 32: SuppliersService.GetReplenishmentProposal:output_type -> ReplenishmentProposal
+	15, // 33: SuppliersService.ApproveReplenishmentProposal:output_type -> ApproveReplenishmentResponse
+	17, // 34: SuppliersService.CreatePurchaseOrder:output_type -> PurchaseOrder
+	17, // 35: SuppliersService.GetPurchaseOrder:output_type -> PurchaseOrder
+	26, // 36: SuppliersService.ListPurchaseOrders:output_type -> ListPurchaseOrdersResponse
+	17, // 37: SuppliersService.SendPurchaseOrder:output_type -> PurchaseOrder
+	17, // 38: SuppliersService.ReceivePurchaseOrder:output_type -> PurchaseOrder
+	17, // 39: SuppliersService.ClosePurchaseOrder:output_type -> PurchaseOrder
+	26, // [26:40] is the sub-list for method output_type
+	12, // [12:26] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_suppliers_proto_init() }
+func file_suppliers_proto_init() {
+	if File_suppliers_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_suppliers_proto_rawDesc), len(file_suppliers_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_suppliers_proto_goTypes,
+		DependencyIndexes: file_suppliers_proto_depIdxs,
+		MessageInfos:      file_suppliers_proto_msgTypes,
+	}.Build()
+	File_suppliers_proto = out.File
+	file_suppliers_proto_goTypes = nil
+	file_suppliers_proto_depIdxs = nil
+}
@@ -0,0 +1,615 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: suppliers.proto
+
+package protobuf
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SuppliersService_CreateSupplier_FullMethodName               = "/SuppliersService/CreateSupplier"
+	SuppliersService_GetSupplier_FullMethodName                  = "/SuppliersService/GetSupplier"
+	SuppliersService_ListSuppliers_FullMethodName                = "/SuppliersService/ListSuppliers"
+	SuppliersService_LinkSupplierProduct_FullMethodName          = "/SuppliersService/LinkSupplierProduct"
+	SuppliersService_ListSupplierProducts_FullMethodName         = "/SuppliersService/ListSupplierProducts"
+	SuppliersService_CreateReplenishmentProposal_FullMethodName  = "/SuppliersService/CreateReplenishmentProposal"
+	SuppliersService_GetReplenishmentProposal_FullMethodName     = "/SuppliersService/GetReplenishmentProposal"
+	SuppliersService_ApproveReplenishmentProposal_FullMethodName = "/SuppliersService/ApproveReplenishmentProposal"
+	SuppliersService_CreatePurchaseOrder_FullMethodName          = "/SuppliersService/CreatePurchaseOrder"
+	SuppliersService_GetPurchaseOrder_FullMethodName             = "/SuppliersService/GetPurchaseOrder"
+	SuppliersService_ListPurchaseOrders_FullMethodName           = "/SuppliersService/ListPurchaseOrders"
+	SuppliersService_SendPurchaseOrder_FullMethodName            = "/SuppliersService/SendPurchaseOrder"
+	SuppliersService_ReceivePurchaseOrder_FullMethodName         = "/SuppliersService/ReceivePurchaseOrder"
+	SuppliersService_ClosePurchaseOrder_FullMethodName           = "/SuppliersService/ClosePurchaseOrder"
+)
+
+// SuppliersServiceClient is the client API for SuppliersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SuppliersServiceClient interface {
+	CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...grpc.CallOption) (*Supplier, error)
+	GetSupplier(ctx context.Context, in *SupplierIdRequest, opts ...grpc.CallOption) (*Supplier, error)
+	ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error)
+	LinkSupplierProduct(ctx context.Context, in *LinkSupplierProductRequest, opts ...grpc.CallOption) (*SupplierProduct, error)
+	ListSupplierProducts(ctx context.Context, in *ListSupplierProductsRequest, opts ...grpc.CallOption) (*ListSupplierProductsResponse, error)
+	CreateReplenishmentProposal(ctx context.Context, in *CreateReplenishmentProposalRequest, opts ...grpc.CallOption) (*ReplenishmentProposal, error)
+	GetReplenishmentProposal(ctx context.Context, in *ReplenishmentProposalIdRequest, opts ...grpc.CallOption) (*ReplenishmentProposal, error)
+	ApproveReplenishmentProposal(ctx context.Context, in *ApproveReplenishmentRequest, opts ...grpc.CallOption) (*ApproveReplenishmentResponse, error)
+	CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	GetPurchaseOrder(ctx context.Context, in *PurchaseOrderIdRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error)
+	SendPurchaseOrder(ctx context.Context, in *PurchaseOrderIdRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	ClosePurchaseOrder(ctx context.Context, in *PurchaseOrderIdRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+}
+
+type suppliersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSuppliersServiceClient(cc grpc.ClientConnInterface) SuppliersServiceClient {
+	return &suppliersServiceClient{cc}
+}
+
+func (c *suppliersServiceClient) CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...grpc.CallOption) (*Supplier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Supplier)
+	err := c.cc.Invoke(ctx, SuppliersService_CreateSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) GetSupplier(ctx context.Context, in *SupplierIdRequest, opts ...grpc.CallOption) (*Supplier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Supplier)
+	err := c.cc.Invoke(ctx, SuppliersService_GetSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuppliersResponse)
+	err := c.cc.Invoke(ctx, SuppliersService_ListSuppliers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) LinkSupplierProduct(ctx context.Context, in *LinkSupplierProductRequest, opts ...grpc.CallOption) (*SupplierProduct, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierProduct)
+	err := c.cc.Invoke(ctx, SuppliersService_LinkSupplierProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) ListSupplierProducts(ctx context.Context, in *ListSupplierProductsRequest, opts ...grpc.CallOption) (*ListSupplierProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSupplierProductsResponse)
+	err := c.cc.Invoke(ctx, SuppliersService_ListSupplierProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) CreateReplenishmentProposal(ctx context.Context, in *CreateReplenishmentProposalRequest, opts ...grpc.CallOption) (*ReplenishmentProposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplenishmentProposal)
+	err := c.cc.Invoke(ctx, SuppliersService_CreateReplenishmentProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) GetReplenishmentProposal(ctx context.Context, in *ReplenishmentProposalIdRequest, opts ...grpc.CallOption) (*ReplenishmentProposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplenishmentProposal)
+	err := c.cc.Invoke(ctx, SuppliersService_GetReplenishmentProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) ApproveReplenishmentProposal(ctx context.Context, in *ApproveReplenishmentRequest, opts ...grpc.CallOption) (*ApproveReplenishmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveReplenishmentResponse)
+	err := c.cc.Invoke(ctx, SuppliersService_ApproveReplenishmentProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, SuppliersService_CreatePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) GetPurchaseOrder(ctx context.Context, in *PurchaseOrderIdRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, SuppliersService_GetPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPurchaseOrdersResponse)
+	err := c.cc.Invoke(ctx, SuppliersService_ListPurchaseOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) SendPurchaseOrder(ctx context.Context, in *PurchaseOrderIdRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, SuppliersService_SendPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, SuppliersService_ReceivePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suppliersServiceClient) ClosePurchaseOrder(ctx context.Context, in *PurchaseOrderIdRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, SuppliersService_ClosePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SuppliersServiceServer is the server API for SuppliersService service.
+// All implementations must embed UnimplementedSuppliersServiceServer
+// for forward compatibility.
+type SuppliersServiceServer interface {
+	CreateSupplier(context.Context, *CreateSupplierRequest) (*Supplier, error)
+	GetSupplier(context.Context, *SupplierIdRequest) (*Supplier, error)
+	ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error)
+	LinkSupplierProduct(context.Context, *LinkSupplierProductRequest) (*SupplierProduct, error)
+	ListSupplierProducts(context.Context, *ListSupplierProductsRequest) (*ListSupplierProductsResponse, error)
+	CreateReplenishmentProposal(context.Context, *CreateReplenishmentProposalRequest) (*ReplenishmentProposal, error)
+	GetReplenishmentProposal(context.Context, *ReplenishmentProposalIdRequest) (*ReplenishmentProposal, error)
+	ApproveReplenishmentProposal(context.Context, *ApproveReplenishmentRequest) (*ApproveReplenishmentResponse, error)
+	CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*PurchaseOrder, error)
+	GetPurchaseOrder(context.Context, *PurchaseOrderIdRequest) (*PurchaseOrder, error)
+	ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error)
+	SendPurchaseOrder(context.Context, *PurchaseOrderIdRequest) (*PurchaseOrder, error)
+	ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*PurchaseOrder, error)
+	ClosePurchaseOrder(context.Context, *PurchaseOrderIdRequest) (*PurchaseOrder, error)
+	mustEmbedUnimplementedSuppliersServiceServer()
+}
+
+// UnimplementedSuppliersServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSuppliersServiceServer struct{}
+
+func (UnimplementedSuppliersServiceServer) CreateSupplier(context.Context, *CreateSupplierRequest) (*Supplier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSupplier not implemented")
+}
+func (UnimplementedSuppliersServiceServer) GetSupplier(context.Context, *SupplierIdRequest) (*Supplier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupplier not implemented")
+}
+func (UnimplementedSuppliersServiceServer) ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuppliers not implemented")
+}
+func (UnimplementedSuppliersServiceServer) LinkSupplierProduct(context.Context, *LinkSupplierProductRequest) (*SupplierProduct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkSupplierProduct not implemented")
+}
+func (UnimplementedSuppliersServiceServer) ListSupplierProducts(context.Context, *ListSupplierProductsRequest) (*ListSupplierProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupplierProducts not implemented")
+}
+func (UnimplementedSuppliersServiceServer) CreateReplenishmentProposal(context.Context, *CreateReplenishmentProposalRequest) (*ReplenishmentProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReplenishmentProposal not implemented")
+}
+func (UnimplementedSuppliersServiceServer) GetReplenishmentProposal(context.Context, *ReplenishmentProposalIdRequest) (*ReplenishmentProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplenishmentProposal not implemented")
+}
+func (UnimplementedSuppliersServiceServer) ApproveReplenishmentProposal(context.Context, *ApproveReplenishmentRequest) (*ApproveReplenishmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReplenishmentProposal not implemented")
+}
+func (UnimplementedSuppliersServiceServer) CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePurchaseOrder not implemented")
+}
+func (UnimplementedSuppliersServiceServer) GetPurchaseOrder(context.Context, *PurchaseOrderIdRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrder not implemented")
+}
+func (UnimplementedSuppliersServiceServer) ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPurchaseOrders not implemented")
+}
+func (UnimplementedSuppliersServiceServer) SendPurchaseOrder(context.Context, *PurchaseOrderIdRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPurchaseOrder not implemented")
+}
+func (UnimplementedSuppliersServiceServer) ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceivePurchaseOrder not implemented")
+}
+func (UnimplementedSuppliersServiceServer) ClosePurchaseOrder(context.Context, *PurchaseOrderIdRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePurchaseOrder not implemented")
+}
+func (UnimplementedSuppliersServiceServer) mustEmbedUnimplementedSuppliersServiceServer() {}
+func (UnimplementedSuppliersServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeSuppliersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SuppliersServiceServer will
+// result in compilation errors.
+type UnsafeSuppliersServiceServer interface {
+	mustEmbedUnimplementedSuppliersServiceServer()
+}
+
+func RegisterSuppliersServiceServer(s grpc.ServiceRegistrar, srv SuppliersServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSuppliersServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SuppliersService_ServiceDesc, srv)
+}
+
+func _SuppliersService_CreateSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSupplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).CreateSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_CreateSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).CreateSupplier(ctx, req.(*CreateSupplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_GetSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).GetSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_GetSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).GetSupplier(ctx, req.(*SupplierIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_ListSuppliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuppliersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).ListSuppliers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_ListSuppliers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).ListSuppliers(ctx, req.(*ListSuppliersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_LinkSupplierProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkSupplierProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).LinkSupplierProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_LinkSupplierProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).LinkSupplierProduct(ctx, req.(*LinkSupplierProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_ListSupplierProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSupplierProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).ListSupplierProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_ListSupplierProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).ListSupplierProducts(ctx, req.(*ListSupplierProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_CreateReplenishmentProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReplenishmentProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).CreateReplenishmentProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_CreateReplenishmentProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).CreateReplenishmentProposal(ctx, req.(*CreateReplenishmentProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_GetReplenishmentProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplenishmentProposalIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).GetReplenishmentProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_GetReplenishmentProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).GetReplenishmentProposal(ctx, req.(*ReplenishmentProposalIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_ApproveReplenishmentProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReplenishmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).ApproveReplenishmentProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_ApproveReplenishmentProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).ApproveReplenishmentProposal(ctx, req.(*ApproveReplenishmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_CreatePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).CreatePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_CreatePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).CreatePurchaseOrder(ctx, req.(*CreatePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_GetPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).GetPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_GetPurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).GetPurchaseOrder(ctx, req.(*PurchaseOrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_ListPurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPurchaseOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).ListPurchaseOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_ListPurchaseOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).ListPurchaseOrders(ctx, req.(*ListPurchaseOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_SendPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).SendPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_SendPurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).SendPurchaseOrder(ctx, req.(*PurchaseOrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_ReceivePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceivePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).ReceivePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_ReceivePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).ReceivePurchaseOrder(ctx, req.(*ReceivePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuppliersService_ClosePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuppliersServiceServer).ClosePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuppliersService_ClosePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuppliersServiceServer).ClosePurchaseOrder(ctx, req.(*PurchaseOrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SuppliersService_ServiceDesc is the grpc.ServiceDesc for SuppliersService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SuppliersService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "SuppliersService",
+	HandlerType: (*SuppliersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSupplier",
+			Handler:    _SuppliersService_CreateSupplier_Handler,
+		},
+		{
+			MethodName: "GetSupplier",
+			Handler:    _SuppliersService_GetSupplier_Handler,
+		},
+		{
+			MethodName: "ListSuppliers",
+			Handler:    _SuppliersService_ListSuppliers_Handler,
+		},
+		{
+			MethodName: "LinkSupplierProduct",
+			Handler:    _SuppliersService_LinkSupplierProduct_Handler,
+		},
+		{
+			MethodName: "ListSupplierProducts",
+			Handler:    _SuppliersService_ListSupplierProducts_Handler,
+		},
+		{
+			MethodName: "CreateReplenishmentProposal",
+			Handler:    _SuppliersService_CreateReplenishmentProposal_Handler,
+		},
+		{
+			MethodName: "GetReplenishmentProposal",
+			Handler:    _SuppliersService_GetReplenishmentProposal_Handler,
+		},
+		{
+			MethodName: "ApproveReplenishmentProposal",
+			Handler:    _SuppliersService_ApproveReplenishmentProposal_Handler,
+		},
+		{
+			MethodName: "CreatePurchaseOrder",
+			Handler:    _SuppliersService_CreatePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrder",
+			Handler:    _SuppliersService_GetPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ListPurchaseOrders",
+			Handler:    _SuppliersService_ListPurchaseOrders_Handler,
+		},
+		{
+			MethodName: "SendPurchaseOrder",
+			Handler:    _SuppliersService_SendPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ReceivePurchaseOrder",
+			Handler:    _SuppliersService_ReceivePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ClosePurchaseOrder",
+			Handler:    _SuppliersService_ClosePurchaseOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "suppliers.proto",
+}
@@ -0,0 +1,137 @@
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// Conn is a *sql.DB or *sql.Tx the migrations run on. Tables are looked up in
+// the connection's current database.
+type Conn interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// CREATE TABLE IF NOT EXISTS leaves tables created by an older version alone,
+// these bring such a table up to the columns the CREATE statement has. Each
+// checks information_schema first so they can run on every start.
+
+// AddColumn adds column to table unless it is already there. definition is
+// everything after the column name, e.g. "INT NOT NULL DEFAULT 0". backfill
+// statements run only when the column is added, to fill it for existing rows.
+func AddColumn(ctx context.Context, conn Conn, table string, column string, definition string, backfill ...string) error {
+	var exists bool
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?
+	)
+	`
+	if err := conn.QueryRowContext(ctx, query, table, column).Scan(&exists); err != nil {
+		return fmt.Errorf("check column %s.%s: %w", table, column, err)
+	}
+	if exists {
+		return nil
+	}
+
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition)); err != nil {
+		return fmt.Errorf("add column %s.%s: %w", table, column, err)
+	}
+
+	for _, statement := range backfill {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("backfill column %s.%s: %w", table, column, err)
+		}
+	}
+	return nil
+}
+
+// ModifyColumn redefines column when its type or nullability differs from
+// definition, e.g. to add values to an enum
+func ModifyColumn(ctx context.Context, conn Conn, table string, column string, definition string) error {
+	var currentType, nullable string
+	query := `
+	SELECT COLUMN_TYPE, IS_NULLABLE FROM information_schema.COLUMNS
+	WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?
+	`
+	if err := conn.QueryRowContext(ctx, query, table, column).Scan(&currentType, &nullable); err != nil {
+		return fmt.Errorf("check column %s.%s: %w", table, column, err)
+	}
+
+	wantType, wantNullable := columnType(definition)
+	if strings.EqualFold(currentType, wantType) && (nullable == "YES") == wantNullable {
+		return nil
+	}
+
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s %s", table, column, definition)); err != nil {
+		return fmt.Errorf("modify column %s.%s: %w", table, column, err)
+	}
+	return nil
+}
+
+// AddIndex adds index name on columns unless the table already has an index
+// by that name or one starting with the same column
+func AddIndex(ctx context.Context, conn Conn, table string, name string, columns ...string) error {
+	var exists bool
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM information_schema.STATISTICS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND (INDEX_NAME = ? OR (SEQ_IN_INDEX = 1 AND COLUMN_NAME = ?))
+	)
+	`
+	if err := conn.QueryRowContext(ctx, query, table, name, columns[0]).Scan(&exists); err != nil {
+		return fmt.Errorf("check index %s.%s: %w", table, name, err)
+	}
+	if exists {
+		return nil
+	}
+
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ADD INDEX %s (%s)", table, name, strings.Join(columns, ", "))); err != nil {
+		return fmt.Errorf("add index %s.%s: %w", table, name, err)
+	}
+	return nil
+}
+
+// AddForeignKey adds constraint name on column unless the column already
+// references a table. references is the rest of the clause, e.g.
+// "locations(id) ON UPDATE CASCADE".
+func AddForeignKey(ctx context.Context, conn Conn, table string, name string, column string, references string) error {
+	var exists bool
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM information_schema.KEY_COLUMN_USAGE
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ? AND REFERENCED_TABLE_NAME IS NOT NULL
+	)
+	`
+	if err := conn.QueryRowContext(ctx, query, table, column).Scan(&exists); err != nil {
+		return fmt.Errorf("check foreign key %s.%s: %w", table, column, err)
+	}
+	if exists {
+		return nil
+	}
+
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s", table, name, column, references)); err != nil {
+		return fmt.Errorf("add foreign key %s.%s: %w", table, column, err)
+	}
+	return nil
+}
+
+// the COLUMN_TYPE information_schema reports for a column definition and
+// whether it allows NULL, "ENUM('a', 'b') NOT NULL" is enum('a','b')
+func columnType(definition string) (string, bool) {
+	definition = strings.TrimSpace(definition)
+
+	end := strings.IndexByte(definition, ' ')
+	if open := strings.IndexByte(definition, '('); open >= 0 && (end < 0 || open < end) {
+		end = open + strings.IndexByte(definition[open:], ')') + 1
+	}
+	if end < 0 {
+		end = len(definition)
+	}
+
+	typ := strings.ToLower(strings.ReplaceAll(definition[:end], ", ", ","))
+	rest := strings.ToUpper(definition[end:])
+	return typ, !strings.Contains(rest, "NOT NULL") && !strings.Contains(rest, "PRIMARY KEY")
+}
@@ -0,0 +1,28 @@
+package schema
+
+import "testing"
+
+func TestColumnType(t *testing.T) {
+	tests := []struct {
+		definition   string
+		wantType     string
+		wantNullable bool
+	}{
+		{"INT", "int", true},
+		{"INT NOT NULL DEFAULT 1", "int", false},
+		{"INT AUTO_INCREMENT PRIMARY KEY", "int", false},
+		{"TIMESTAMP NULL", "timestamp", true},
+		{"DECIMAL(12, 4)", "decimal(12,4)", true},
+		{"VARCHAR(20) NOT NULL DEFAULT ''", "varchar(20)", false},
+		{"ENUM('purchase', 'supply', 'correction') NOT NULL", "enum('purchase','supply','correction')", false},
+		{"ENUM('pending', 'cancelled') NOT NULL DEFAULT 'pending'", "enum('pending','cancelled')", false},
+		{"ENUM('available', 'in_transit')", "enum('available','in_transit')", true},
+	}
+
+	for _, tt := range tests {
+		gotType, gotNullable := columnType(tt.definition)
+		if gotType != tt.wantType || gotNullable != tt.wantNullable {
+			t.Errorf("columnType(%q) = %q, %v, want %q, %v", tt.definition, gotType, gotNullable, tt.wantType, tt.wantNullable)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/logan2k02/ims/shared/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type suppliersGRPCHandler struct {
+	service *suppliersService
+	pb.UnimplementedSuppliersServiceServer
+}
+
+func NewSuppliersGRPCHandler(service *suppliersService) *suppliersGRPCHandler {
+	return &suppliersGRPCHandler{
+		service: service,
+	}
+}
+
+func suppliersStatusError(err error) error {
+	switch {
+	case errors.Is(err, errSupplierNotFound),
+		errors.Is(err, errProductNotFound),
+		errors.Is(err, errProposalNotFound),
+		errors.Is(err, errPurchaseOrderNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errProposalState),
+		errors.Is(err, errNothingToReplenish),
+		errors.Is(err, errNothingToApprove),
+		errors.Is(err, errPurchaseOrderState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errInvalidPurchaseOrder),
+		errors.Is(err, errInvalidReceipt):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// errors from the inventory service keep their code
+	if st, ok := status.FromError(err); ok {
+		return status.Error(st.Code(), err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (h *suppliersGRPCHandler) CreateSupplier(ctx context.Context, payload *pb.CreateSupplierRequest) (*pb.Supplier, error) {
+	if payload.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "supplier name is required")
+	}
+
+	supplier, err := h.service.CreateSupplier(ctx, payload)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return supplier, nil
+}
+
+func (h *suppliersGRPCHandler) GetSupplier(ctx context.Context, payload *pb.SupplierIdRequest) (*pb.Supplier, error) {
+	supplier, err := h.service.GetSupplier(ctx, payload.Id)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return supplier, nil
+}
+
+func (h *suppliersGRPCHandler) ListSuppliers(ctx context.Context, payload *pb.ListSuppliersRequest) (*pb.ListSuppliersResponse, error) {
+	suppliers, err := h.service.ListSuppliers(ctx)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return &pb.ListSuppliersResponse{
+		Suppliers: suppliers,
+	}, nil
+}
+
+func (h *suppliersGRPCHandler) LinkSupplierProduct(ctx context.Context, payload *pb.LinkSupplierProductRequest) (*pb.SupplierProduct, error) {
+	if payload.UnitCost < 0 {
+		return nil, status.Error(codes.InvalidArgument, "unit cost cannot be negative")
+	}
+
+	if payload.PackSize < 0 || payload.LeadTimeDays < 0 {
+		return nil, status.Error(codes.InvalidArgument, "pack size and lead time cannot be negative")
+	}
+
+	product, err := h.service.LinkSupplierProduct(ctx, payload)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return product, nil
+}
+
+func (h *suppliersGRPCHandler) ListSupplierProducts(ctx context.Context, payload *pb.ListSupplierProductsRequest) (*pb.ListSupplierProductsResponse, error) {
+	products, err := h.service.ListSupplierProducts(ctx, payload)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return &pb.ListSupplierProductsResponse{
+		Products: products,
+	}, nil
+}
+
+func (h *suppliersGRPCHandler) CreateReplenishmentProposal(ctx context.Context, payload *pb.CreateReplenishmentProposalRequest) (*pb.ReplenishmentProposal, error) {
+	proposal, err := h.service.CreateReplenishmentProposal(ctx, payload)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return proposal, nil
+}
+
+func (h *suppliersGRPCHandler) GetReplenishmentProposal(ctx context.Context, payload *pb.ReplenishmentProposalIdRequest) (*pb.ReplenishmentProposal, error) {
+	proposal, err := h.service.GetReplenishmentProposal(ctx, payload.Id)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return proposal, nil
+}
+
+func (h *suppliersGRPCHandler) ApproveReplenishmentProposal(ctx context.Context, payload *pb.ApproveReplenishmentRequest) (*pb.ApproveReplenishmentResponse, error) {
+	res, err := h.service.ApproveReplenishmentProposal(ctx, payload)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return res, nil
+}
+
+func (h *suppliersGRPCHandler) CreatePurchaseOrder(ctx context.Context, payload *pb.CreatePurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	if len(payload.Lines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "purchase order lines are required")
+	}
+
+	for _, line := range payload.Lines {
+		if line.Quantity <= 0 || line.UnitCost < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "product %d: quantity must be positive and unit cost cannot be negative", line.ProductId)
+		}
+	}
+
+	order, err := h.service.CreatePurchaseOrder(ctx, payload)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return order, nil
+}
+
+func (h *suppliersGRPCHandler) SendPurchaseOrder(ctx context.Context, payload *pb.PurchaseOrderIdRequest) (*pb.PurchaseOrder, error) {
+	order, err := h.service.SendPurchaseOrder(ctx, payload.Id)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return order, nil
+}
+
+func (h *suppliersGRPCHandler) ReceivePurchaseOrder(ctx context.Context, payload *pb.ReceivePurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	if len(payload.Lines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "received lines are required")
+	}
+
+	order, err := h.service.ReceivePurchaseOrder(ctx, payload)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return order, nil
+}
+
+func (h *suppliersGRPCHandler) ClosePurchaseOrder(ctx context.Context, payload *pb.PurchaseOrderIdRequest) (*pb.PurchaseOrder, error) {
+	order, err := h.service.ClosePurchaseOrder(ctx, payload.Id)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return order, nil
+}
+
+func (h *suppliersGRPCHandler) GetPurchaseOrder(ctx context.Context, payload *pb.PurchaseOrderIdRequest) (*pb.PurchaseOrder, error) {
+	order, err := h.service.GetPurchaseOrder(ctx, payload.Id)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return order, nil
+}
+
+func (h *suppliersGRPCHandler) ListPurchaseOrders(ctx context.Context, payload *pb.ListPurchaseOrdersRequest) (*pb.ListPurchaseOrdersResponse, error) {
+	orders, err := h.service.ListPurchaseOrders(ctx, payload)
+
+	if err != nil {
+		return nil, suppliersStatusError(err)
+	}
+
+	return &pb.ListPurchaseOrdersResponse{
+		PurchaseOrders: orders,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/logan2k02/ims/shared/consul"
+	"github.com/logan2k02/ims/shared/grpcservice"
+	"github.com/logan2k02/ims/shared/logger"
+	"github.com/logan2k02/ims/shared/utils"
+
+	_ "github.com/joho/godotenv/autoload"
+
+	pb "github.com/logan2k02/ims/shared/protobuf"
+)
+
+var (
+	gRPCPort   = utils.GetEnv("GRPC_PORT", "50054")
+	gRPCHost   = utils.GetEnv("GRPC_HOST", "localhost")
+	consulAddr = utils.GetEnv("CONSUL_ADDR", "localhost:8500")
+
+	Logger = logger.NewLogger("suppliers-service")
+)
+
+func main() {
+	store, err := NewSuppliersStore()
+	if err != nil {
+		Logger.FatalLog("store init", "failed to create store: %v", err)
+	}
+
+	defer func() {
+		if err := store.Close(); err != nil {
+			Logger.FatalLog("store close", "%v", err)
+		}
+		Logger.Log("store close", "store closed successfully")
+	}()
+
+	if err := store.Init(); err != nil {
+		Logger.FatalLog("store init", "failed to init: %v", err)
+	}
+
+	Logger.Log("store init", "initialized successfully")
+
+	consulCient, err := consul.NewClient(consulAddr)
+	if err != nil {
+		Logger.FatalLog("consul init", "failed to create client: %v", err)
+	}
+
+	inventoryClientConn, err := grpcservice.GetGRPCConnection(consulCient, "inventory-grpc-service")
+	if err != nil {
+		Logger.FatalLog("get inventory client connection", "failed to get gRPC connection: %v", err)
+	}
+	defer inventoryClientConn.Close()
+
+	inventoryClient := pb.NewInventoryServiceClient(inventoryClientConn)
+
+	service := NewSuppliersService(store, inventoryClient)
+
+	_gRPCPort, _ := strconv.Atoi(gRPCPort)
+
+	gRPCServiceServer, err := grpcservice.NewServer(consulCient, "suppliers-grpc-service", gRPCHost, _gRPCPort)
+	if err != nil {
+		Logger.FatalLog("grpc server init", "failed to create server: %v", err)
+	}
+
+	suppliersGRPCHandler := NewSuppliersGRPCHandler(service)
+	gRPCServiceServer.RegisterService(&pb.SuppliersService_ServiceDesc, suppliersGRPCHandler)
+
+	Logger.Log("grpc server init", "starting server on port %s", gRPCPort)
+
+	if err := gRPCServiceServer.Start(); err != nil {
+		Logger.FatalLog("grpc server init", "failed to start: %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/logan2k02/ims/shared/protobuf"
+)
+
+type suppliersService struct {
+	store           *suppliersStore
+	inventoryClient pb.InventoryServiceClient
+}
+
+func NewSuppliersService(store *suppliersStore, inventoryClient pb.InventoryServiceClient) *suppliersService {
+	return &suppliersService{store, inventoryClient}
+}
+
+func (s *suppliersService) CreateSupplier(ctx context.Context, payload *pb.CreateSupplierRequest) (*pb.Supplier, error) {
+	return s.store.CreateSupplier(ctx, &CreateSupplierDto{
+		Name:  payload.Name,
+		Email: payload.Email,
+		Phone: payload.Phone,
+	})
+}
+
+func (s *suppliersService) GetSupplier(ctx context.Context, id int64) (*pb.Supplier, error) {
+	return s.store.GetSupplier(ctx, id)
+}
+
+func (s *suppliersService) ListSuppliers(ctx context.Context) ([]*pb.Supplier, error) {
+	return s.store.ListSuppliers(ctx)
+}
+
+func (s *suppliersService) LinkSupplierProduct(ctx context.Context, payload *pb.LinkSupplierProductRequest) (*pb.SupplierProduct, error) {
+	return s.store.LinkSupplierProduct(ctx, &SupplierProductDto{
+		SupplierId:   payload.SupplierId,
+		ProductId:    payload.ProductId,
+		SupplierSku:  payload.SupplierSku,
+		UnitCost:     payload.UnitCost,
+		PackSize:     payload.PackSize,
+		LeadTimeDays: payload.LeadTimeDays,
+		Preferred:    payload.Preferred,
+	})
+}
+
+func (s *suppliersService) ListSupplierProducts(ctx context.Context, payload *pb.ListSupplierProductsRequest) ([]*pb.SupplierProduct, error) {
+	return s.store.ListSupplierProducts(ctx, payload.SupplierId, payload.ProductId)
+}
+
+func (s *suppliersService) CreateReplenishmentProposal(ctx context.Context, payload *pb.CreateReplenishmentProposalRequest) (*pb.ReplenishmentProposal, error) {
+	proposal, err := s.store.CreateReplenishmentProposal(ctx, payload.ProductIds)
+	if err != nil {
+		return nil, err
+	}
+
+	Logger.Log("create replenishment proposal", "proposal %d created for %d suppliers", proposal.Id, len(proposal.Groups))
+
+	return proposal, nil
+}
+
+func (s *suppliersService) GetReplenishmentProposal(ctx context.Context, id int64) (*pb.ReplenishmentProposal, error) {
+	return s.store.GetReplenishmentProposal(ctx, id)
+}
+
+func (s *suppliersService) ApproveReplenishmentProposal(ctx context.Context, payload *pb.ApproveReplenishmentRequest) (*pb.ApproveReplenishmentResponse, error) {
+	proposal, orders, err := s.store.ApproveReplenishmentProposal(ctx, payload.Id, payload.SupplierIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, order := range orders {
+		Logger.Log("approve replenishment proposal", "purchase order %s drafted from proposal %d", order.Number, proposal.Id)
+	}
+
+	return &pb.ApproveReplenishmentResponse{
+		Proposal:       proposal,
+		PurchaseOrders: orders,
+	}, nil
+}
+
+func (s *suppliersService) CreatePurchaseOrder(ctx context.Context, payload *pb.CreatePurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	lines := make([]PurchaseOrderLineDto, len(payload.Lines))
+	for i, line := range payload.Lines {
+		lines[i] = PurchaseOrderLineDto{
+			ProductId: line.ProductId,
+			Quantity:  line.Quantity,
+			UnitCost:  line.UnitCost,
+		}
+	}
+
+	return s.store.CreatePurchaseOrder(ctx, &CreatePurchaseOrderDto{
+		SupplierId: payload.SupplierId,
+		Note:       payload.Note,
+		Lines:      lines,
+	})
+}
+
+func (s *suppliersService) SendPurchaseOrder(ctx context.Context, id int64) (*pb.PurchaseOrder, error) {
+	return s.store.SendPurchaseOrder(ctx, id)
+}
+
+func (s *suppliersService) ClosePurchaseOrder(ctx context.Context, id int64) (*pb.PurchaseOrder, error) {
+	return s.store.ClosePurchaseOrder(ctx, id)
+}
+
+// posts a supply movement per received line with the purchase order number as
+// reference. If the receipt cannot be recorded the movements already posted
+// are reversed so stock and the order stay in step.
+func (s *suppliersService) ReceivePurchaseOrder(ctx context.Context, payload *pb.ReceivePurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	lines := make([]ReceiptLineDto, len(payload.Lines))
+	for i, line := range payload.Lines {
+		lines[i] = ReceiptLineDto{
+			LineId:        line.LineId,
+			Quantity:      line.Quantity,
+			LotNumber:     line.LotNumber,
+			ExpiresAt:     line.ExpiresAt,
+			SerialNumbers: line.SerialNumbers,
+		}
+	}
+
+	order, posted, err := s.store.ReceivePurchaseOrder(ctx, &ReceivePurchaseOrderDto{
+		Id:         payload.Id,
+		LocationId: payload.LocationId,
+		Note:       payload.Note,
+		Lines:      lines,
+	}, func(ctx context.Context, productId int64, unitCost float64, reference string, line *ReceiptLineDto) (*pb.StockMovement, error) {
+		return s.inventoryClient.SupplyInventoryProduct(ctx, &pb.ManageInventoryRequest{
+			ProductId:     productId,
+			Quantity:      line.Quantity,
+			Note:          payload.Note,
+			LocationId:    payload.LocationId,
+			LotNumber:     line.LotNumber,
+			ExpiresAt:     line.ExpiresAt,
+			SerialNumbers: line.SerialNumbers,
+			Reference:     reference,
+			UnitCost:      unitCost,
+		})
+	})
+	if err != nil {
+		// the request context may already be done, the reversals must still go out
+		reverseCtx := context.WithoutCancel(ctx)
+		for _, movement := range posted {
+			if _, err := s.inventoryClient.ReverseStockMovement(reverseCtx, &pb.ReverseStockMovementRequest{
+				Id:   movement.Id,
+				Note: "goods receipt failed",
+			}); err != nil {
+				Logger.LogError("receive purchase order", "failed to reverse movement %d of %s: %v", movement.Id, movement.Reference, err)
+			}
+		}
+		return nil, err
+	}
+
+	Logger.Log("receive purchase order", "%s received %d lines, now %s", order.Number, len(lines), order.Status)
+
+	return order, nil
+}
+
+func (s *suppliersService) GetPurchaseOrder(ctx context.Context, id int64) (*pb.PurchaseOrder, error) {
+	return s.store.GetPurchaseOrder(ctx, id)
+}
+
+func (s *suppliersService) ListPurchaseOrders(ctx context.Context, payload *pb.ListPurchaseOrdersRequest) ([]*pb.PurchaseOrder, error) {
+	return s.store.ListPurchaseOrders(ctx, payload.SupplierId, payload.Status)
+}
@@ -0,0 +1,1305 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/logan2k02/ims/shared/schema"
+	"github.com/logan2k02/ims/shared/utils"
+
+	pb "github.com/logan2k02/ims/shared/protobuf"
+)
+
+type suppliersStore struct {
+	db *sql.DB
+}
+
+var (
+	dbHost     = utils.GetEnv("DB_HOST", "localhost")
+	dbPort     = utils.GetEnv("DB_PORT", "3306")
+	dbUser     = utils.GetEnv("DB_USER", "admin")
+	dbPassword = utils.GetEnv("DB_PASSWORD", "123456")
+	dbName     = utils.GetEnv("DB_NAME", "ims_db")
+)
+
+func NewSuppliersStore() (*suppliersStore, error) {
+	cfg := mysql.NewConfig()
+	cfg.User = dbUser
+	cfg.Passwd = dbPassword
+	cfg.Net = "tcp"
+	cfg.Addr = fmt.Sprintf("%s:%s", dbHost, dbPort)
+	cfg.DBName = dbName
+
+	conn, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		return nil, err
+	}
+
+	// ping
+	if err := conn.Ping(); err != nil {
+		return nil, err
+	}
+
+	return &suppliersStore{
+		db: conn,
+	}, nil
+}
+
+func (s *suppliersStore) Close() error {
+	if s.db != nil {
+		return s.db.Close()
+	}
+	return nil
+}
+
+// every purchase order status, tables created before a status was added are
+// migrated to it
+const purchaseOrderStatusDefinition = `ENUM('draft', 'sent', 'partially_received', 'received', 'closed') NOT NULL DEFAULT 'draft'`
+
+func (s *suppliersStore) Init() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("init suppliers store", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS suppliers (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		email VARCHAR(255),
+		phone VARCHAR(50),
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS supplier_products (
+		supplier_id INT NOT NULL,
+		product_id INT NOT NULL,
+		supplier_sku VARCHAR(255),
+		unit_cost DECIMAL(10, 2) NOT NULL DEFAULT 0,
+		pack_size INT NOT NULL DEFAULT 1,
+		lead_time_days INT NOT NULL DEFAULT 0,
+		preferred BOOLEAN NOT NULL DEFAULT FALSE,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (supplier_id, product_id),
+		FOREIGN KEY (supplier_id) REFERENCES suppliers(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS replenishment_proposals (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		status ENUM('open', 'approved', 'superseded') NOT NULL DEFAULT 'open',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		approved_at TIMESTAMP NULL
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS purchase_orders (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		supplier_id INT NOT NULL,
+		status `+purchaseOrderStatusDefinition+`,
+		proposal_id INT,
+		note TEXT,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		sent_at TIMESTAMP NULL,
+		expected_at TIMESTAMP NULL,
+		closed_at TIMESTAMP NULL,
+		FOREIGN KEY (supplier_id) REFERENCES suppliers(id) ON UPDATE CASCADE,
+		FOREIGN KEY (proposal_id) REFERENCES replenishment_proposals(id) ON DELETE SET NULL ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.ModifyColumn(ctx, tx, "purchase_orders", "status", purchaseOrderStatusDefinition); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "purchase_orders", "note", "TEXT"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "purchase_orders", "sent_at", "TIMESTAMP NULL"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "purchase_orders", "expected_at", "TIMESTAMP NULL"); err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "purchase_orders", "closed_at", "TIMESTAMP NULL"); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS purchase_order_lines (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		purchase_order_id INT NOT NULL,
+		product_id INT NOT NULL,
+		supplier_sku VARCHAR(255),
+		quantity INT NOT NULL,
+		received_quantity INT NOT NULL DEFAULT 0,
+		unit_cost DECIMAL(10, 2) NOT NULL DEFAULT 0,
+		FOREIGN KEY (purchase_order_id) REFERENCES purchase_orders(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.AddColumn(ctx, tx, "purchase_order_lines", "received_quantity", "INT NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS replenishment_lines (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		proposal_id INT NOT NULL,
+		product_id INT NOT NULL,
+		supplier_id INT,
+		supplier_sku VARCHAR(255),
+		stock_quantity INT NOT NULL,
+		on_order_quantity INT NOT NULL DEFAULT 0,
+		reorder_level INT NOT NULL,
+		reorder_quantity INT NOT NULL,
+		pack_size INT NOT NULL DEFAULT 1,
+		packs INT NOT NULL,
+		quantity INT NOT NULL,
+		unit_cost DECIMAL(10, 2) NOT NULL DEFAULT 0,
+		lead_time_days INT NOT NULL DEFAULT 0,
+		purchase_order_id INT,
+		FOREIGN KEY (proposal_id) REFERENCES replenishment_proposals(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (supplier_id) REFERENCES suppliers(id) ON DELETE SET NULL ON UPDATE CASCADE,
+		FOREIGN KEY (purchase_order_id) REFERENCES purchase_orders(id) ON DELETE SET NULL ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS goods_receipts (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		purchase_order_id INT NOT NULL,
+		location_id INT NOT NULL DEFAULT 0,
+		note TEXT,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (purchase_order_id) REFERENCES purchase_orders(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS goods_receipt_lines (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		receipt_id INT NOT NULL,
+		line_id INT NOT NULL,
+		quantity INT NOT NULL,
+		movement_id INT NOT NULL,
+		FOREIGN KEY (receipt_id) REFERENCES goods_receipts(id) ON DELETE CASCADE ON UPDATE CASCADE,
+		FOREIGN KEY (line_id) REFERENCES purchase_order_lines(id) ON DELETE CASCADE ON UPDATE CASCADE
+	);
+	`)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+var (
+	errSupplierNotFound      = errors.New("supplier not found")
+	errProductNotFound       = errors.New("product not found")
+	errProposalNotFound      = errors.New("replenishment proposal not found")
+	errProposalState         = errors.New("replenishment proposal is not open")
+	errNothingToReplenish    = errors.New("no products are below their reorder level")
+	errNothingToApprove      = errors.New("nothing left to approve")
+	errPurchaseOrderNotFound = errors.New("purchase order not found")
+	errPurchaseOrderState    = errors.New("purchase order is not in the required state")
+	errInvalidPurchaseOrder  = errors.New("invalid purchase order")
+	errInvalidReceipt        = errors.New("invalid goods receipt")
+)
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func inFilter(column string, ids []int64) (string, []any) {
+	placeholders := make([]string, len(ids))
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+	return column + " IN (" + strings.Join(placeholders, ", ") + ")", args
+}
+
+const supplierColumns = `id, name, COALESCE(email, ''), COALESCE(phone, ''), created_at`
+
+func scanSupplier(row rowScanner) (*pb.Supplier, error) {
+	var supplier pb.Supplier
+	if err := row.Scan(&supplier.Id, &supplier.Name, &supplier.Email, &supplier.Phone, &supplier.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &supplier, nil
+}
+
+type CreateSupplierDto struct {
+	Name  string
+	Email string
+	Phone string
+}
+
+func (s *suppliersStore) CreateSupplier(ctx context.Context, payload *CreateSupplierDto) (*pb.Supplier, error) {
+	result, err := s.db.ExecContext(ctx, `INSERT INTO suppliers (name, email, phone) VALUES (?, ?, ?)`, payload.Name, payload.Email, payload.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetSupplier(ctx, id)
+}
+
+func (s *suppliersStore) GetSupplier(ctx context.Context, id int64) (*pb.Supplier, error) {
+	supplier, err := scanSupplier(s.db.QueryRowContext(ctx, `SELECT `+supplierColumns+` FROM suppliers WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, errSupplierNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return supplier, nil
+}
+
+func (s *suppliersStore) ListSuppliers(ctx context.Context) ([]*pb.Supplier, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+supplierColumns+` FROM suppliers ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var suppliers []*pb.Supplier
+	for rows.Next() {
+		supplier, err := scanSupplier(rows)
+		if err != nil {
+			return nil, err
+		}
+		suppliers = append(suppliers, supplier)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return suppliers, nil
+}
+
+const supplierProductColumns = `supplier_id, product_id, COALESCE(supplier_sku, ''), unit_cost, pack_size, lead_time_days, preferred`
+
+func scanSupplierProduct(row rowScanner) (*pb.SupplierProduct, error) {
+	var product pb.SupplierProduct
+	if err := row.Scan(&product.SupplierId, &product.ProductId, &product.SupplierSku, &product.UnitCost, &product.PackSize, &product.LeadTimeDays, &product.Preferred); err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+type SupplierProductDto struct {
+	SupplierId   int64
+	ProductId    int64
+	SupplierSku  string
+	UnitCost     float64
+	PackSize     int64
+	LeadTimeDays int64
+	Preferred    bool
+}
+
+// links are upserted, marking one supplier preferred clears the flag on the
+// product's other suppliers
+func (s *suppliersStore) LinkSupplierProduct(ctx context.Context, payload *SupplierProductDto) (*pb.SupplierProduct, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("link supplier product", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var exists int
+	if err := tx.QueryRowContext(ctx, `SELECT 1 FROM suppliers WHERE id = ?`, payload.SupplierId).Scan(&exists); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errSupplierNotFound
+		}
+		return nil, err
+	}
+
+	if err := tx.QueryRowContext(ctx, `SELECT 1 FROM products WHERE id = ?`, payload.ProductId).Scan(&exists); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errProductNotFound
+		}
+		return nil, err
+	}
+
+	packSize := payload.PackSize
+	if packSize <= 0 {
+		packSize = 1
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO supplier_products (supplier_id, product_id, supplier_sku, unit_cost, pack_size, lead_time_days, preferred)
+	VALUES (?, ?, ?, ?, ?, ?, ?)
+	ON DUPLICATE KEY UPDATE
+		supplier_sku = VALUES(supplier_sku),
+		unit_cost = VALUES(unit_cost),
+		pack_size = VALUES(pack_size),
+		lead_time_days = VALUES(lead_time_days),
+		preferred = VALUES(preferred)
+	`, payload.SupplierId, payload.ProductId, payload.SupplierSku, payload.UnitCost, packSize, payload.LeadTimeDays, payload.Preferred)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Preferred {
+		if _, err := tx.ExecContext(ctx, `UPDATE supplier_products SET preferred = FALSE WHERE product_id = ? AND supplier_id <> ?`, payload.ProductId, payload.SupplierId); err != nil {
+			return nil, err
+		}
+	}
+
+	product, err := scanSupplierProduct(tx.QueryRowContext(ctx, `SELECT `+supplierProductColumns+` FROM supplier_products WHERE supplier_id = ? AND product_id = ?`, payload.SupplierId, payload.ProductId))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+func (s *suppliersStore) ListSupplierProducts(ctx context.Context, supplierId, productId int64) ([]*pb.SupplierProduct, error) {
+	query := `SELECT ` + supplierProductColumns + ` FROM supplier_products`
+	var conditions []string
+	var args []any
+	if supplierId > 0 {
+		conditions = append(conditions, "supplier_id = ?")
+		args = append(args, supplierId)
+	}
+	if productId > 0 {
+		conditions = append(conditions, "product_id = ?")
+		args = append(args, productId)
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY product_id, preferred DESC, unit_cost"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var products []*pb.SupplierProduct
+	for rows.Next() {
+		product, err := scanSupplierProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// what is still to be received on open purchase orders counts towards stock
+// so approved proposals are not suggested again before the goods arrive
+const onOrderQuantityQuery = `
+SELECT COALESCE(SUM(GREATEST(pol.quantity - pol.received_quantity, 0)), 0)
+FROM purchase_order_lines pol
+JOIN purchase_orders po ON po.id = pol.purchase_order_id
+WHERE pol.product_id = p.id AND po.status IN ('draft', 'sent', 'partially_received')`
+
+type replenishmentCandidate struct {
+	ProductId       int64
+	StockQuantity   int64
+	OnOrderQuantity int64
+	ReorderLevel    int64
+	ReorderQuantity int64
+}
+
+// orders the reorder quantity, or enough to get back to the reorder level
+// when that is more, rounded up to whole packs
+func packsToOrder(candidate *replenishmentCandidate, packSize int64) int64 {
+	quantity := candidate.ReorderQuantity
+	if shortfall := candidate.ReorderLevel - candidate.StockQuantity - candidate.OnOrderQuantity; shortfall > quantity {
+		quantity = shortfall
+	}
+	return (quantity + packSize - 1) / packSize
+}
+
+// scans products whose stock plus what is already on order is below the
+// reorder level and proposes an order line for each from the preferred, or
+// else cheapest, supplier. A new proposal supersedes any open one.
+func (s *suppliersStore) CreateReplenishmentProposal(ctx context.Context, productIds []int64) (*pb.ReplenishmentProposal, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("create replenishment proposal", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	query := `
+	SELECT id, stock_quantity, on_order, reorder_level, reorder_quantity
+	FROM (
+		SELECT p.id, p.stock_quantity, p.reorder_level, p.reorder_quantity, (` + onOrderQuantityQuery + `) AS on_order
+		FROM products p
+		WHERE p.reorder_level > 0`
+	var args []any
+	if len(productIds) > 0 {
+		condition, conditionArgs := inFilter("p.id", productIds)
+		query += " AND " + condition
+		args = conditionArgs
+	}
+	query += `
+	) c
+	WHERE stock_quantity + on_order < reorder_level
+	ORDER BY id`
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*replenishmentCandidate
+	for rows.Next() {
+		var candidate replenishmentCandidate
+		if err := rows.Scan(&candidate.ProductId, &candidate.StockQuantity, &candidate.OnOrderQuantity, &candidate.ReorderLevel, &candidate.ReorderQuantity); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		candidates = append(candidates, &candidate)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		return nil, errNothingToReplenish
+	}
+
+	ids := make([]int64, len(candidates))
+	for i, candidate := range candidates {
+		ids[i] = candidate.ProductId
+	}
+
+	condition, conditionArgs := inFilter("product_id", ids)
+	rows, err = tx.QueryContext(ctx, `
+	SELECT `+supplierProductColumns+`
+	FROM supplier_products
+	WHERE `+condition+`
+	ORDER BY product_id, preferred DESC, unit_cost, lead_time_days, supplier_id`, conditionArgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make(map[int64]*pb.SupplierProduct)
+	for rows.Next() {
+		source, err := scanSupplierProduct(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if _, ok := sources[source.ProductId]; !ok {
+			sources[source.ProductId] = source
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE replenishment_proposals SET status = 'superseded' WHERE status = 'open'`); err != nil {
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO replenishment_proposals () VALUES ()`)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalId, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		// products without a supplier are still proposed so buyers see them,
+		// they just cannot be approved until a supplier is linked
+		var supplierId sql.NullInt64
+		var supplierSku string
+		var unitCost float64
+		var leadTimeDays int64
+		packSize := int64(1)
+		if source, ok := sources[candidate.ProductId]; ok {
+			supplierId = sql.NullInt64{Int64: source.SupplierId, Valid: true}
+			supplierSku = source.SupplierSku
+			unitCost = source.UnitCost
+			leadTimeDays = source.LeadTimeDays
+			packSize = source.PackSize
+		}
+
+		packs := packsToOrder(candidate, packSize)
+
+		_, err := tx.ExecContext(ctx, `
+		INSERT INTO replenishment_lines (proposal_id, product_id, supplier_id, supplier_sku, stock_quantity, on_order_quantity, reorder_level, reorder_quantity, pack_size, packs, quantity, unit_cost, lead_time_days)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, proposalId, candidate.ProductId, supplierId, supplierSku, candidate.StockQuantity, candidate.OnOrderQuantity, candidate.ReorderLevel, candidate.ReorderQuantity, packSize, packs, packs*packSize, unitCost, leadTimeDays)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	proposal, err := getReplenishmentProposal(ctx, tx, proposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+func getReplenishmentProposal(ctx context.Context, tx *sql.Tx, id int64) (*pb.ReplenishmentProposal, error) {
+	var proposal pb.ReplenishmentProposal
+	err := tx.QueryRowContext(ctx, `
+	SELECT id, status, created_at, COALESCE(approved_at, '')
+	FROM replenishment_proposals
+	WHERE id = ?
+	`, id).Scan(&proposal.Id, &proposal.Status, &proposal.CreatedAt, &proposal.ApprovedAt)
+	if err == sql.ErrNoRows {
+		return nil, errProposalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT rl.id, rl.product_id, COALESCE(rl.supplier_id, 0), COALESCE(s.name, ''), COALESCE(rl.supplier_sku, ''),
+		rl.stock_quantity, rl.on_order_quantity, rl.reorder_level, rl.reorder_quantity,
+		rl.pack_size, rl.packs, rl.quantity, rl.unit_cost, rl.lead_time_days, COALESCE(rl.purchase_order_id, 0)
+	FROM replenishment_lines rl
+	LEFT JOIN suppliers s ON s.id = rl.supplier_id
+	WHERE rl.proposal_id = ?
+	ORDER BY COALESCE(rl.supplier_id, 0) = 0, rl.supplier_id, rl.product_id
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var group *pb.ReplenishmentGroup
+	for rows.Next() {
+		var line pb.ReplenishmentLine
+		var supplierId, leadTimeDays int64
+		var supplierName string
+		if err := rows.Scan(&line.Id, &line.ProductId, &supplierId, &supplierName, &line.SupplierSku,
+			&line.StockQuantity, &line.OnOrderQuantity, &line.ReorderLevel, &line.ReorderQuantity,
+			&line.PackSize, &line.Packs, &line.Quantity, &line.UnitCost, &leadTimeDays, &line.PurchaseOrderId); err != nil {
+			return nil, err
+		}
+
+		if group == nil || group.SupplierId != supplierId {
+			group = &pb.ReplenishmentGroup{
+				SupplierId:      supplierId,
+				SupplierName:    supplierName,
+				PurchaseOrderId: line.PurchaseOrderId,
+			}
+			proposal.Groups = append(proposal.Groups, group)
+		}
+
+		if leadTimeDays > group.LeadTimeDays {
+			group.LeadTimeDays = leadTimeDays
+		}
+		group.TotalCost += float64(line.Quantity) * line.UnitCost
+		group.Lines = append(group.Lines, &line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &proposal, nil
+}
+
+func (s *suppliersStore) GetReplenishmentProposal(ctx context.Context, id int64) (*pb.ReplenishmentProposal, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get replenishment proposal", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	proposal, err := getReplenishmentProposal(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// turns the supplier groups of an open proposal into draft purchase orders,
+// one per supplier. The proposal is approved once every group with a
+// supplier has its order.
+func (s *suppliersStore) ApproveReplenishmentProposal(ctx context.Context, id int64, supplierIds []int64) (*pb.ReplenishmentProposal, []*pb.PurchaseOrder, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("approve replenishment proposal", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var proposalStatus string
+	if err := tx.QueryRowContext(ctx, `SELECT status FROM replenishment_proposals WHERE id = ? FOR UPDATE`, id).Scan(&proposalStatus); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, errProposalNotFound
+		}
+		return nil, nil, err
+	}
+
+	if proposalStatus != "open" {
+		return nil, nil, fmt.Errorf("proposal %d is %s: %w", id, proposalStatus, errProposalState)
+	}
+
+	query := `
+	SELECT DISTINCT supplier_id
+	FROM replenishment_lines
+	WHERE proposal_id = ? AND supplier_id IS NOT NULL AND purchase_order_id IS NULL`
+	args := []any{id}
+	if len(supplierIds) > 0 {
+		condition, conditionArgs := inFilter("supplier_id", supplierIds)
+		query += " AND " + condition
+		args = append(args, conditionArgs...)
+	}
+	query += " ORDER BY supplier_id"
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pending []int64
+	for rows.Next() {
+		var supplierId int64
+		if err := rows.Scan(&supplierId); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		pending = append(pending, supplierId)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(pending) == 0 {
+		return nil, nil, errNothingToApprove
+	}
+
+	var orders []*pb.PurchaseOrder
+	for _, supplierId := range pending {
+		result, err := tx.ExecContext(ctx, `INSERT INTO purchase_orders (supplier_id, proposal_id) VALUES (?, ?)`, supplierId, id)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		orderId, err := result.LastInsertId()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO purchase_order_lines (purchase_order_id, product_id, supplier_sku, quantity, unit_cost)
+		SELECT ?, product_id, supplier_sku, quantity, unit_cost
+		FROM replenishment_lines
+		WHERE proposal_id = ? AND supplier_id = ? AND purchase_order_id IS NULL
+		ORDER BY product_id
+		`, orderId, id, supplierId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		UPDATE replenishment_lines SET purchase_order_id = ?
+		WHERE proposal_id = ? AND supplier_id = ? AND purchase_order_id IS NULL
+		`, orderId, id, supplierId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		order, err := getPurchaseOrder(ctx, tx, orderId)
+		if err != nil {
+			return nil, nil, err
+		}
+		orders = append(orders, order)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE replenishment_proposals SET status = 'approved', approved_at = NOW()
+	WHERE id = ? AND NOT EXISTS (
+		SELECT 1 FROM replenishment_lines
+		WHERE proposal_id = ? AND supplier_id IS NOT NULL AND purchase_order_id IS NULL
+	)
+	`, id, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proposal, err := getReplenishmentProposal(ctx, tx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return proposal, orders, nil
+}
+
+func purchaseOrderNumber(id int64) string {
+	return fmt.Sprintf("PO-%06d", id)
+}
+
+const purchaseOrderColumns = `id, supplier_id, status, COALESCE(proposal_id, 0), created_at, COALESCE(note, ''), COALESCE(sent_at, ''), COALESCE(expected_at, ''), COALESCE(closed_at, '')`
+
+func scanPurchaseOrder(row rowScanner) (*pb.PurchaseOrder, error) {
+	var order pb.PurchaseOrder
+	if err := row.Scan(&order.Id, &order.SupplierId, &order.Status, &order.ProposalId, &order.CreatedAt, &order.Note, &order.SentAt, &order.ExpectedAt, &order.ClosedAt); err != nil {
+		return nil, err
+	}
+	order.Number = purchaseOrderNumber(order.Id)
+	return &order, nil
+}
+
+func getPurchaseOrder(ctx context.Context, tx *sql.Tx, id int64) (*pb.PurchaseOrder, error) {
+	order, err := scanPurchaseOrder(tx.QueryRowContext(ctx, `SELECT `+purchaseOrderColumns+` FROM purchase_orders WHERE id = ?`, id))
+	if err == sql.ErrNoRows {
+		return nil, errPurchaseOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT id, product_id, COALESCE(supplier_sku, ''), quantity, unit_cost, received_quantity
+	FROM purchase_order_lines
+	WHERE purchase_order_id = ?
+	ORDER BY id
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		var line pb.PurchaseOrderLine
+		if err := rows.Scan(&line.Id, &line.ProductId, &line.SupplierSku, &line.Quantity, &line.UnitCost, &line.ReceivedQuantity); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		order.Lines = append(order.Lines, &line)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = tx.QueryContext(ctx, `
+	SELECT gr.id, gr.location_id, COALESCE(gr.note, ''), gr.created_at, grl.line_id, pol.product_id, grl.quantity, grl.movement_id
+	FROM goods_receipts gr
+	JOIN goods_receipt_lines grl ON grl.receipt_id = gr.id
+	JOIN purchase_order_lines pol ON pol.id = grl.line_id
+	WHERE gr.purchase_order_id = ?
+	ORDER BY gr.id, grl.id
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var receipt *pb.GoodsReceipt
+	for rows.Next() {
+		var receiptId, locationId int64
+		var note, createdAt string
+		var line pb.GoodsReceiptLine
+		if err := rows.Scan(&receiptId, &locationId, &note, &createdAt, &line.LineId, &line.ProductId, &line.Quantity, &line.MovementId); err != nil {
+			return nil, err
+		}
+
+		if receipt == nil || receipt.Id != receiptId {
+			receipt = &pb.GoodsReceipt{
+				Id:         receiptId,
+				LocationId: locationId,
+				Note:       note,
+				CreatedAt:  createdAt,
+			}
+			order.Receipts = append(order.Receipts, receipt)
+		}
+		receipt.Lines = append(receipt.Lines, &line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func lockPurchaseOrder(ctx context.Context, tx *sql.Tx, id int64, allowed ...string) (string, error) {
+	var orderStatus string
+	if err := tx.QueryRowContext(ctx, `SELECT status FROM purchase_orders WHERE id = ? FOR UPDATE`, id).Scan(&orderStatus); err != nil {
+		if err == sql.ErrNoRows {
+			return "", errPurchaseOrderNotFound
+		}
+		return "", err
+	}
+
+	for _, status := range allowed {
+		if orderStatus == status {
+			return orderStatus, nil
+		}
+	}
+
+	return "", fmt.Errorf("purchase order %s is %s: %w", purchaseOrderNumber(id), orderStatus, errPurchaseOrderState)
+}
+
+type PurchaseOrderLineDto struct {
+	ProductId int64
+	Quantity  int64
+	UnitCost  float64
+}
+
+type CreatePurchaseOrderDto struct {
+	SupplierId int64
+	Note       string
+	Lines      []PurchaseOrderLineDto
+}
+
+// every product has to be linked to the supplier, the link fills in the
+// supplier sku and the cost when none is given
+func (s *suppliersStore) CreatePurchaseOrder(ctx context.Context, payload *CreatePurchaseOrderDto) (*pb.PurchaseOrder, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("create purchase order", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var exists int
+	if err := tx.QueryRowContext(ctx, `SELECT 1 FROM suppliers WHERE id = ?`, payload.SupplierId).Scan(&exists); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errSupplierNotFound
+		}
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO purchase_orders (supplier_id, note) VALUES (?, ?)`, payload.SupplierId, payload.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	orderId, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range payload.Lines {
+		var supplierSku string
+		var unitCost float64
+		err := tx.QueryRowContext(ctx, `
+		SELECT COALESCE(supplier_sku, ''), unit_cost
+		FROM supplier_products
+		WHERE supplier_id = ? AND product_id = ?
+		`, payload.SupplierId, line.ProductId).Scan(&supplierSku, &unitCost)
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("product %d is not supplied by supplier %d: %w", line.ProductId, payload.SupplierId, errInvalidPurchaseOrder)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if line.UnitCost > 0 {
+			unitCost = line.UnitCost
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO purchase_order_lines (purchase_order_id, product_id, supplier_sku, quantity, unit_cost)
+		VALUES (?, ?, ?, ?, ?)
+		`, orderId, line.ProductId, supplierSku, line.Quantity, unitCost)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	order, err := getPurchaseOrder(ctx, tx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// the expected date is taken from the longest lead time among the ordered
+// products
+func (s *suppliersStore) SendPurchaseOrder(ctx context.Context, id int64) (*pb.PurchaseOrder, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("send purchase order", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if _, err := lockPurchaseOrder(ctx, tx, id, "draft"); err != nil {
+		return nil, err
+	}
+
+	var lines, leadTimeDays int64
+	err = tx.QueryRowContext(ctx, `
+	SELECT COUNT(*), COALESCE(MAX(sp.lead_time_days), 0)
+	FROM purchase_order_lines pol
+	JOIN purchase_orders po ON po.id = pol.purchase_order_id
+	LEFT JOIN supplier_products sp ON sp.supplier_id = po.supplier_id AND sp.product_id = pol.product_id
+	WHERE pol.purchase_order_id = ?
+	`, id).Scan(&lines, &leadTimeDays)
+	if err != nil {
+		return nil, err
+	}
+
+	if lines == 0 {
+		return nil, fmt.Errorf("purchase order %s has no lines: %w", purchaseOrderNumber(id), errInvalidPurchaseOrder)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE purchase_orders
+	SET status = 'sent', sent_at = NOW(), expected_at = NOW() + INTERVAL ? DAY
+	WHERE id = ?
+	`, leadTimeDays, id)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := getPurchaseOrder(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// closing stops anything still outstanding from counting as on order, it is
+// how short deliveries and cancelled drafts are settled
+func (s *suppliersStore) ClosePurchaseOrder(ctx context.Context, id int64) (*pb.PurchaseOrder, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("close purchase order", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if _, err := lockPurchaseOrder(ctx, tx, id, "draft", "sent", "partially_received", "received"); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE purchase_orders SET status = 'closed', closed_at = NOW() WHERE id = ?`, id); err != nil {
+		return nil, err
+	}
+
+	order, err := getPurchaseOrder(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+type ReceiptLineDto struct {
+	LineId        int64
+	Quantity      int64
+	LotNumber     string
+	ExpiresAt     string
+	SerialNumbers []string
+}
+
+type ReceivePurchaseOrderDto struct {
+	Id         int64
+	LocationId int64
+	Note       string
+	Lines      []ReceiptLineDto
+}
+
+// posts one supply movement for a received line at the ordered unit cost,
+// reference is the purchase order number
+type supplyFunc func(ctx context.Context, productId int64, unitCost float64, reference string, line *ReceiptLineDto) (*pb.StockMovement, error)
+
+// the purchase order stays locked while the supply movements are posted so
+// concurrent receipts cannot over-receive a line. Movements posted before a
+// failure are returned with the error for the caller to reverse.
+func (s *suppliersStore) ReceivePurchaseOrder(ctx context.Context, payload *ReceivePurchaseOrderDto, supply supplyFunc) (*pb.PurchaseOrder, []*pb.StockMovement, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("receive purchase order", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if _, err := lockPurchaseOrder(ctx, tx, payload.Id, "sent", "partially_received"); err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+	SELECT id, product_id, unit_cost, quantity - received_quantity
+	FROM purchase_order_lines
+	WHERE purchase_order_id = ?
+	`, payload.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	products := make(map[int64]int64)
+	unitCosts := make(map[int64]float64)
+	outstanding := make(map[int64]int64)
+	for rows.Next() {
+		var lineId, productId, quantity int64
+		var unitCost float64
+		if err := rows.Scan(&lineId, &productId, &unitCost, &quantity); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		products[lineId] = productId
+		unitCosts[lineId] = unitCost
+		outstanding[lineId] = quantity
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	for _, line := range payload.Lines {
+		if _, ok := products[line.LineId]; !ok {
+			return nil, nil, fmt.Errorf("line %d is not on purchase order %s: %w", line.LineId, purchaseOrderNumber(payload.Id), errInvalidReceipt)
+		}
+		if line.Quantity <= 0 {
+			return nil, nil, fmt.Errorf("line %d: quantity must be positive: %w", line.LineId, errInvalidReceipt)
+		}
+		outstanding[line.LineId] -= line.Quantity
+		if outstanding[line.LineId] < 0 {
+			return nil, nil, fmt.Errorf("line %d: receiving more than was ordered: %w", line.LineId, errInvalidReceipt)
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO goods_receipts (purchase_order_id, location_id, note) VALUES (?, ?, ?)`, payload.Id, payload.LocationId, payload.Note)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	receiptId, err := result.LastInsertId()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reference := purchaseOrderNumber(payload.Id)
+
+	var posted []*pb.StockMovement
+	for i := range payload.Lines {
+		line := &payload.Lines[i]
+
+		movement, err := supply(ctx, products[line.LineId], unitCosts[line.LineId], reference, line)
+		if err != nil {
+			return nil, posted, fmt.Errorf("line %d: %w", line.LineId, err)
+		}
+		posted = append(posted, movement)
+
+		_, err = tx.ExecContext(ctx, `
+		INSERT INTO goods_receipt_lines (receipt_id, line_id, quantity, movement_id)
+		VALUES (?, ?, ?, ?)
+		`, receiptId, line.LineId, line.Quantity, movement.Id)
+		if err != nil {
+			return nil, posted, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE purchase_order_lines SET received_quantity = received_quantity + ? WHERE id = ?`, line.Quantity, line.LineId); err != nil {
+			return nil, posted, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE purchase_orders
+	SET status = IF(EXISTS (
+		SELECT 1 FROM purchase_order_lines
+		WHERE purchase_order_id = ? AND received_quantity < quantity
+	), 'partially_received', 'received')
+	WHERE id = ?
+	`, payload.Id, payload.Id)
+	if err != nil {
+		return nil, posted, err
+	}
+
+	order, err := getPurchaseOrder(ctx, tx, payload.Id)
+	if err != nil {
+		return nil, posted, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, posted, err
+	}
+
+	return order, posted, nil
+}
+
+func (s *suppliersStore) GetPurchaseOrder(ctx context.Context, id int64) (*pb.PurchaseOrder, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			Logger.LogError("get purchase order", "failed to rollback transaction: %v", err)
+		}
+	}()
+
+	order, err := getPurchaseOrder(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (s *suppliersStore) ListPurchaseOrders(ctx context.Context, supplierId int64, orderStatus string) ([]*pb.PurchaseOrder, error) {
+	query := `SELECT ` + purchaseOrderColumns + ` FROM purchase_orders`
+	var conditions []string
+	var args []any
+	if supplierId > 0 {
+		conditions = append(conditions, "supplier_id = ?")
+		args = append(args, supplierId)
+	}
+	if orderStatus != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, orderStatus)
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id DESC"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var orders []*pb.PurchaseOrder
+	for rows.Next() {
+		order, err := scanPurchaseOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}